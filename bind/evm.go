@@ -0,0 +1,510 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Generate the go binding of the solidity contract from the ABI.
+// The binding deploys, invokes and calls the contract through the XplaClient with typed arguments and outputs,
+// and parses logs of events. The deploy function is generated only if the bytecode is not empty.
+func BindEvm(abiJson string, bytecode string, pkg string, typeName string) (string, error) {
+	if pkg == "" {
+		return "", types.ErrWrap(types.ErrInsufficientParams, "empty package name")
+	}
+	if typeName == "" {
+		return "", types.ErrWrap(types.ErrInsufficientParams, "empty type name")
+	}
+
+	contractAbi, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		return "", types.ErrWrap(types.ErrParse, err)
+	}
+
+	// The ABI is embedded without spaces.
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(abiJson)); err != nil {
+		return "", types.ErrWrap(types.ErrParse, err)
+	}
+
+	g := newEvmGenerator(typeName)
+	code := g.generate(contractAbi, compacted.String(), strings.TrimSpace(bytecode), pkg)
+
+	formatted, err := format.Source(code)
+	if err != nil {
+		return "", types.ErrWrap(types.ErrParse, "generated code is invalid :", err)
+	}
+	return string(formatted), nil
+}
+
+type evmGenerator struct {
+	typeName string
+
+	// Names of go types which are declared, and structs of tuples which are keyed by the type of the tuple.
+	declared     map[string]bool
+	structs      map[string]string
+	declarations []string
+}
+
+func newEvmGenerator(typeName string) *evmGenerator {
+	return &evmGenerator{
+		typeName: typeName,
+		declared: map[string]bool{
+			typeName:            true,
+			typeName + "ABI":    true,
+			typeName + "Bin":    true,
+			"New" + typeName:    true,
+			"Deploy" + typeName: true,
+		},
+		structs: make(map[string]string),
+	}
+}
+
+func (g *evmGenerator) generate(contractAbi abi.ABI, abiJson string, bytecode string, pkg string) []byte {
+	var b bytes.Buffer
+	t := g.typeName
+
+	// Methods are generated before writing the header, because tuples of arguments are declared as structs.
+	methodNames := map[string]bool{"ContractAddress": true}
+	var methods []string
+	for _, name := range sortedMethodNames(contractAbi.Methods) {
+		methods = append(methods, g.method(contractAbi.Methods[name], methodNames))
+	}
+
+	var events []string
+	for _, name := range sortedEventNames(contractAbi.Events) {
+		events = append(events, g.event(contractAbi.Events[name], methodNames))
+	}
+
+	var constructor string
+	if bytecode != "" {
+		constructor = g.constructor(contractAbi.Constructor)
+	}
+
+	b.WriteString("// Code generated by xplabind. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n")
+	b.WriteString("\t\"math/big\"\n")
+	b.WriteString("\t\"strings\"\n\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/core/evm\"\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/provider\"\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/types\"\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/util\"\n\n")
+	b.WriteString("\t\"github.com/ethereum/go-ethereum/accounts/abi\"\n")
+	b.WriteString("\t\"github.com/ethereum/go-ethereum/common\"\n")
+	b.WriteString("\tethtypes \"github.com/ethereum/go-ethereum/core/types\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("// Reference imports to suppress errors if they are not otherwise used.\n")
+	b.WriteString("var (\n")
+	b.WriteString("\t_ = big.NewInt\n")
+	b.WriteString("\t_ = common.Big1\n")
+	b.WriteString("\t_ = ethtypes.BloomLookup\n")
+	b.WriteString("\t_ = evm.CallSolidityContractOutputs\n")
+	b.WriteString("\t_ = util.UnpackEvmEventValues\n")
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(&b, "// %sABI is the ABI of the contract.\n", t)
+	fmt.Fprintf(&b, "const %sABI = %q\n\n", t, abiJson)
+	if bytecode != "" {
+		fmt.Fprintf(&b, "// %sBin is the bytecode of the contract which is used to deploy.\n", t)
+		fmt.Fprintf(&b, "const %sBin = %q\n\n", t, bytecode)
+	}
+
+	fmt.Fprintf(&b, "// %s is the binding of the solidity contract.\n", t)
+	b.WriteString("// Invoke methods return the xpla client which has the message, so the transaction is created by CreateAndSignTx.\n")
+	fmt.Fprintf(&b, "type %s struct {\n", t)
+	b.WriteString("\txplac           provider.XplaClient\n")
+	b.WriteString("\tcontractAddress common.Address\n")
+	b.WriteString("\tabi             abi.ABI\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString(constructor)
+
+	fmt.Fprintf(&b, "// New%s binds the contract which is deployed at the contract address.\n", t)
+	fmt.Fprintf(&b, "func New%s(xplac provider.XplaClient, contractAddress common.Address) (*%s, error) {\n", t, t)
+	fmt.Fprintf(&b, "\tparsed, err := abi.JSON(strings.NewReader(%sABI))\n", t)
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn nil, types.ErrWrap(types.ErrParse, err)\n")
+	b.WriteString("\t}\n")
+	fmt.Fprintf(&b, "\treturn &%s{xplac: xplac, contractAddress: contractAddress, abi: parsed}, nil\n", t)
+	b.WriteString("}\n\n")
+
+	b.WriteString("// ContractAddress returns the address of the bound contract.\n")
+	fmt.Fprintf(&b, "func (c *%s) ContractAddress() common.Address {\n", t)
+	b.WriteString("\treturn c.contractAddress\n")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "func (c *%s) invoke(method string, args ...interface{}) provider.XplaClient {\n", t)
+	b.WriteString("\treturn c.xplac.InvokeSolidityContract(types.InvokeSolContractMsg{\n")
+	b.WriteString("\t\tContractAddress:      c.contractAddress.Hex(),\n")
+	b.WriteString("\t\tContractFuncCallName: method,\n")
+	b.WriteString("\t\tArgs:                 args,\n")
+	fmt.Fprintf(&b, "\t\tABI:                  %sABI,\n", t)
+	b.WriteString("\t\tFromByteAddress:      common.BytesToAddress(c.xplac.GetFromAddress()).Hex(),\n")
+	b.WriteString("\t})\n")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "func (c *%s) call(method string, args ...interface{}) ([]interface{}, error) {\n", t)
+	b.WriteString("\treturn evm.CallSolidityContractOutputs(c.xplac, types.CallSolContractMsg{\n")
+	b.WriteString("\t\tContractAddress:      c.contractAddress.Hex(),\n")
+	b.WriteString("\t\tContractFuncCallName: method,\n")
+	b.WriteString("\t\tArgs:                 args,\n")
+	fmt.Fprintf(&b, "\t\tABI:                  %sABI,\n", t)
+	b.WriteString("\t\tFromByteAddress:      common.BytesToAddress(c.xplac.GetFromAddress()).Hex(),\n")
+	b.WriteString("\t})\n")
+	b.WriteString("}\n\n")
+
+	for _, method := range methods {
+		b.WriteString(method)
+	}
+	for _, event := range events {
+		b.WriteString(event)
+	}
+	for _, declaration := range g.declarations {
+		b.WriteString(declaration)
+		b.WriteString("\n")
+	}
+
+	return b.Bytes()
+}
+
+// The deploy function has arguments of the constructor.
+func (g *evmGenerator) constructor(constructor abi.Method) string {
+	var b strings.Builder
+	t := g.typeName
+
+	params, args := g.params(constructor.Inputs, map[string]bool{"xplac": true})
+	fmt.Fprintf(&b, "// Deploy%s makes the deploy message of the contract with arguments of the constructor.\n", t)
+	fmt.Fprintf(&b, "func Deploy%s(xplac provider.XplaClient%s) provider.XplaClient {\n", t, prefixComma(params))
+	b.WriteString("\treturn xplac.DeploySolidityContract(types.DeploySolContractMsg{\n")
+	fmt.Fprintf(&b, "\t\tABI:      %sABI,\n", t)
+	fmt.Fprintf(&b, "\t\tBytecode: %sBin,\n", t)
+	if len(args) != 0 {
+		fmt.Fprintf(&b, "\t\tArgs:     []interface{}{%s},\n", strings.Join(args, ", "))
+	}
+	b.WriteString("\t})\n")
+	b.WriteString("}\n\n")
+	return b.String()
+}
+
+// Functions which do not change the state, view and pure, are called as query and return outputs.
+// Others are invoked by the transaction. Overloaded functions are named with the index, e.g. SafeTransferFrom0.
+func (g *evmGenerator) method(method abi.Method, methodNames map[string]bool) string {
+	var b strings.Builder
+	t := g.typeName
+
+	constant := method.IsConstant()
+	name := abi.ToCamelCase(method.Name)
+	if methodNames[name] {
+		if constant {
+			name = "Call" + name
+		} else {
+			name = "Invoke" + name
+		}
+	}
+	methodNames[name] = true
+
+	params, args := g.params(method.Inputs, map[string]bool{"out": true, "outputs": true, "err": true})
+	callArgs := fmt.Sprintf("%q", method.Name)
+	if len(args) != 0 {
+		callArgs += ", " + strings.Join(args, ", ")
+	}
+
+	if !constant {
+		fmt.Fprintf(&b, "// %s invokes %q of the contract.\n", name, method.Sig)
+		if method.IsPayable() {
+			b.WriteString("// The function is payable, but the value is not sent with the transaction.\n")
+		}
+		fmt.Fprintf(&b, "func (c *%s) %s(%s) provider.XplaClient {\n", t, name, params)
+		fmt.Fprintf(&b, "\treturn c.invoke(%s)\n", callArgs)
+		b.WriteString("}\n\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "// %s calls %q of the contract.\n", name, method.Sig)
+	switch len(method.Outputs) {
+	case 0:
+		fmt.Fprintf(&b, "func (c *%s) %s(%s) error {\n", t, name, params)
+		fmt.Fprintf(&b, "\t_, err := c.call(%s)\n", callArgs)
+		b.WriteString("\treturn err\n")
+
+	case 1:
+		outType := g.goType(method.Outputs[0].Type)
+		fmt.Fprintf(&b, "func (c *%s) %s(%s) (%s, error) {\n", t, name, params, outType)
+		fmt.Fprintf(&b, "\tvar out %s\n", outType)
+		fmt.Fprintf(&b, "\toutputs, err := c.call(%s)\n", callArgs)
+		b.WriteString("\tif err != nil {\n")
+		b.WriteString("\t\treturn out, err\n")
+		b.WriteString("\t}\n")
+		fmt.Fprintf(&b, "\tout = %s\n", convertType("outputs[0]", outType))
+		b.WriteString("\treturn out, nil\n")
+
+	default:
+		outType := t + name + "Output"
+		g.declareOutput(outType, method)
+		fmt.Fprintf(&b, "func (c *%s) %s(%s) (%s, error) {\n", t, name, params, outType)
+		fmt.Fprintf(&b, "\tvar out %s\n", outType)
+		fmt.Fprintf(&b, "\toutputs, err := c.call(%s)\n", callArgs)
+		b.WriteString("\tif err != nil {\n")
+		b.WriteString("\t\treturn out, err\n")
+		b.WriteString("\t}\n")
+		for i, field := range fieldNames(method.Outputs, "Output", nil) {
+			fmt.Fprintf(&b, "\tout.%s = %s\n", field, convertType(fmt.Sprintf("outputs[%d]", i), g.goType(method.Outputs[i].Type)))
+		}
+		b.WriteString("\treturn out, nil\n")
+	}
+	b.WriteString("}\n\n")
+	return b.String()
+}
+
+// Outputs of the function which returns multiple values are the struct.
+func (g *evmGenerator) declareOutput(name string, method abi.Method) {
+	var b strings.Builder
+	g.declared[name] = true
+
+	fmt.Fprintf(&b, "// %s is outputs of %q.\n", name, method.Sig)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	for i, field := range fieldNames(method.Outputs, "Output", nil) {
+		fmt.Fprintf(&b, "\t%s %s\n", field, g.goType(method.Outputs[i].Type))
+	}
+	b.WriteString("}\n")
+	g.declarations = append(g.declarations, b.String())
+}
+
+// The event is declared as the struct which has arguments and the raw log, and the log is parsed by the parse method.
+// Indexed arguments of dynamic types are the hash of the value, so the type of them is common.Hash.
+func (g *evmGenerator) event(event abi.Event, methodNames map[string]bool) string {
+	var b strings.Builder
+	t := g.typeName
+
+	eventType := t + abi.ToCamelCase(event.Name)
+	for g.declared[eventType] {
+		eventType += "Event"
+	}
+	g.declared[eventType] = true
+
+	fields := fieldNames(event.Inputs, "Arg", map[string]bool{"Raw": true})
+	fieldTypes := make([]string, len(event.Inputs))
+	for i, input := range event.Inputs {
+		fieldTypes[i] = g.goType(input.Type)
+		if input.Indexed && isDynamicType(input.Type) {
+			fieldTypes[i] = "common.Hash"
+		}
+	}
+
+	var decl strings.Builder
+	fmt.Fprintf(&decl, "// %s is the event %q of the contract.\n", eventType, event.Sig)
+	fmt.Fprintf(&decl, "type %s struct {\n", eventType)
+	for i, field := range fields {
+		fmt.Fprintf(&decl, "\t%s %s\n", field, fieldTypes[i])
+	}
+	decl.WriteString("\tRaw ethtypes.Log\n")
+	decl.WriteString("}\n")
+	g.declarations = append(g.declarations, decl.String())
+
+	name := "Parse" + abi.ToCamelCase(event.Name)
+	for methodNames[name] {
+		name += "Event"
+	}
+	methodNames[name] = true
+
+	fmt.Fprintf(&b, "// %s parses the log of the event %q.\n", name, event.Name)
+	fmt.Fprintf(&b, "func (c *%s) %s(log ethtypes.Log) (*%s, error) {\n", t, name, eventType)
+	if len(fields) == 0 {
+		fmt.Fprintf(&b, "\tif _, err := util.UnpackEvmEventValues(c.abi, %q, log); err != nil {\n", event.Name)
+		b.WriteString("\t\treturn nil, err\n")
+		b.WriteString("\t}\n")
+		fmt.Fprintf(&b, "\treturn &%s{Raw: log}, nil\n", eventType)
+		b.WriteString("}\n\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "\tvalues, err := util.UnpackEvmEventValues(c.abi, %q, log)\n", event.Name)
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn nil, err\n")
+	b.WriteString("\t}\n")
+	fmt.Fprintf(&b, "\tevent := &%s{Raw: log}\n", eventType)
+	for i, field := range fields {
+		fmt.Fprintf(&b, "\tevent.%s = %s\n", field, convertType(fmt.Sprintf("values[%d]", i), fieldTypes[i]))
+	}
+	b.WriteString("\treturn event, nil\n")
+	b.WriteString("}\n\n")
+	return b.String()
+}
+
+// Parameters of the function and arguments which are passed to the ABI.
+func (g *evmGenerator) params(inputs abi.Arguments, reserved map[string]bool) (string, []string) {
+	used := map[string]bool{
+		"c": true, "method": true, "args": true,
+		"abi": true, "big": true, "common": true, "ethtypes": true, "evm": true,
+		"provider": true, "strings": true, "types": true, "util": true,
+	}
+	for name := range reserved {
+		used[name] = true
+	}
+
+	var params, args []string
+	for i, input := range inputs {
+		name := paramName(input.Name)
+		if name == "" || token.IsKeyword(name) {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+
+		params = append(params, name+" "+g.goType(input.Type))
+		args = append(args, name)
+	}
+	return strings.Join(params, ", "), args
+}
+
+// Convert the type of the ABI to the go type which is used by the ABI packer of go-ethereum.
+func (g *evmGenerator) goType(t abi.Type) string {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		prefix := "int"
+		if t.T == abi.UintTy {
+			prefix = "uint"
+		}
+		switch t.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%s%d", prefix, t.Size)
+		}
+		return "*big.Int"
+	case abi.BoolTy:
+		return "bool"
+	case abi.StringTy:
+		return "string"
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.HashTy:
+		return "common.Hash"
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size)
+	case abi.FunctionTy:
+		return "[24]byte"
+	case abi.SliceTy:
+		return "[]" + g.goType(*t.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]%s", t.Size, g.goType(*t.Elem))
+	case abi.TupleTy:
+		return g.structType(t)
+	default:
+		return "interface{}"
+	}
+}
+
+// Tuples are declared as structs which are named by the struct of solidity.
+// Fields are named in the same way with go-ethereum, so outputs are converted to the struct by abi.ConvertType.
+func (g *evmGenerator) structType(t abi.Type) string {
+	key := t.TupleType.String()
+	if name, ok := g.structs[key]; ok {
+		return name
+	}
+
+	name := abi.ToCamelCase(strings.TrimRight(t.TupleRawName, "[]0123456789"))
+	if name == "" {
+		name = "Struct"
+	}
+	for g.declared[name] {
+		name += "Struct"
+	}
+	g.declared[name] = true
+	g.structs[key] = name
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s is the tuple %s of the contract.\n", name, t.String())
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	for i, elem := range t.TupleElems {
+		fmt.Fprintf(&b, "\t%s %s\n", abi.ToCamelCase(t.TupleRawNames[i]), g.goType(*elem))
+	}
+	b.WriteString("}\n")
+	g.declarations = append(g.declarations, b.String())
+	return name
+}
+
+// Names of fields of arguments, e.g. outputs of the function and inputs of the event.
+// Unnamed arguments are named with the prefix and the index.
+func fieldNames(arguments abi.Arguments, prefix string, reserved map[string]bool) []string {
+	used := make(map[string]bool)
+	for name := range reserved {
+		used[name] = true
+	}
+
+	names := make([]string, len(arguments))
+	for i, argument := range arguments {
+		name := abi.ToCamelCase(strings.TrimLeft(argument.Name, "_"))
+		if name == "" {
+			name = fmt.Sprintf("%s%d", prefix, i)
+		}
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// The parameter name is the argument name of solidity without leading underscores, e.g. _owner to owner.
+func paramName(name string) string {
+	name = strings.TrimLeft(name, "_")
+	if name == "" {
+		return ""
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func convertType(value string, goType string) string {
+	return fmt.Sprintf("*abi.ConvertType(%s, new(%s)).(*%s)", value, goType, goType)
+}
+
+func isDynamicType(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+	return false
+}
+
+func prefixComma(params string) string {
+	if params == "" {
+		return ""
+	}
+	return ", " + params
+}
+
+func sortedMethodNames(methods map[string]abi.Method) []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedEventNames(events map[string]abi.Event) []string {
+	names := make([]string, 0, len(events))
+	for name := range events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,78 @@
+package bind_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/xpladev/xpla.go/bind"
+	"github.com/xpladev/xpla.go/util"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testErc20ABIJsonFilePath      = "../util/testutil/test_files/erc20_abi.json"
+	testErc20BytecodeJsonFilePath = "../util/testutil/test_files/erc20_bytecode.json"
+	testErc20BindingFile          = "../util/testutil/bindings/erc20/erc20.go"
+)
+
+// The binding of the test contract is generated by go generate, so it should not be drifted from the generator.
+func TestBindEvm(t *testing.T) {
+	abiJson, err := util.AbiParsing(testErc20ABIJsonFilePath)
+	require.NoError(t, err)
+	bytecode, err := util.BytecodeParsing(testErc20BytecodeJsonFilePath)
+	require.NoError(t, err)
+
+	code, err := bind.BindEvm(abiJson, bytecode, "erc20", "Erc20")
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(testErc20BindingFile)
+	require.NoError(t, err)
+	require.Equal(t, string(generated), code)
+
+	_, err = bind.BindEvm(abiJson, bytecode, "erc20", "")
+	require.Error(t, err)
+
+	_, err = bind.BindEvm("invalid", bytecode, "erc20", "Erc20")
+	require.Error(t, err)
+}
+
+func TestBindEvmTypes(t *testing.T) {
+	abiJson := `[
+		{"type": "function", "name": "getOrder", "stateMutability": "view",
+			"inputs": [{"name": "id", "type": "uint64"}],
+			"outputs": [
+				{"name": "order", "type": "tuple", "internalType": "struct Market.Order",
+					"components": [{"name": "maker", "type": "address"}, {"name": "amounts", "type": "uint256[]"}]},
+				{"name": "", "type": "bytes32"}
+			]},
+		{"type": "function", "name": "safeTransferFrom", "stateMutability": "nonpayable",
+			"inputs": [{"name": "from", "type": "address"}, {"name": "to", "type": "address"}, {"name": "tokenId", "type": "uint256"}],
+			"outputs": []},
+		{"type": "function", "name": "safeTransferFrom", "stateMutability": "nonpayable",
+			"inputs": [{"name": "from", "type": "address"}, {"name": "to", "type": "address"}, {"name": "tokenId", "type": "uint256"}, {"name": "data", "type": "bytes"}],
+			"outputs": []},
+		{"type": "function", "name": "deposit", "stateMutability": "payable",
+			"inputs": [{"name": "type", "type": "uint8"}, {"name": "", "type": "int24"}],
+			"outputs": []},
+		{"type": "event", "name": "Named", "anonymous": false,
+			"inputs": [{"name": "name", "type": "string", "indexed": true}, {"name": "raw", "type": "bytes4", "indexed": false}]}
+	]`
+
+	code, err := bind.BindEvm(abiJson, "", "market", "Market")
+	require.NoError(t, err)
+
+	// the deploy function is not generated without the bytecode
+	require.NotContains(t, code, "func DeployMarket(")
+	require.Contains(t, code, "func (c *Market) GetOrder(id uint64) (MarketGetOrderOutput, error) {")
+	require.Contains(t, code, "type MarketOrder struct {\n\tMaker   common.Address\n\tAmounts []*big.Int\n}")
+	require.Contains(t, code, "type MarketGetOrderOutput struct {\n\tOrder   MarketOrder\n\tOutput1 [32]byte\n}")
+	// overloaded functions
+	require.Contains(t, code, "func (c *Market) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) provider.XplaClient {")
+	require.Contains(t, code, "func (c *Market) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) provider.XplaClient {")
+	require.Contains(t, code, "return c.invoke(\"safeTransferFrom0\", from, to, tokenId, data)")
+	// keywords and unnamed parameters
+	require.Contains(t, code, "func (c *Market) Deposit(arg0 uint8, arg1 *big.Int) provider.XplaClient {")
+	// indexed dynamic types are hashes
+	require.Contains(t, code, "type MarketNamed struct {\n\tName common.Hash\n\tRaw_ [4]byte\n\tRaw  ethtypes.Log\n}")
+}
@@ -0,0 +1,628 @@
+package bind
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/xpladev/xpla.go/types"
+)
+
+// Generate the go binding of the cosmwasm contract from the schema.
+// The binding instantiates, executes and queries the contract through the XplaClient with typed messages and responses.
+// If the type name is empty, the name is made by the contract name of the schema.
+func BindWasm(wasmSchema *WasmSchema, pkg string, typeName string) (string, error) {
+	if pkg == "" {
+		return "", types.ErrWrap(types.ErrInsufficientParams, "empty package name")
+	}
+	if typeName == "" {
+		typeName = goName(wasmSchema.ContractName)
+	}
+	if typeName == "" {
+		return "", types.ErrWrap(types.ErrInsufficientParams, "empty type name")
+	}
+
+	g := newWasmGenerator(typeName)
+	code, err := g.generate(wasmSchema, pkg)
+	if err != nil {
+		return "", err
+	}
+
+	formatted, err := format.Source(code)
+	if err != nil {
+		return "", types.ErrWrap(types.ErrParse, "generated code is invalid :", err)
+	}
+	return string(formatted), nil
+}
+
+type wasmGenerator struct {
+	typeName string
+
+	// Definitions of all schemas are merged, because schemars names definitions by rust types.
+	definitions map[string]*jsonSchema
+	// Go types which are already declared, and declarations in the order of declaration.
+	declared     map[string]bool
+	declarations []string
+
+	executeMethods []wasmMethod
+	queryMethods   []wasmMethod
+}
+
+type wasmMethod struct {
+	name        string
+	variant     string
+	description string
+	unit        bool
+	argType     string
+	resType     string
+}
+
+func newWasmGenerator(typeName string) *wasmGenerator {
+	return &wasmGenerator{
+		typeName:    typeName,
+		definitions: make(map[string]*jsonSchema),
+		declared:    make(map[string]bool),
+	}
+}
+
+func (g *wasmGenerator) generate(wasmSchema *WasmSchema, pkg string) ([]byte, error) {
+	roots := []*jsonSchema{wasmSchema.instantiate, wasmSchema.execute, wasmSchema.query, wasmSchema.migrate}
+	for _, variant := range sortedKeys(wasmSchema.responses) {
+		roots = append(roots, wasmSchema.responses[variant])
+	}
+	for _, root := range roots {
+		if root == nil {
+			continue
+		}
+		for _, name := range sortedKeys(root.Definitions) {
+			if _, ok := g.definitions[name]; !ok {
+				g.definitions[name] = root.Definitions[name]
+			}
+		}
+	}
+
+	// Names of methods are reserved, so the message type which has the same name is not declared.
+	reserved := map[string]bool{
+		g.typeName:                 true,
+		"New" + g.typeName:         true,
+		"Instantiate" + g.typeName: true,
+	}
+	for name := range g.definitions {
+		reserved[goName(name)] = true
+	}
+
+	if wasmSchema.instantiate != nil {
+		if err := g.declareRoot("InstantiateMsg", wasmSchema.instantiate); err != nil {
+			return nil, err
+		}
+	}
+	if wasmSchema.migrate != nil {
+		if err := g.declareRoot("MigrateMsg", wasmSchema.migrate); err != nil {
+			return nil, err
+		}
+	}
+
+	methodNames := map[string]bool{"ContractAddress": true, "WithAmount": true, "Migrate": wasmSchema.migrate != nil}
+	if wasmSchema.execute != nil {
+		variants, ok := enumVariants(wasmSchema.execute)
+		if !ok {
+			return nil, types.ErrWrap(types.ErrNotSupport, "execute msg must be the enum")
+		}
+		for _, variant := range variants {
+			method, err := g.variantMethod(variant, "Msg", reserved)
+			if err != nil {
+				return nil, err
+			}
+			if methodNames[method.name] {
+				method.name = "Execute" + method.name
+			}
+			methodNames[method.name] = true
+			g.executeMethods = append(g.executeMethods, method)
+		}
+	}
+
+	if wasmSchema.query != nil {
+		variants, ok := enumVariants(wasmSchema.query)
+		if !ok {
+			return nil, types.ErrWrap(types.ErrNotSupport, "query msg must be the enum")
+		}
+		for _, variant := range variants {
+			method, err := g.variantMethod(variant, "Query", reserved)
+			if err != nil {
+				return nil, err
+			}
+			if methodNames[method.name] {
+				method.name = "Query" + method.name
+			}
+			methodNames[method.name] = true
+
+			method.resType = "json.RawMessage"
+			if response, ok := wasmSchema.responses[variant.name]; ok {
+				resType, err := g.responseType(variant.name, response)
+				if err != nil {
+					return nil, err
+				}
+				method.resType = resType
+			}
+			g.queryMethods = append(g.queryMethods, method)
+		}
+	}
+
+	return g.write(wasmSchema, pkg), nil
+}
+
+// Declare the top level message, e.g. InstantiateMsg, as the struct.
+func (g *wasmGenerator) declareRoot(name string, schema *jsonSchema) error {
+	if g.declared[name] {
+		return types.ErrWrap(types.ErrAlreadyExist, "type", name, "is declared by the definition")
+	}
+	_, err := g.declare(name, schema)
+	return err
+}
+
+// Make the method of the variant of execute or query msg.
+// The argument of the method is the struct of fields of the variant, or the definition which is referred by the variant.
+func (g *wasmGenerator) variantMethod(variant enumVariant, suffix string, reserved map[string]bool) (wasmMethod, error) {
+	method := wasmMethod{
+		name:        goName(variant.name),
+		variant:     variant.name,
+		description: variant.description,
+		unit:        variant.unit,
+	}
+	if variant.unit || isEmptyObject(variant.value) {
+		return method, nil
+	}
+
+	argType := method.name + suffix
+	if reserved[argType] || g.declared[argType] {
+		argType = method.name + "Args" + suffix
+	}
+	goType, err := g.goType(variant.value, argType)
+	if err != nil {
+		return wasmMethod{}, err
+	}
+	method.argType = goType
+	return method, nil
+}
+
+// The response of the query is declared by the title of the schema, e.g. OwnerOfResponse.
+// Schemars names titles and definitions by rust types, so the response which has the same name is the same type.
+func (g *wasmGenerator) responseType(variant string, schema *jsonSchema) (string, error) {
+	name := goName(schema.Title)
+	if name == "" {
+		name = goName(variant) + "Response"
+	}
+	if len(schema.Properties) == 0 && len(schema.OneOf) == 0 {
+		return g.goType(schema, name)
+	}
+
+	if g.declared[name] {
+		return name, nil
+	}
+	return g.declare(name, schema)
+}
+
+// Convert the schema to the go type. Objects and enums which are not definitions are declared by the name.
+func (g *wasmGenerator) goType(schema *jsonSchema, name string) (string, error) {
+	if schema == nil || schema.boolean != nil {
+		return "json.RawMessage", nil
+	}
+
+	if schema.Ref != "" {
+		return g.refType(schema.Ref)
+	}
+
+	if len(schema.AllOf) == 1 {
+		return g.goType(schema.AllOf[0], name)
+	}
+
+	if len(schema.AnyOf) == 2 {
+		for i, anyOf := range schema.AnyOf {
+			if len(anyOf.Type) == 1 && anyOf.Type[0] == "null" {
+				goType, err := g.goType(schema.AnyOf[1-i], name)
+				if err != nil {
+					return "", err
+				}
+				return nullable(goType), nil
+			}
+		}
+	}
+
+	if len(schema.OneOf) != 0 || len(schema.Enum) != 0 {
+		if g.declared[name] {
+			return name, nil
+		}
+		return g.declare(name, schema)
+	}
+
+	nonNullTypes := schema.nonNullTypes()
+	if len(nonNullTypes) != 1 {
+		return "json.RawMessage", nil
+	}
+
+	var goType string
+	switch nonNullTypes[0] {
+	case "string":
+		goType = "string"
+	case "boolean":
+		goType = "bool"
+	case "integer":
+		goType = integerType(schema.Format)
+	case "number":
+		goType = "float64"
+	case "array":
+		if schema.tuple || schema.Items == nil {
+			goType = "[]json.RawMessage"
+			break
+		}
+		itemType, err := g.goType(schema.Items, name+"Item")
+		if err != nil {
+			return "", err
+		}
+		goType = "[]" + itemType
+	case "object":
+		switch {
+		case len(schema.Properties) != 0:
+			if !g.declared[name] {
+				if _, err := g.declare(name, schema); err != nil {
+					return "", err
+				}
+			}
+			goType = name
+		case schema.AdditionalProperties != nil && schema.AdditionalProperties.boolean == nil:
+			valueType, err := g.goType(schema.AdditionalProperties, name+"Value")
+			if err != nil {
+				return "", err
+			}
+			goType = "map[string]" + valueType
+		default:
+			goType = "struct{}"
+		}
+	default:
+		goType = "json.RawMessage"
+	}
+
+	if schema.hasType("null") {
+		return nullable(goType), nil
+	}
+	return goType, nil
+}
+
+// The definition which is referred is declared by the name of the definition.
+func (g *wasmGenerator) refType(ref string) (string, error) {
+	defName := strings.TrimPrefix(ref, "#/definitions/")
+	def, ok := g.definitions[defName]
+	if !ok {
+		return "", types.ErrWrap(types.ErrNotFound, "definition", ref)
+	}
+
+	name := goName(defName)
+	if g.declared[name] {
+		return name, nil
+	}
+	return g.declare(name, def)
+}
+
+// Declare the go type of the schema by the name.
+func (g *wasmGenerator) declare(name string, schema *jsonSchema) (string, error) {
+	// The name is marked before declaring fields, because the type can refer itself.
+	g.declared[name] = true
+
+	var b strings.Builder
+	writeComment(&b, "", schema.Description)
+
+	switch {
+	case len(schema.Enum) != 0 || len(schema.OneOf) != 0:
+		variants, ok := enumVariants(schema)
+		if !ok {
+			return g.alias(name, "json.RawMessage", b.String())
+		}
+
+		if allUnitVariants(variants) {
+			fmt.Fprintf(&b, "type %s string\n\n", name)
+			b.WriteString("const (\n")
+			for _, variant := range variants {
+				fmt.Fprintf(&b, "\t%s%s %s = %q\n", name, goName(variant.name), name, variant.name)
+			}
+			b.WriteString(")\n")
+			break
+		}
+
+		// The unit variant is serialized to the string, so it cannot be the field of the struct.
+		if hasUnitVariant(variants) {
+			return g.alias(name, "json.RawMessage", b.String())
+		}
+
+		// Only one field of variants should be set.
+		fmt.Fprintf(&b, "type %s struct {\n", name)
+		for _, variant := range variants {
+			fieldType, err := g.goType(variant.value, name+goName(variant.name))
+			if err != nil {
+				return "", err
+			}
+			writeComment(&b, "\t", variant.description)
+			fmt.Fprintf(&b, "\t%s %s `json:\"%s,omitempty\"`\n", goName(variant.name), nullable(fieldType), variant.name)
+		}
+		b.WriteString("}\n")
+
+	case len(schema.Properties) != 0:
+		fmt.Fprintf(&b, "type %s struct {\n", name)
+		for _, property := range schema.propertyOrder {
+			fieldType, err := g.goType(schema.Properties[property], name+goName(property))
+			if err != nil {
+				return "", err
+			}
+
+			tag := property
+			if !schema.isRequired(property) {
+				fieldType = nullable(fieldType)
+				tag += ",omitempty"
+			}
+			writeComment(&b, "\t", schema.Properties[property].Description)
+			fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", goName(property), fieldType, tag)
+		}
+		b.WriteString("}\n")
+
+	default:
+		goType, err := g.goType(schema, name)
+		if err != nil {
+			return "", err
+		}
+		if goType == name {
+			return name, nil
+		}
+		return g.alias(name, goType, b.String())
+	}
+
+	g.declarations = append(g.declarations, b.String())
+	return name, nil
+}
+
+// Declare the named type of the underlying type, e.g. type Uint128 string.
+func (g *wasmGenerator) alias(name string, goType string, comment string) (string, error) {
+	if strings.HasPrefix(goType, "*") {
+		goType = strings.TrimPrefix(goType, "*")
+	}
+	g.declarations = append(g.declarations, fmt.Sprintf("%stype %s %s\n", comment, name, goType))
+	return name, nil
+}
+
+func (g *wasmGenerator) write(wasmSchema *WasmSchema, pkg string) []byte {
+	var b bytes.Buffer
+	t := g.typeName
+
+	b.WriteString("// Code generated by xplabind. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n")
+	b.WriteString("\t\"encoding/json\"\n\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/provider\"\n")
+	b.WriteString("\t\"github.com/xpladev/xpla.go/types\"\n\n")
+	b.WriteString("\twasmtypes \"github.com/CosmWasm/wasmd/x/wasm/types\"\n")
+	b.WriteString(")\n\n")
+
+	contract := wasmSchema.ContractName
+	if contract == "" {
+		contract = "the contract"
+	}
+	if wasmSchema.ContractVersion != "" {
+		contract += " " + wasmSchema.ContractVersion
+	}
+
+	fmt.Fprintf(&b, "// %s is the binding of %s.\n", t, contract)
+	b.WriteString("// Execute methods return the xpla client which has the message, so the transaction is created by CreateAndSignTx.\n")
+	fmt.Fprintf(&b, "type %s struct {\n", t)
+	b.WriteString("\txplac           provider.XplaClient\n")
+	b.WriteString("\tcontractAddress string\n")
+	b.WriteString("\tamount          string\n")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "// New%s binds the contract which is deployed at the contract address.\n", t)
+	fmt.Fprintf(&b, "func New%s(xplac provider.XplaClient, contractAddress string) *%s {\n", t, t)
+	fmt.Fprintf(&b, "\treturn &%s{xplac: xplac, contractAddress: contractAddress, amount: \"0\"}\n", t)
+	b.WriteString("}\n\n")
+
+	if wasmSchema.instantiate != nil {
+		fmt.Fprintf(&b, "// Instantiate%s makes the instantiate message of the contract.\n", t)
+		b.WriteString("// InitMsg of the instantiate message is replaced by the typed message.\n")
+		fmt.Fprintf(&b, "func Instantiate%s(xplac provider.XplaClient, instantiateMsg types.InstantiateMsg, msg InstantiateMsg) provider.XplaClient {\n", t)
+		b.WriteString("\tbz, err := json.Marshal(msg)\n")
+		b.WriteString("\tif err != nil {\n")
+		b.WriteString("\t\treturn xplac.WithErr(types.ErrWrap(types.ErrFailedToMarshal, err))\n")
+		b.WriteString("\t}\n")
+		b.WriteString("\tinstantiateMsg.InitMsg = string(bz)\n")
+		b.WriteString("\treturn xplac.InstantiateContract(instantiateMsg)\n")
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("// ContractAddress returns the address of the bound contract.\n")
+	fmt.Fprintf(&b, "func (c *%s) ContractAddress() string {\n", t)
+	b.WriteString("\treturn c.contractAddress\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// WithAmount returns the copy of the binding which sends the amount with execute messages.\n")
+	fmt.Fprintf(&b, "func (c *%s) WithAmount(amount string) *%s {\n", t, t)
+	b.WriteString("\tcopied := *c\n")
+	b.WriteString("\tcopied.amount = amount\n")
+	b.WriteString("\treturn &copied\n")
+	b.WriteString("}\n\n")
+
+	if wasmSchema.migrate != nil {
+		b.WriteString("// Migrate makes the migrate message of the contract to the new code ID.\n")
+		fmt.Fprintf(&b, "func (c *%s) Migrate(codeId string, msg MigrateMsg) provider.XplaClient {\n", t)
+		b.WriteString("\tbz, err := json.Marshal(msg)\n")
+		b.WriteString("\tif err != nil {\n")
+		b.WriteString("\t\treturn c.xplac.WithErr(types.ErrWrap(types.ErrFailedToMarshal, err))\n")
+		b.WriteString("\t}\n")
+		b.WriteString("\treturn c.xplac.Migrate(types.MigrateMsg{ContractAddress: c.contractAddress, CodeId: codeId, MigrateMsg: string(bz)})\n")
+		b.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&b, "func (c *%s) execute(msg interface{}) provider.XplaClient {\n", t)
+	b.WriteString("\tbz, err := json.Marshal(msg)\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn c.xplac.WithErr(types.ErrWrap(types.ErrFailedToMarshal, err))\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\treturn c.xplac.ExecuteContract(types.ExecuteMsg{ContractAddress: c.contractAddress, Amount: c.amount, ExecMsg: string(bz)})\n")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "func (c *%s) query(msg interface{}, res interface{}) error {\n", t)
+	b.WriteString("\tbz, err := json.Marshal(msg)\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn types.ErrWrap(types.ErrFailedToMarshal, err)\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tprotoRes, err := c.xplac.QueryContract(types.QueryMsg{ContractAddress: c.contractAddress, QueryMsg: string(bz)}).QueryProto()\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn err\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tif err := json.Unmarshal(protoRes.(*wasmtypes.QuerySmartContractStateResponse).Data, res); err != nil {\n")
+	b.WriteString("\t\treturn types.ErrWrap(types.ErrFailedToUnmarshal, err)\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\treturn nil\n")
+	b.WriteString("}\n\n")
+
+	for _, method := range g.executeMethods {
+		fmt.Fprintf(&b, "// %s executes %q of the contract.\n", method.name, method.variant)
+		writeComment(&b, "", method.description)
+		switch {
+		case method.unit:
+			fmt.Fprintf(&b, "func (c *%s) %s() provider.XplaClient {\n", t, method.name)
+			fmt.Fprintf(&b, "\treturn c.execute(%q)\n", method.variant)
+		case method.argType == "":
+			fmt.Fprintf(&b, "func (c *%s) %s() provider.XplaClient {\n", t, method.name)
+			fmt.Fprintf(&b, "\treturn c.execute(map[string]struct{}{%q: {}})\n", method.variant)
+		default:
+			fmt.Fprintf(&b, "func (c *%s) %s(msg %s) provider.XplaClient {\n", t, method.name, method.argType)
+			fmt.Fprintf(&b, "\treturn c.execute(map[string]%s{%q: msg})\n", method.argType, method.variant)
+		}
+		b.WriteString("}\n\n")
+	}
+
+	for _, method := range g.queryMethods {
+		fmt.Fprintf(&b, "// %s queries %q of the contract.\n", method.name, method.variant)
+		writeComment(&b, "", method.description)
+		var query string
+		switch {
+		case method.unit:
+			fmt.Fprintf(&b, "func (c *%s) %s() (%s, error) {\n", t, method.name, method.resType)
+			query = fmt.Sprintf("%q", method.variant)
+		case method.argType == "":
+			fmt.Fprintf(&b, "func (c *%s) %s() (%s, error) {\n", t, method.name, method.resType)
+			query = fmt.Sprintf("map[string]struct{}{%q: {}}", method.variant)
+		default:
+			fmt.Fprintf(&b, "func (c *%s) %s(msg %s) (%s, error) {\n", t, method.name, method.argType, method.resType)
+			query = fmt.Sprintf("map[string]%s{%q: msg}", method.argType, method.variant)
+		}
+		fmt.Fprintf(&b, "\tvar res %s\n", method.resType)
+		fmt.Fprintf(&b, "\terr := c.query(%s, &res)\n", query)
+		b.WriteString("\treturn res, err\n")
+		b.WriteString("}\n\n")
+	}
+
+	for _, declaration := range g.declarations {
+		b.WriteString(declaration)
+		b.WriteString("\n")
+	}
+
+	return b.Bytes()
+}
+
+// Convert the name of rust, e.g. transfer_nft or MintMsg_for_Nullable_Metadata, to the exported go name.
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	goName := b.String()
+	if goName != "" && unicode.IsDigit(rune(goName[0])) {
+		goName = "X" + goName
+	}
+	return goName
+}
+
+func integerType(format string) string {
+	switch format {
+	case "uint8", "uint16", "uint32", "uint64", "int8", "int16", "int32", "int64":
+		return format
+	case "uint":
+		return "uint64"
+	default:
+		return "int64"
+	}
+}
+
+// The optional value is the pointer, except types which can be nil.
+func nullable(goType string) string {
+	if strings.HasPrefix(goType, "*") ||
+		strings.HasPrefix(goType, "[]") ||
+		strings.HasPrefix(goType, "map[") ||
+		goType == "json.RawMessage" {
+		return goType
+	}
+	return "*" + goType
+}
+
+func isEmptyObject(schema *jsonSchema) bool {
+	return schema != nil &&
+		schema.Ref == "" &&
+		len(schema.Properties) == 0 &&
+		len(schema.OneOf) == 0 &&
+		len(schema.AllOf) == 0 &&
+		len(schema.AnyOf) == 0 &&
+		(len(schema.Type) == 0 || (len(schema.Type) == 1 && schema.Type[0] == "object")) &&
+		(schema.AdditionalProperties == nil || schema.AdditionalProperties.boolean != nil)
+}
+
+func allUnitVariants(variants []enumVariant) bool {
+	for _, variant := range variants {
+		if !variant.unit {
+			return false
+		}
+	}
+	return true
+}
+
+func hasUnitVariant(variants []enumVariant) bool {
+	for _, variant := range variants {
+		if variant.unit {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]*jsonSchema) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeComment(w io.Writer, indent string, description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		line = strings.TrimRight(line, " ")
+		if line == "" {
+			fmt.Fprintf(w, "%s//\n", indent)
+			continue
+		}
+		fmt.Fprintf(w, "%s// %s\n", indent, line)
+	}
+}
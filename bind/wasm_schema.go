@@ -0,0 +1,318 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/xpladev/xpla.go/types"
+)
+
+// Schema of the cosmwasm contract which is generated by cosmwasm-schema.
+// Both the single schema file of cosmwasm-schema v1.1 or later, e.g. schema/cw721-base.json,
+// and the legacy schema files for each message, e.g. schema/execute_msg.json, are supported.
+type WasmSchema struct {
+	ContractName    string
+	ContractVersion string
+
+	instantiate *jsonSchema
+	execute     *jsonSchema
+	query       *jsonSchema
+	migrate     *jsonSchema
+	responses   map[string]*jsonSchema
+}
+
+// The subset of the JSON schema draft-07 which is used by schemars of cosmwasm-schema.
+type jsonSchema struct {
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 schemaType             `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	OneOf                []*jsonSchema          `json:"oneOf,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	Definitions          map[string]*jsonSchema `json:"definitions,omitempty"`
+
+	// Properties are ordered by the schema file in order to generate the same code always.
+	propertyOrder []string
+	// The schema is the boolean schema, e.g. "additionalProperties": false.
+	boolean *bool
+	// Items of the tuple are not converted to go types.
+	tuple bool
+}
+
+// Types of the JSON schema are the string or the list of strings, e.g. ["string", "null"].
+type schemaType []string
+
+func (t *schemaType) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaType{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*t = multiple
+	return nil
+}
+
+func (s *jsonSchema) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("true")) || bytes.Equal(trimmed, []byte("false")) {
+		boolean := bytes.Equal(trimmed, []byte("true"))
+		s.boolean = &boolean
+		return nil
+	}
+
+	// The tuple is the list of schemas in items.
+	var raw struct {
+		Items json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(trimmed, &raw); err != nil {
+		return err
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(raw.Items), []byte("[")) {
+		s.tuple = true
+		var withoutItems map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &withoutItems); err != nil {
+			return err
+		}
+		delete(withoutItems, "items")
+		trimmed, _ = json.Marshal(withoutItems)
+	}
+
+	type plainSchema jsonSchema
+	var plain plainSchema
+	if err := json.Unmarshal(trimmed, &plain); err != nil {
+		return err
+	}
+	plain.tuple = s.tuple
+
+	propertyOrder, err := propertyKeys(trimmed)
+	if err != nil {
+		return err
+	}
+	plain.propertyOrder = propertyOrder
+
+	*s = jsonSchema(plain)
+	return nil
+}
+
+// Collect keys of properties in the order of the schema file.
+func propertyKeys(data []byte) ([]string, error) {
+	var raw struct {
+		Properties json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if len(raw.Properties) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw.Properties))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, token.(string))
+
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+func (s *jsonSchema) hasType(t string) bool {
+	for _, schemaType := range s.Type {
+		if schemaType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// The type of the schema without "null".
+func (s *jsonSchema) nonNullTypes() []string {
+	var nonNullTypes []string
+	for _, schemaType := range s.Type {
+		if schemaType != "null" {
+			nonNullTypes = append(nonNullTypes, schemaType)
+		}
+	}
+	return nonNullTypes
+}
+
+func (s *jsonSchema) isRequired(property string) bool {
+	for _, required := range s.Required {
+		if required == property {
+			return true
+		}
+	}
+	return false
+}
+
+// The variant of the enum of rust which is serialized by serde.
+// Unit variants are serialized to the string, and others are serialized to the object which has the single key.
+type enumVariant struct {
+	name        string
+	description string
+	unit        bool
+	value       *jsonSchema
+}
+
+// Split the schema of the enum, e.g. ExecuteMsg, to variants.
+func enumVariants(s *jsonSchema) ([]enumVariant, bool) {
+	var variants []enumVariant
+	if len(s.Enum) != 0 {
+		for _, e := range s.Enum {
+			name, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			variants = append(variants, enumVariant{name: name, description: s.Description, unit: true})
+		}
+		return variants, true
+	}
+
+	if len(s.OneOf) == 0 {
+		return nil, false
+	}
+
+	for _, oneOf := range s.OneOf {
+		switch {
+		case len(oneOf.Enum) != 0:
+			for _, e := range oneOf.Enum {
+				name, ok := e.(string)
+				if !ok {
+					return nil, false
+				}
+				variants = append(variants, enumVariant{name: name, description: oneOf.Description, unit: true})
+			}
+
+		case len(oneOf.Properties) == 1 && len(oneOf.Required) == 1:
+			name := oneOf.Required[0]
+			value, ok := oneOf.Properties[name]
+			if !ok {
+				return nil, false
+			}
+			variants = append(variants, enumVariant{name: name, description: oneOf.Description, value: value})
+
+		default:
+			return nil, false
+		}
+	}
+	return variants, true
+}
+
+// Load the schema of the cosmwasm contract from the schema directory of the contract.
+// Only JSON files in the directory are read, so the "raw" directory of cosmwasm-schema is ignored.
+func LoadWasmSchema(schemaDir string) (*WasmSchema, error) {
+	entries, err := os.ReadDir(schemaDir)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrCannotRead, err)
+	}
+
+	files := make(map[string][]byte)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		bytes, err := os.ReadFile(filepath.Join(schemaDir, entry.Name()))
+		if err != nil {
+			return nil, types.ErrWrap(types.ErrCannotRead, err)
+		}
+		files[entry.Name()] = bytes
+	}
+
+	return ParseWasmSchema(files)
+}
+
+// Parse the schema of the cosmwasm contract from schema files which are keyed by file names.
+func ParseWasmSchema(files map[string][]byte) (*WasmSchema, error) {
+	if len(files) == 0 {
+		return nil, types.ErrWrap(types.ErrInsufficientParams, "no schema file")
+	}
+
+	fileNames := make([]string, 0, len(files))
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	// cosmwasm-schema v1.1 or later writes the single file which includes all messages.
+	for _, fileName := range fileNames {
+		var contractSchema struct {
+			ContractName    string                 `json:"contract_name"`
+			ContractVersion string                 `json:"contract_version"`
+			Instantiate     *jsonSchema            `json:"instantiate"`
+			Execute         *jsonSchema            `json:"execute"`
+			Query           *jsonSchema            `json:"query"`
+			Migrate         *jsonSchema            `json:"migrate"`
+			Responses       map[string]*jsonSchema `json:"responses"`
+		}
+		if err := json.Unmarshal(files[fileName], &contractSchema); err != nil {
+			return nil, types.ErrWrap(types.ErrFailedToUnmarshal, fileName, ":", err)
+		}
+		if contractSchema.ContractName == "" {
+			continue
+		}
+
+		return &WasmSchema{
+			ContractName:    contractSchema.ContractName,
+			ContractVersion: contractSchema.ContractVersion,
+			instantiate:     contractSchema.Instantiate,
+			execute:         contractSchema.Execute,
+			query:           contractSchema.Query,
+			migrate:         contractSchema.Migrate,
+			responses:       contractSchema.Responses,
+		}, nil
+	}
+
+	// Legacy schema files are named by messages, and responses are named by query variants, e.g. owner_of_response.json.
+	wasmSchema := &WasmSchema{responses: make(map[string]*jsonSchema)}
+	for _, fileName := range fileNames {
+		var schema jsonSchema
+		if err := json.Unmarshal(files[fileName], &schema); err != nil {
+			return nil, types.ErrWrap(types.ErrFailedToUnmarshal, fileName, ":", err)
+		}
+
+		switch name := strings.TrimSuffix(fileName, ".json"); {
+		case name == "instantiate_msg" || name == "init_msg":
+			wasmSchema.instantiate = &schema
+		case name == "execute_msg" || name == "handle_msg":
+			wasmSchema.execute = &schema
+		case name == "query_msg":
+			wasmSchema.query = &schema
+		case name == "migrate_msg":
+			wasmSchema.migrate = &schema
+		case strings.HasSuffix(name, "_response"):
+			wasmSchema.responses[strings.TrimSuffix(name, "_response")] = &schema
+		}
+	}
+
+	if wasmSchema.instantiate == nil && wasmSchema.execute == nil && wasmSchema.query == nil {
+		return nil, types.ErrWrap(types.ErrNotFound, "no message schema of the contract")
+	}
+	return wasmSchema, nil
+}
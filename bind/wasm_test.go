@@ -0,0 +1,90 @@
+package bind_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/xpladev/xpla.go/bind"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCw721SchemaDir   = "../util/testutil/test_files/cw721_metadata_onchain_schema"
+	testCw721BindingFile = "../util/testutil/bindings/cw721/cw721.go"
+)
+
+// The binding of the test contract is generated by go generate, so it should not be drifted from the generator.
+func TestBindWasm(t *testing.T) {
+	wasmSchema, err := bind.LoadWasmSchema(testCw721SchemaDir)
+	require.NoError(t, err)
+	require.Equal(t, "cw721-metadata-onchain", wasmSchema.ContractName)
+	require.Equal(t, "0.15.0", wasmSchema.ContractVersion)
+
+	code, err := bind.BindWasm(wasmSchema, "cw721", "Cw721")
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(testCw721BindingFile)
+	require.NoError(t, err)
+	require.Equal(t, string(generated), code)
+
+	_, err = bind.BindWasm(wasmSchema, "", "Cw721")
+	require.Error(t, err)
+}
+
+func TestBindWasmLegacySchema(t *testing.T) {
+	files := map[string][]byte{
+		"instantiate_msg.json": []byte(`{
+			"title": "InstantiateMsg",
+			"type": "object",
+			"required": ["count"],
+			"properties": {"count": {"type": "integer", "format": "int32"}}
+		}`),
+		"execute_msg.json": []byte(`{
+			"title": "ExecuteMsg",
+			"oneOf": [
+				{"type": "string", "enum": ["increment"]},
+				{
+					"type": "object",
+					"required": ["reset"],
+					"properties": {"reset": {"type": "object", "required": ["count"], "properties": {"count": {"type": "integer", "format": "int32"}}}}
+				}
+			]
+		}`),
+		"query_msg.json": []byte(`{
+			"title": "QueryMsg",
+			"oneOf": [
+				{"type": "object", "required": ["get_count"], "properties": {"get_count": {"type": "object"}}},
+				{"type": "object", "required": ["get_status"], "properties": {"get_status": {"type": "object"}}}
+			]
+		}`),
+		"get_count_response.json": []byte(`{
+			"title": "CountResponse",
+			"type": "object",
+			"required": ["count", "status"],
+			"properties": {
+				"count": {"type": "integer", "format": "int32"},
+				"status": {"$ref": "#/definitions/Status"}
+			},
+			"definitions": {"Status": {"type": "string", "enum": ["active", "paused"]}}
+		}`),
+	}
+
+	wasmSchema, err := bind.ParseWasmSchema(files)
+	require.NoError(t, err)
+
+	code, err := bind.BindWasm(wasmSchema, "counter", "Counter")
+	require.NoError(t, err)
+
+	require.Contains(t, code, "func (c *Counter) Increment() provider.XplaClient {\n\treturn c.execute(\"increment\")\n}")
+	require.Contains(t, code, "func (c *Counter) Reset(msg ResetMsg) provider.XplaClient {")
+	require.Contains(t, code, "func (c *Counter) GetCount() (CountResponse, error) {")
+	// the query without the response schema returns the raw response
+	require.Contains(t, code, "func (c *Counter) GetStatus() (json.RawMessage, error) {")
+	require.Contains(t, code, "type Status string")
+	require.Contains(t, code, "StatusPaused Status = \"paused\"")
+	require.Contains(t, code, "Count int32 `json:\"count\"`")
+
+	_, err = bind.ParseWasmSchema(map[string][]byte{"state.json": []byte(`{"type": "object"}`)})
+	require.Error(t, err)
+}
@@ -1,14 +1,18 @@
 package client
 
 import (
+	"errors"
+
 	mevm "github.com/xpladev/xpla.go/core/evm"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-var xplaTxRes types.TxRes
+// The broadcast mode of evm transactions which waits the transaction receipt by BroadcastAndWait.
+const broadcastModeWait = "wait"
 
 // Broadcast the transaction.
 // Default broadcast mode is "sync" if not xpla client has broadcast mode option.
@@ -17,7 +21,7 @@ var xplaTxRes types.TxRes
 func (xplac *xplaClient) Broadcast(txBytes []byte) (*types.TxRes, error) {
 
 	if xplac.GetModule() == mevm.EvmModule {
-		return xplac.broadcastEvm(txBytes)
+		return xplac.broadcastEvm(txBytes, xplac.GetBroadcastMode())
 
 	} else {
 		broadcastMode := xplac.GetBroadcastMode()
@@ -38,7 +42,7 @@ func (xplac *xplaClient) Broadcast(txBytes []byte) (*types.TxRes, error) {
 // It takes precedence over the option of the xpla client.
 func (xplac *xplaClient) BroadcastBlock(txBytes []byte) (*types.TxRes, error) {
 	if xplac.GetModule() == mevm.EvmModule {
-		return xplac.broadcastEvm(txBytes)
+		return xplac.broadcastEvm(txBytes, xplac.GetBroadcastMode())
 	}
 	return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_BLOCK)
 }
@@ -47,13 +51,50 @@ func (xplac *xplaClient) BroadcastBlock(txBytes []byte) (*types.TxRes, error) {
 // It takes precedence over the option of the xpla client.
 func (xplac *xplaClient) BroadcastAsync(txBytes []byte) (*types.TxRes, error) {
 	if xplac.GetModule() == mevm.EvmModule {
-		return xplac.broadcastEvm(txBytes)
+		return xplac.broadcastEvm(txBytes, xplac.GetBroadcastMode())
 	}
 	return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_ASYNC)
 }
 
+// Broadcast the transaction with mode "sync" and wait until the transaction is committed in the block.
+// Unlike the mode "block", the transaction is queried by the hash with backoff until it is found,
+// and the response includes events and the height of the block.
+// The waiting follows the context of the xpla client. If the context has no deadline, it waits for util.DefaultTxWaitTimeout.
+// When the transaction is not committed in time, *types.TxTimeoutError is returned.
+// For evm transaction, it waits the transaction receipt in the same way, and the reverted transaction is returned with the error.
+func (xplac *xplaClient) BroadcastAndWait(txBytes []byte) (*types.TxRes, error) {
+	if xplac.GetModule() == mevm.EvmModule {
+		return xplac.broadcastEvm(txBytes, broadcastModeWait)
+	}
+
+	res, err := broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	if err != nil {
+		return res, err
+	}
+	if res.Response.Code != 0 {
+		return res, xplac.GetLogger().Err(types.ErrWrap(types.ErrTxFailed, "with code", res.Response.Code, ":", res.Response.RawLog))
+	}
+
+	return waitTx(xplac, res.Response.TxHash)
+}
+
+// Query the committed transaction by the hash, and return the result as same as BroadcastAndWait.
+// It is used to check the transaction which was broadcast before, e.g. when waiting is timed out.
+// If the transaction is not committed, *types.TxNotFoundError is returned.
+func (xplac *xplaClient) GetTxRes(txHash string) (*types.TxRes, error) {
+	txResponse, err := getTx(xplac, xplac.GetContext(), txHash)
+	if err != nil {
+		var notFoundErr *types.TxNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+		return nil, xplac.GetLogger().Err(err)
+	}
+	return committedTxRes(xplac, txResponse)
+}
+
 // Broadcast the transaction which is evm transaction by using ethclient of go-ethereum.
-func (xplac *xplaClient) broadcastEvm(txBytes []byte) (*types.TxRes, error) {
+func (xplac *xplaClient) broadcastEvm(txBytes []byte, broadcastMode string) (*types.TxRes, error) {
 	if xplac.GetEvmRpc() == "" {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist"))
 	}
@@ -61,6 +102,10 @@ func (xplac *xplaClient) broadcastEvm(txBytes []byte) (*types.TxRes, error) {
 	if err != nil {
 		return nil, xplac.GetLogger().Err(err)
 	}
-	broadcastMode := xplac.GetBroadcastMode()
-	return broadcastTxEvm(xplac, txBytes, broadcastMode, evmClient)
+	res, err := broadcastTxEvm(xplac, txBytes, broadcastMode, evmClient)
+	if err != nil && xplac.GetSequenceManager() != nil {
+		// The nonce of evm is same as the sequence of the account, so the sequence is loaded again.
+		xplac.GetSequenceManager().Resync(sdk.AccAddress(xplac.GetSigner().PubKey().Address()).String(), "")
+	}
+	return res, err
 }
@@ -1,23 +1,88 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/xpladev/xpla.go/core"
 	mevm "github.com/xpladev/xpla.go/core/evm"
+	mwasm "github.com/xpladev/xpla.go/core/wasm"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Broadcast generated transactions.
+// If the xpla client has the sequence manager, the transaction is signed again and retried
+// when the sequence of the transaction is mismatched.
+func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode) (*types.TxRes, error) {
+	if xplac.GetSequenceManager() == nil {
+		return broadcastTxBytes(xplac, txBytes, mode)
+	}
+
+	sequenceManager := xplac.GetSequenceManager()
+	address := sdk.AccAddress(xplac.GetSigner().PubKey().Address()).String()
+	for retry := 0; ; retry++ {
+		seq, err := getTxSequence(xplac, txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := broadcastTxBytes(xplac, txBytes, mode)
+		if !isSequenceMismatch(res) || retry >= util.DefaultSequenceMismatchRetryCount {
+			// The sequence is not consumed if the transaction is not included in the mempool or the block.
+			if res == nil || res.Response == nil || (res.Response.Code != 0 && res.Response.Height == 0) {
+				sequenceManager.ReleaseSequence(address, seq)
+			}
+			return res, err
+		}
+
+		// If the chain expects the lower sequence, transactions which have previous sequences are
+		// not delivered yet. Broadcast the same transaction again after waiting for them.
+		expected, ok := util.ExpectedSequence(res.Response.RawLog)
+		if _, synced := sequenceManager.Sequence(address); ok && synced && expected < seq {
+			select {
+			case <-xplac.GetContext().Done():
+				return res, xplac.GetLogger().Err(types.ErrWrap(types.ErrTxFailed, xplac.GetContext().Err()))
+			case <-time.After(util.DefaultSequenceMismatchRetryInterval):
+			}
+			continue
+		}
+
+		sequenceManager.Resync(address, res.Response.RawLog)
+		txBytes, err = resignTx(xplac, txBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Check the ABCI code of the response is the account sequence mismatch.
+func isSequenceMismatch(res *types.TxRes) bool {
+	return res != nil &&
+		res.Response != nil &&
+		res.Response.Codespace == sdkerrors.RootCodespace &&
+		res.Response.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
 // Broadcast responses, excluding evm, are delivered as "TxResponse" of the entire response structure of the xpla client.
 // Support broadcast by using LCD and gRPC at the same time. Default method is gRPC.
-func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode) (*types.TxRes, error) {
+func broadcastTxBytes(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode) (*types.TxRes, error) {
+	var xplaTxRes types.TxRes
 	broadcastReq := txtypes.BroadcastTxRequest{
 		TxBytes: txBytes,
 		Mode:    mode,
@@ -44,11 +109,10 @@ func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode)
 		}
 
 		txResponse := broadcastTxResponse.TxResponse
+		xplaTxRes.Response = txResponse
 		if txResponse.Code != 0 {
 			return &xplaTxRes, xplac.GetLogger().Err(types.ErrWrap(types.ErrTxFailed, "with code", txResponse.Code, ":", txResponse.RawLog))
 		}
-
-		xplaTxRes.Response = txResponse
 	} else {
 		txClient := txtypes.NewServiceClient(xplac.GetGrpcClient())
 		txResponse, err := txClient.BroadcastTx(xplac.GetContext(), &broadcastReq)
@@ -58,9 +122,109 @@ func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode)
 		xplaTxRes.Response = txResponse.TxResponse
 	}
 
+	setContractResult(&xplaTxRes)
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return &xplaTxRes, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	if err := mwasm.VerifyStoredCodeChecksum(&xplaTxRes, sdkTx.GetMsgs()); err != nil {
+		return &xplaTxRes, xplac.GetLogger().Err(err)
+	}
 	return &xplaTxRes, nil
 }
 
+// Wait until the broadcasted transaction is committed in the block.
+// The transaction is queried by the hash with backoff, and the interval is doubled after every query.
+func waitTx(xplac *xplaClient, txHash string) (*types.TxRes, error) {
+	ctx := xplac.GetContext()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, util.DefaultTxWaitTimeout)
+		defer cancel()
+	}
+
+	interval := util.DefaultTxWaitInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, &types.TxTimeoutError{TxHash: txHash, Err: ctx.Err()}
+		case <-time.After(interval):
+		}
+
+		// The transaction is not found until it is committed, so only that case is retried until the context is done.
+		txResponse, err := getTx(xplac, ctx, txHash)
+		if err == nil {
+			return committedTxRes(xplac, txResponse)
+		}
+		var notFoundErr *types.TxNotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return nil, xplac.GetLogger().Err(err)
+		}
+
+		interval = interval * 2
+		if interval > util.DefaultTxWaitMaxInterval {
+			interval = util.DefaultTxWaitMaxInterval
+		}
+	}
+}
+
+// Make the result of the committed transaction which includes results of deployed wasm contracts.
+// The failed transaction is returned with the error.
+func committedTxRes(xplac *xplaClient, txResponse *sdk.TxResponse) (*types.TxRes, error) {
+	xplaTxRes := types.TxRes{Response: txResponse}
+	setContractResult(&xplaTxRes)
+	if txResponse.Code != 0 {
+		return &xplaTxRes, xplac.GetLogger().Err(types.ErrWrap(types.ErrTxFailed, "with code", txResponse.Code, ":", txResponse.RawLog))
+	}
+
+	if txResponse.Tx != nil {
+		var sdkTx sdk.Tx
+		if err := xplac.GetEncoding().InterfaceRegistry.UnpackAny(txResponse.Tx, &sdkTx); err != nil {
+			return &xplaTxRes, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+		}
+		if err := mwasm.VerifyStoredCodeChecksum(&xplaTxRes, sdkTx.GetMsgs()); err != nil {
+			return &xplaTxRes, xplac.GetLogger().Err(err)
+		}
+	}
+	return &xplaTxRes, nil
+}
+
+// Query the committed transaction by the hash.
+// Support LCD and gRPC at the same time as broadcasting. Default method is gRPC.
+// If the transaction is not found, *types.TxNotFoundError is returned.
+func getTx(xplac *xplaClient, ctx context.Context, txHash string) (*sdk.TxResponse, error) {
+	if xplac.GetGrpcUrl() == "" {
+		xplac.GetHttpMutex().Lock()
+		out, err := util.CtxHttpClient("GET", xplac.GetLcdURL()+broadcastUrl+"/"+txHash, nil, ctx)
+		if err != nil {
+			xplac.GetHttpMutex().Unlock()
+			var statusErr *types.HttpStatusError
+			if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+				return nil, &types.TxNotFoundError{TxHash: txHash}
+			}
+			return nil, err
+		}
+		xplac.GetHttpMutex().Unlock()
+
+		var getTxResponse txtypes.GetTxResponse
+		if err := core.UnmarshalLcdResponse(core.QueryClient{Ixplac: xplac}, out, &getTxResponse); err != nil {
+			return nil, err
+		}
+		return getTxResponse.TxResponse, nil
+
+	} else {
+		txClient := txtypes.NewServiceClient(xplac.GetGrpcClient())
+		getTxResponse, err := txClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: txHash})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, &types.TxNotFoundError{TxHash: txHash}
+			}
+			return nil, types.ErrWrap(types.ErrGrpcRequest, err)
+		}
+		return getTxResponse.TxResponse, nil
+	}
+}
+
 // Broadcast generated transactions of ethereum type.
 // Broadcast responses, including evm, are delivered as "TxResponse".
 func broadcastTxEvm(xplac *xplaClient, txBytes []byte, broadcastMode string, evmClient *util.EvmClient) (*types.TxRes, error) {
@@ -88,19 +252,25 @@ func broadcastTxEvm(xplac *xplaClient, txBytes []byte, broadcastMode string, evm
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
 		}
 
-		ethPrivKey, err := toECDSA(xplac.GetPrivateKey())
-		if err != nil {
-			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
-		}
-
-		contractAuth, err := bind.NewKeyedTransactorWithChainID(ethPrivKey, deployTx.ChainId)
-		if err != nil {
-			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrInsufficientParams, err))
+		// The deploy transaction is signed by the signer of the xpla client instead of the private key.
+		evmSigner := evmtypes.LatestSignerForChainID(deployTx.ChainId)
+		signer := xplac.GetSigner()
+		contractAuth := &bind.TransactOpts{
+			From: evmAddress(signer),
+			Signer: func(address common.Address, tx *evmtypes.Transaction) (*evmtypes.Transaction, error) {
+				if address != evmAddress(signer) {
+					return nil, bind.ErrNotAuthorized
+				}
+				return signEvmTx(signer, evmSigner, tx)
+			},
+			Context: xplac.GetContext(),
 		}
 		contractAuth.Nonce = deployTx.Nonce
 		contractAuth.Value = deployTx.Value
 		contractAuth.GasLimit = deployTx.GasLimit
 		contractAuth.GasPrice = deployTx.GasPrice
+		contractAuth.GasFeeCap = deployTx.GasFeeCap
+		contractAuth.GasTipCap = deployTx.GasTipCap
 
 		metadata := util.GetBindMetaData(deployTx.ABI, deployTx.Bytecode)
 		parsedAbi, err := metadata.GetAbi()
@@ -112,55 +282,133 @@ func broadcastTxEvm(xplac *xplaClient, txBytes []byte, broadcastMode string, evm
 		}
 		parsedBytecode := common.FromHex(metadata.Bin)
 
-		var transaction *evmtypes.Transaction
-		if mevm.Args == nil {
-			_, transaction, _, err = bind.DeployContract(contractAuth, *parsedAbi, parsedBytecode, evmClient.Client)
-		} else {
-			_, transaction, _, err = bind.DeployContract(contractAuth, *parsedAbi, parsedBytecode, evmClient.Client, mevm.Args...)
-			mevm.Args = nil
+		// Constructor arguments are not kept in the marshaled deploy tx because their types are lost in JSON,
+		// so they are read from the message of the xpla client.
+		var args []interface{}
+		if contractInfo, ok := xplac.GetMsg().(mevm.ContractInfo); ok {
+			args = contractInfo.Args
 		}
+
+		_, transaction, _, err := bind.DeployContract(contractAuth, *parsedAbi, parsedBytecode, evmClient.Client, args...)
 		if err != nil {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
 		}
 
-		return checkEvmBroadcastMode(xplac, broadcastMode, evmClient, transaction)
+		res, err := checkEvmBroadcastMode(xplac, broadcastMode, evmClient, transaction)
+		if res == nil {
+			res = &types.TxRes{}
+		}
+		res.PredictedContractAddress = util.EvmContractAddress(contractAuth.From, transaction.Nonce()).Hex()
+		if err != nil {
+			return res, err
+		}
+		if res.EvmReceipt != nil && res.EvmReceipt.Status == evmtypes.ReceiptStatusSuccessful {
+			res.ContractAddress = res.PredictedContractAddress
+		}
+		return res, nil
 
 	default:
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, "invalid EVM msg type:", xplac.GetMsgType()))
 	}
 }
 
+// Set results of wasm contracts which are deployed by the transaction from events of the response.
+// The code ID is read from the instantiated contract if the transaction does not store the code.
+func setContractResult(xplaTxRes *types.TxRes) {
+	if xplaTxRes.Response == nil {
+		return
+	}
+
+	for _, log := range xplaTxRes.Response.Logs {
+		for _, event := range log.Events {
+			if event.Type != wasmtypes.EventTypeStoreCode && event.Type != wasmtypes.EventTypeInstantiate {
+				continue
+			}
+
+			var codeID uint64
+			var checksum, contractAddress string
+			for _, attribute := range event.Attributes {
+				switch attribute.Key {
+				case wasmtypes.AttributeKeyCodeID:
+					codeID, _ = strconv.ParseUint(attribute.Value, 10, 64)
+				case wasmtypes.AttributeKeyChecksum:
+					checksum = attribute.Value
+				case wasmtypes.AttributeKeyContractAddr:
+					contractAddress = attribute.Value
+				}
+			}
+
+			if event.Type == wasmtypes.EventTypeStoreCode && xplaTxRes.Checksum == "" {
+				xplaTxRes.CodeID = codeID
+				xplaTxRes.Checksum = checksum
+			}
+			if event.Type == wasmtypes.EventTypeInstantiate && xplaTxRes.ContractAddress == "" {
+				xplaTxRes.ContractAddress = contractAddress
+				if xplaTxRes.CodeID == 0 {
+					xplaTxRes.CodeID = codeID
+				}
+			}
+		}
+	}
+}
+
 // Handle evm broadcast mode.
 // Similarly, determine broadcast mode included in the options of xpla client.
+// If the transaction receipt is waited and the transaction is reverted, the receipt is returned with the error.
 func checkEvmBroadcastMode(xplac *xplaClient, broadcastMode string, evmClient *util.EvmClient, tx *evmtypes.Transaction) (*types.TxRes, error) {
-	// Wait tx receipt (Broadcast Block)
-	if broadcastMode == "block" {
-		receipt, err := waitTxReceipt(evmClient, tx)
-		if err != nil {
-			return nil, xplac.GetLogger().Err(err)
-		}
-		xplaTxRes.EvmReceipt = receipt
-		return &xplaTxRes, nil
-	} else {
+	var timeout time.Duration
+	switch broadcastMode {
+	case "block":
+		timeout = time.Duration(util.DefaultEvmTxReceiptTimeout) * time.Second
+	case broadcastModeWait:
+		timeout = util.DefaultTxWaitTimeout
+	default:
 		return nil, nil
 	}
+
+	receipt, err := waitTxReceipt(xplac, evmClient, tx, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.TxRes{EvmReceipt: receipt}
+	if receipt.Status != evmtypes.ReceiptStatusSuccessful {
+		return res, xplac.GetLogger().Err(types.ErrWrap(types.ErrTxFailed, "evm transaction", tx.Hash().Hex(), "is reverted"))
+	}
+	return res, nil
 }
 
-// If broadcast mode is "block", client waits transaction receipt of evm.
-// The wait time is in seconds and is set inside the library as timeout count.
-// When the timeout occurs, no longer wait for the transaction receipt.
-func waitTxReceipt(evmClient *util.EvmClient, signedTx *evmtypes.Transaction) (*evmtypes.Receipt, error) {
-	count := util.DefaultEvmTxReceiptTimeout
+// Wait the transaction receipt of evm until the transaction is committed in the block.
+// The receipt is queried with backoff as same as waiting the cosmos transaction, and the waiting follows
+// the context of the xpla client. If the context has no deadline, it waits for the timeout.
+// When the transaction is not committed in time, *types.TxTimeoutError is returned.
+func waitTxReceipt(xplac *xplaClient, evmClient *util.EvmClient, signedTx *evmtypes.Transaction, timeout time.Duration) (*evmtypes.Receipt, error) {
+	ctx := xplac.GetContext()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	interval := util.DefaultTxWaitInterval
 	for {
-		receipt, err := evmClient.Client.TransactionReceipt(evmClient.Ctx, signedTx.Hash())
-		if err != nil {
-			count = count - 1
-			if count < 0 {
-				return nil, types.ErrWrap(types.ErrEvmRpcRequest, "cannot receive the transaction receipt in count time is", count)
-			}
-			time.Sleep(time.Second * 1)
-		} else {
+		select {
+		case <-ctx.Done():
+			return nil, &types.TxTimeoutError{TxHash: signedTx.Hash().Hex(), Err: ctx.Err()}
+		case <-time.After(interval):
+		}
+
+		receipt, err := evmClient.Client.TransactionReceipt(ctx, signedTx.Hash())
+		if err == nil {
 			return receipt, nil
 		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
+		}
+
+		interval = interval * 2
+		if interval > util.DefaultTxWaitMaxInterval {
+			interval = util.DefaultTxWaitMaxInterval
+		}
 	}
 }
@@ -1,23 +1,35 @@
 package client_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/evmos/ethermint/crypto/hd"
+	"github.com/xpladev/xpla.go/client"
 	"github.com/xpladev/xpla.go/key"
 	"github.com/xpladev/xpla.go/provider"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
+	"github.com/xpladev/xpla.go/util/testutil"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/jsonpb"
 )
 
@@ -50,7 +62,7 @@ func (s *ClientTestSuite) TestBroadcast() {
 		bankSendMsg := types.BankSendMsg{
 			FromAddress: from.Address.String(),
 			ToAddress:   to.Address.String(),
-			Amount:      testSendAmount,
+			Amount:      testSendAmount + types.XplaDenom,
 		}
 		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
 		s.Require().NoError(err)
@@ -94,7 +106,7 @@ func (s *ClientTestSuite) TestBroadcastMode() {
 		bankSendMsg := types.BankSendMsg{
 			FromAddress: from.Address.String(),
 			ToAddress:   to.Address.String(),
-			Amount:      testSendAmount,
+			Amount:      testSendAmount + types.XplaDenom,
 		}
 		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
 		s.Require().NoError(err)
@@ -111,6 +123,37 @@ func (s *ClientTestSuite) TestBroadcastMode() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *ClientTestSuite) TestBroadcastAndWait() {
+	from := s.network.Validators[0].AdditionalAccount
+	to := s.network.Validators[1].AdditionalAccount
+
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId).
+			WithPrivateKey(from.PrivKey)
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		bankSendMsg := types.BankSendMsg{
+			FromAddress: from.Address.String(),
+			ToAddress:   to.Address.String(),
+			Amount:      testSendAmount + types.XplaDenom,
+		}
+		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		// the transaction is committed without waiting for next block
+		res, err := xplac.BroadcastAndWait(txbytes)
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(0), res.Response.Code)
+		s.Require().NotZero(res.Response.Height)
+		s.Require().NotEmpty(res.Response.TxHash)
+		s.Require().NotEmpty(res.Response.Events)
+	}
+}
+
 func (s *ClientTestSuite) TestBroadcastEVM() {
 	from := s.network.Validators[2].AdditionalAccount
 	to := s.network.Validators[0].AdditionalAccount
@@ -158,6 +201,329 @@ func (s *ClientTestSuite) TestBroadcastEVM() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *ClientTestSuite) TestBroadcastEVMDynamicFee() {
+	from := s.network.Validators[3].AdditionalAccount
+	to := s.network.Validators[0].AdditionalAccount
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithURL(s.apis[0]).
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithEvmDynamicFee(true)
+
+	// send coin by the dynamic fee which is suggested by the fee history
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err := xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	var signedTx evmtypes.Transaction
+	s.Require().NoError(signedTx.UnmarshalJSON(txbytes))
+	s.Require().Equal(uint8(evmtypes.DynamicFeeTxType), signedTx.Type())
+	s.Require().True(signedTx.GasFeeCap().Cmp(signedTx.GasTipCap()) >= 0)
+
+	res, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+
+	// deploy contract by the dynamic fee
+	xplac.WithAccountNumber("").WithSequence("")
+	deploySolContractMsg := types.DeploySolContractMsg{
+		ABIJsonFilePath:      "../util/testutil/test_files/abi.json",
+		BytecodeJsonFilePath: "../util/testutil/test_files/bytecode.json",
+		Args:                 nil,
+	}
+	txbytes, err = xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+
+	evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), context.Background())
+	s.Require().NoError(err)
+	deployTx, _, err := evmClient.Client.TransactionByHash(context.Background(), res.EvmReceipt.TxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(uint8(evmtypes.DynamicFeeTxType), deployTx.Type())
+
+	// the contract address is computed by the sender and the nonce, and set because the receipt is successful
+	s.Require().Equal(res.EvmReceipt.ContractAddress.Hex(), res.ContractAddress)
+	s.Require().Equal(util.EvmContractAddress(common.BytesToAddress(xplac.GetFromAddress()), deployTx.Nonce()).Hex(), res.PredictedContractAddress)
+	s.Require().Equal(res.PredictedContractAddress, res.ContractAddress)
+
+	// max fee and max priority fee are set manually
+	xplac.WithAccountNumber("").WithSequence("").
+		WithEvmDynamicFee(false).
+		WithGasFeeCap(types.DefaultGasPrice + types.XplaDenom).
+		WithGasTipCap("1")
+	txbytes, err = xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	s.Require().NoError(signedTx.UnmarshalJSON(txbytes))
+	s.Require().Equal(uint8(evmtypes.DynamicFeeTxType), signedTx.Type())
+	s.Require().Equal(types.DefaultGasPrice, signedTx.GasFeeCap().String())
+	s.Require().Equal("1", signedTx.GasTipCap().String())
+
+	res, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+
+	// the dynamic fee cannot be suggested without the evm RPC
+	_, err = client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithAccountNumber("0").
+		WithSequence("0").
+		WithEvmDynamicFee(true).
+		EvmSendCoin(sendCoinMsg).
+		CreateAndSignTx()
+	s.Require().Error(err)
+}
+
+func (s *ClientTestSuite) TestBroadcastAndWaitEVMReceipt() {
+	from := s.network.Validators[0].AdditionalAccount
+	to := s.network.Validators[1].AdditionalAccount
+
+	// the evm RPC which accepts transactions and returns the receipt if it is set
+	var receipt *evmtypes.Receipt
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		s.Require().NoError(json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		if req.Method == "eth_getTransactionReceipt" && receipt != nil {
+			result = receipt
+		}
+		s.Require().NoError(json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.Id,
+			"result":  result,
+		}))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithAccountNumber("0").
+		WithSequence("0").
+		WithEvmRpc(server.URL).
+		WithContext(ctx)
+
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err := xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	var signedTx evmtypes.Transaction
+	s.Require().NoError(signedTx.UnmarshalJSON(txbytes))
+
+	// the receipt is not found until the context is done
+	_, err = xplac.BroadcastAndWait(txbytes)
+	var timeoutErr *types.TxTimeoutError
+	s.Require().ErrorAs(err, &timeoutErr)
+	s.Require().Equal(signedTx.Hash().Hex(), timeoutErr.TxHash)
+
+	// the reverted transaction is failed with the receipt
+	receipt = &evmtypes.Receipt{
+		Type:              signedTx.Type(),
+		Status:            evmtypes.ReceiptStatusFailed,
+		CumulativeGasUsed: signedTx.Gas(),
+		Logs:              []*evmtypes.Log{},
+		TxHash:            signedTx.Hash(),
+		GasUsed:           signedTx.Gas(),
+		BlockNumber:       big.NewInt(1),
+	}
+	xplac.WithContext(context.Background())
+	res, err := xplac.EvmSendCoin(sendCoinMsg).BroadcastAndWait(txbytes)
+	s.Require().Error(err)
+	s.Require().NotNil(res)
+	s.Require().Equal(evmtypes.ReceiptStatusFailed, res.EvmReceipt.Status)
+
+	// the contract address of the failed deployment is only predicted
+	txbytes, err = xplac.WithGasLimit(types.DefaultGasLimit).DeploySolidityContract(types.DeploySolContractMsg{
+		ABIJsonFilePath:      "../util/testutil/test_files/abi.json",
+		BytecodeJsonFilePath: "../util/testutil/test_files/bytecode.json",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().Error(err)
+	s.Require().NotNil(res)
+	s.Require().Empty(res.ContractAddress)
+	s.Require().Equal(util.EvmContractAddress(common.BytesToAddress(xplac.GetFromAddress()), 0).Hex(), res.PredictedContractAddress)
+}
+
+func (s *ClientTestSuite) TestBroadcastWithSequenceManager() {
+	from := s.network.Validators[3].AdditionalAccount
+	to := s.network.Validators[0].AdditionalAccount
+	sequenceManager := util.NewSequenceManager()
+
+	newXplac := func() provider.XplaClient {
+		return client.NewXplaClient(testutil.TestChainId).
+			WithPrivateKey(from.PrivKey).
+			WithGrpc(s.apis[1]).
+			WithGasLimit(types.DefaultGasLimit).
+			WithSequenceManager(sequenceManager)
+	}
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount + types.XplaDenom,
+	}
+	broadcast := func(xplac provider.XplaClient, bankSendMsg types.BankSendMsg) error {
+		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+		if err != nil {
+			return err
+		}
+		res, err := xplac.Broadcast(txbytes)
+		if err != nil {
+			return err
+		}
+		if res.Response.Code != 0 {
+			return fmt.Errorf("code %d: %s", res.Response.Code, res.Response.RawLog)
+		}
+		return nil
+	}
+
+	// broadcast transactions of one account in several goroutines
+	txNumber := 10
+	var wg sync.WaitGroup
+	errs := make(chan error, txNumber)
+	for i := 0; i < txNumber; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := broadcast(newXplac(), bankSendMsg); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.Require().NoError(err)
+	}
+	seq, ok := sequenceManager.Sequence(from.Address.String())
+	s.Require().True(ok)
+
+	// the transactions in the mempool may be committed over several blocks
+	var (
+		account authtypes.AccountI
+		err     error
+	)
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.network.WaitForNextBlock())
+		account, err = s.xplac.WithGrpc(s.apis[1]).LoadAccount(from.Address)
+		s.Require().NoError(err)
+		if account.GetSequence() == seq {
+			break
+		}
+	}
+	s.Require().Equal(account.GetSequence(), seq)
+
+	// the sequence is consumed by the transaction which is not tracked by the sequence manager
+	untrackedXplac := newXplac().
+		WithSequenceManager(nil).
+		WithAccountNumber(util.FromUint64ToString(account.GetAccountNumber())).
+		WithSequence(util.FromUint64ToString(seq))
+	untrackedBankSendMsg := bankSendMsg
+	untrackedBankSendMsg.Amount = "1" + types.XplaDenom
+	s.Require().NoError(broadcast(untrackedXplac, untrackedBankSendMsg))
+
+	// resync the sequence when the sequence is mismatched, and retry
+	s.Require().NoError(broadcast(newXplac(), bankSendMsg))
+	seq, ok = sequenceManager.Sequence(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(account.GetSequence()+2, seq)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// the reserved sequence is released when signing is failed
+	failedXplac := newXplac().
+		WithSigner(failingSigner{key.NewPrivKeySigner(from.PrivKey)})
+	_, err = failedXplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().Error(err)
+	releasedSeq, ok := sequenceManager.Sequence(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(seq, releasedSeq)
+
+	// the next transaction is signed with the released sequence
+	s.Require().NoError(broadcast(newXplac(), bankSendMsg))
+	seq, ok = sequenceManager.Sequence(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(releasedSeq+1, seq)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+// The signer which fails to sign transactions.
+type failingSigner struct {
+	key.Signer
+}
+
+func (failingSigner) Sign([]byte) ([]byte, error) {
+	return nil, fmt.Errorf("failed to sign")
+}
+
+func (s *ClientTestSuite) TestBroadcastWithSigner() {
+	from := s.network.Validators[2].AdditionalAccount
+	to := s.network.Validators[0].AdditionalAccount
+
+	// the private key is kept in the signing server, not the xpla client
+	server := httptest.NewServer(key.NewSignerHandler(key.NewPrivKeySigner(from.PrivKey)))
+	defer server.Close()
+
+	signer, err := key.NewRemoteSigner(server.URL, context.Background())
+	s.Require().NoError(err)
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithSigner(signer)
+	s.Require().Nil(xplac.GetPrivateKey())
+	s.Require().Equal(from.Address, xplac.GetFromAddress())
+
+	// cosmos transaction
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount + types.XplaDenom,
+	}
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Response.Code)
+
+	// evm transaction
+	xplac.WithAccountNumber("").WithSequence("")
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err = xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+
+	// without private key and signer
+	_, err = client.NewXplaClient(testutil.TestChainId).BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().Error(err)
+}
+
 func (s *ClientTestSuite) TestBroadcastSolidityContract() {
 	xplac := s.xplac.WithPrivateKey(s.network.Validators[3].AdditionalAccount.PrivKey).
 		WithURL(s.apis[0]).
@@ -249,7 +615,7 @@ func (s *ClientTestSuite) TestMultiSignature() {
 	bankSendMsg2 := types.BankSendMsg{
 		FromAddress: multiKeyInfo.GetAddress().String(),
 		ToAddress:   key1.Address.String(),
-		Amount:      "10",
+		Amount:      "10axpla",
 	}
 
 	_, err = xplac.BankSend(bankSendMsg2).CreateUnsignedTx()
@@ -48,7 +48,7 @@ func (s *ClientTestSuite) TestSimulate() {
 			Granter:           s.network.Validators[4].AdditionalAccount.Address.String(),
 			Grantee:           val1.String(),
 			AuthorizationType: "send",
-			SpendLimit:        "1000",
+			SpendLimit:        "1000axpla",
 		}
 
 		xplac = s.xplac.AuthzGrant(authzGrantMsg)
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmhd "github.com/evmos/ethermint/crypto/hd"
+)
+
+// List keys in the keyring of the xpla client.
+func (xplac *xplaClient) ListKeys() ([]keyring.Info, error) {
+	kr, err := xplac.keyringOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := kr.List()
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrCannotRead, err))
+	}
+	return infos, nil
+}
+
+// Add the key which is derived from the mnemonic words to the keyring.
+// The key is derived by the same way as key.NewPrivKey, so the address is same as the private key of the mnemonic.
+func (xplac *xplaClient) AddKey(name string, mnemonic string) (keyring.Info, error) {
+	kr, err := xplac.keyringOrErr()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := kr.Key(name); err == nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrAlreadyExist, "key", name, "already exists"))
+	}
+
+	info, err := kr.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path(), evmhd.EthSecp256k1)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	return info, nil
+}
+
+// Import the ASCII armored private key which is encrypted by the passphrase to the keyring.
+// The armor is compatible with key.EncryptArmorPrivKey.
+func (xplac *xplaClient) ImportKey(name string, armor string, passphrase string) error {
+	kr, err := xplac.keyringOrErr()
+	if err != nil {
+		return err
+	}
+
+	if err := kr.ImportPrivKey(name, armor, passphrase); err != nil {
+		return xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	return nil
+}
+
+// Export the private key in the keyring as the ASCII armor which is encrypted by the passphrase.
+// The armor can be decrypted by key.UnarmorDecryptPrivKey.
+func (xplac *xplaClient) ExportKey(name string, passphrase string) (string, error) {
+	kr, err := xplac.keyringOrErr()
+	if err != nil {
+		return "", err
+	}
+
+	armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return "", xplac.GetLogger().Err(types.ErrWrap(types.ErrKeyNotFound, err))
+	}
+	return armor, nil
+}
+
+// Delete the key in the keyring.
+func (xplac *xplaClient) DeleteKey(name string) error {
+	kr, err := xplac.keyringOrErr()
+	if err != nil {
+		return err
+	}
+
+	if err := kr.Delete(name); err != nil {
+		return xplac.GetLogger().Err(types.ErrWrap(types.ErrKeyNotFound, err))
+	}
+	return nil
+}
+
+// Keys can be managed before the key of the from name is added, so the error of the xpla client is not checked.
+func (xplac *xplaClient) keyringOrErr() (keyring.Keyring, error) {
+	if xplac.GetKeyring() == nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "keyring of xpla client's option must exist"))
+	}
+	return xplac.GetKeyring(), nil
+}
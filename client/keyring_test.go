@@ -0,0 +1,137 @@
+package client_test
+
+import (
+	"github.com/xpladev/xpla.go/client"
+	"github.com/xpladev/xpla.go/key"
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+	"github.com/xpladev/xpla.go/util/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (s *ClientTestSuite) TestKeyring() {
+	keyringDir := s.T().TempDir()
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithKeyring(util.BackendTest, keyringDir)
+	s.Require().NoError(xplac.GetErr())
+
+	// add key by mnemonic
+	mnemonic, err := key.NewMnemonic()
+	s.Require().NoError(err)
+	privKey, err := key.NewPrivKey(mnemonic)
+	s.Require().NoError(err)
+
+	info, err := xplac.AddKey("key1", mnemonic)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.AccAddress(privKey.PubKey().Address()), info.GetAddress())
+
+	_, err = xplac.AddKey("key1", mnemonic)
+	s.Require().Error(err)
+
+	// import and export key
+	from := s.network.Validators[2].AdditionalAccount
+	armor, err := key.EncryptArmorPrivKey(from.PrivKey, key.DefaultEncryptPassphrase)
+	s.Require().NoError(err)
+	s.Require().NoError(xplac.ImportKey("key2", armor, key.DefaultEncryptPassphrase))
+
+	exported, err := xplac.ExportKey("key2", key.DefaultEncryptPassphrase)
+	s.Require().NoError(err)
+	exportedPrivKey, _, err := key.UnarmorDecryptPrivKey(exported, key.DefaultEncryptPassphrase)
+	s.Require().NoError(err)
+	s.Require().True(from.PrivKey.Equals(exportedPrivKey))
+
+	infos, err := xplac.ListKeys()
+	s.Require().NoError(err)
+	s.Require().Len(infos, 2)
+
+	// delete key
+	s.Require().NoError(xplac.DeleteKey("key1"))
+	s.Require().Error(xplac.DeleteKey("key1"))
+
+	infos, err = xplac.ListKeys()
+	s.Require().NoError(err)
+	s.Require().Len(infos, 1)
+
+	// without keyring
+	_, err = client.NewXplaClient(testutil.TestChainId).ListKeys()
+	s.Require().Error(err)
+
+	// key which does not exist
+	s.Require().Error(client.NewXplaClient(testutil.TestChainId).
+		WithKeyring(util.BackendTest, keyringDir).
+		WithFromName("key1").
+		GetErr())
+
+	// the key which is set last signs transactions
+	other := s.network.Validators[3].AdditionalAccount
+	xplac = client.NewXplaClient(testutil.TestChainId).
+		WithKeyring(util.BackendTest, keyringDir).
+		WithFromName("key2").
+		WithPrivateKey(other.PrivKey)
+	s.Require().NoError(xplac.GetErr())
+	s.Require().Empty(xplac.GetFromName())
+	s.Require().Equal(other.Address, xplac.GetFromAddress())
+	s.Require().Equal(other.PubKey.Address(), xplac.GetSigner().PubKey().Address())
+
+	// the keyring is kept to manage keys
+	infos, err = xplac.ListKeys()
+	s.Require().NoError(err)
+	s.Require().Len(infos, 1)
+
+	xplac.WithFromName("key2")
+	s.Require().NoError(xplac.GetErr())
+	s.Require().Nil(xplac.GetPrivateKey())
+	s.Require().Equal(from.Address, xplac.GetFromAddress())
+	s.Require().Equal(from.PubKey.Address(), xplac.GetSigner().PubKey().Address())
+}
+
+func (s *ClientTestSuite) TestBroadcastWithKeyring() {
+	from := s.network.Validators[2].AdditionalAccount
+	to := s.network.Validators[0].AdditionalAccount
+
+	keyringDir := s.T().TempDir()
+	armor, err := key.EncryptArmorPrivKey(from.PrivKey, key.DefaultEncryptPassphrase)
+	s.Require().NoError(err)
+	s.Require().NoError(client.NewXplaClient(testutil.TestChainId).
+		WithKeyring(util.BackendTest, keyringDir).
+		ImportKey("from", armor, key.DefaultEncryptPassphrase))
+
+	// the private key is kept in the keyring, not the xpla client
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithEvmRpc("http://"+s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithKeyring(util.BackendTest, keyringDir).
+		WithFromName("from")
+	s.Require().NoError(xplac.GetErr())
+	s.Require().Nil(xplac.GetPrivateKey())
+	s.Require().Equal(from.Address, xplac.GetFromAddress())
+
+	// cosmos transaction
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount + types.XplaDenom,
+	}
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(0), res.Response.Code)
+
+	// evm transaction
+	xplac.WithAccountNumber("").WithSequence("")
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err = xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+}
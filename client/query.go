@@ -6,6 +6,8 @@ import (
 
 	mevm "github.com/xpladev/xpla.go/core/evm"
 	"github.com/xpladev/xpla.go/types"
+
+	"github.com/gogo/protobuf/proto"
 )
 
 // Query transactions and xpla blockchain information.
@@ -31,6 +33,23 @@ func (xplac *xplaClient) Query() (string, error) {
 	return controller.Controller().Get(xplac.GetModule()).NewQueryRouter(*queryClient)
 }
 
+// Query transactions and xpla blockchain information, and return the response as protobuf message.
+// The type of the response is the same regardless of the query type (gRPC or LCD),
+// thus it can be converted to the response type of each module's query (e.g. *banktypes.QueryAllBalancesResponse).
+// The query of the EVM module is not supported because its response is not protobuf message.
+func (xplac *xplaClient) QueryProto() (proto.Message, error) {
+	if xplac.GetErr() != nil {
+		return nil, xplac.GetErr()
+	}
+
+	if xplac.GetGrpcUrl() == "" && xplac.GetLcdURL() == "" {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "at least one of the gRPC URL or LCD URL must exist for query"))
+	}
+	queryClient := core.NewIxplaClient(xplac, setQueryType(xplac))
+
+	return controller.Controller().Get(xplac.GetModule()).NewQueryProtoRouter(*queryClient)
+}
+
 func setQueryType(xplac *xplaClient) uint8 {
 	// Default query type is gRPC, not LCD.
 	if xplac.GetGrpcUrl() != "" {
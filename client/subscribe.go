@@ -0,0 +1,39 @@
+package client
+
+import (
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Subscribe events of the query by using the websocket of the tendermint RPC URL.
+// The query follows the syntax of tendermint, e.g. "tm.event='Tx' AND transfer.recipient='xpla1...'".
+// Events are delivered on the channel of the subscription until the subscription is closed or the context of the xpla client is done.
+// When the connection is lost, the subscription reconnects and subscribes the query again.
+func (xplac *xplaClient) Subscribe(query string) (*util.Subscription, error) {
+	if xplac.GetRpc() == "" {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "tendermint RPC URL must exist"))
+	}
+
+	subscription, err := util.NewSubscription(xplac.GetContext(), xplac.GetRpc(), query)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(err)
+	}
+	return subscription, nil
+}
+
+// Subscribe new blocks.
+func (xplac *xplaClient) SubscribeNewBlock() (*util.Subscription, error) {
+	return xplac.Subscribe(tmtypes.QueryForEvent(tmtypes.EventNewBlock).String())
+}
+
+// Subscribe transactions.
+// If the query is not empty, only transactions which match the query are delivered.
+func (xplac *xplaClient) SubscribeTx(query string) (*util.Subscription, error) {
+	txQuery := tmtypes.QueryForEvent(tmtypes.EventTx).String()
+	if query != "" {
+		txQuery = txQuery + " AND " + query
+	}
+	return xplac.Subscribe(txQuery)
+}
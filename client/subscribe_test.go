@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"time"
+
+	"github.com/xpladev/xpla.go/client"
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util/testutil"
+)
+
+func (s *ClientTestSuite) TestSubscribe() {
+	from := s.network.Validators[1].AdditionalAccount
+	to := s.network.Validators[2].AdditionalAccount
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithRpc(s.network.Validators[0].RPCAddress).
+		WithPrivateKey(from.PrivKey)
+
+	// subscribe new block
+	blockSubscription, err := xplac.SubscribeNewBlock()
+	s.Require().NoError(err)
+
+	select {
+	case event := <-blockSubscription.Events():
+		s.Require().NotNil(event.NewBlock)
+		s.Require().Nil(event.Tx)
+		s.Require().NotZero(event.NewBlock.Block.Height)
+	case <-time.After(30 * time.Second):
+		s.Require().Fail("new block event is not delivered")
+	}
+
+	// the event channel is closed after the subscription is closed
+	blockSubscription.Close()
+	for range blockSubscription.Events() {
+	}
+
+	// subscribe tx of the recipient
+	txSubscription, err := xplac.SubscribeTx("transfer.recipient='" + to.Address.String() + "'")
+	s.Require().NoError(err)
+	defer txSubscription.Close()
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount + types.XplaDenom,
+	}
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	select {
+	case event := <-txSubscription.Events():
+		s.Require().NotNil(event.Tx)
+		s.Require().Equal(res.Response.Height, event.Tx.Height)
+		s.Require().Contains(event.Events["transfer.recipient"], to.Address.String())
+		s.Require().Contains(event.Events["tx.hash"], res.Response.TxHash)
+	case <-time.After(30 * time.Second):
+		s.Require().Fail("tx event is not delivered")
+	}
+
+	// invalid query
+	_, err = xplac.Subscribe("invalid query")
+	s.Require().Error(err)
+
+	// without RPC URL
+	_, err = client.NewXplaClient(testutil.TestChainId).SubscribeNewBlock()
+	s.Require().Error(err)
+}
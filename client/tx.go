@@ -3,8 +3,6 @@ package client
 import (
 	"encoding/base64"
 	"encoding/json"
-	"os"
-	"path/filepath"
 	"strings"
 
 	mevm "github.com/xpladev/xpla.go/core/evm"
@@ -16,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -28,16 +25,28 @@ import (
 // Create and sign a transaction before it is broadcasted to xpla chain.
 // Options required for create and sign are stored in the xpla client and reflected when the values of those options exist.
 // Create and sign transaction must be needed in order to send transaction to the chain.
-func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
-	var err error
+// Appended messages and the memo are removed whether the transaction is created or not.
+// If the sequence is reserved from the sequence manager and the transaction is not created, the sequence is released.
+func (xplac *xplaClient) CreateAndSignTx() (_ []byte, err error) {
+	defer clearTxRequest(xplac)
+
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
 	}
 
+	if xplac.GetSigner() == nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option"))
+	}
+
 	xplac, err = getAccNumAndSeq(xplac)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		if err != nil {
+			releaseSequence(xplac)
+		}
+	}()
 
 	if xplac.GetGasAdjustment() == "" {
 		xplac.WithGasAdjustment(types.DefaultGasAdjustment)
@@ -48,6 +57,9 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 	}
 
 	if xplac.GetModule() == mevm.EvmModule {
+		if xplac.msgsErr != nil || len(xplac.GetMsgs()) > 0 {
+			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "evm message cannot be included in the transaction with other messages"))
+		}
 		return xplac.createAndSignEvmTx()
 
 	} else {
@@ -71,7 +83,7 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 			xplac.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
 		}
 
-		privs := []cryptotypes.PrivKey{xplac.GetPrivateKey()}
+		signers := []key.Signer{xplac.GetSigner()}
 
 		accNumU64, err := util.FromStringToUint64(xplac.GetAccountNumber())
 		if err != nil {
@@ -86,7 +98,7 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 
 		var sigsV2 []signing.SignatureV2
 
-		err = txSignRound(xplac, sigsV2, privs, accSeqs, accNums, builder)
+		err = txSignRound(xplac, sigsV2, signers, accSeqs, accNums, builder)
 		if err != nil {
 			return nil, err
 		}
@@ -118,7 +130,10 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 // Create transaction with unsigning.
 // It returns txbytes of byte type when output document options is nil.
 // If not, save the unsigned transaction file which name is "outputDocument"
+// Appended messages and the memo are removed whether the transaction is created or not.
 func (xplac *xplaClient) CreateUnsignedTx() ([]byte, error) {
+	defer clearTxRequest(xplac)
+
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
 	}
@@ -178,6 +193,10 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "need sign tx message of xpla client's option"))
 	}
 
+	if xplac.GetSigner() == nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option"))
+	}
+
 	if !signTxMsg.Offline {
 		xplac, err = getAccNumAndSeq(xplac)
 		if err != nil {
@@ -190,20 +209,7 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 		return nil, xplac.GetLogger().Err(err)
 	}
 
-	fromName := types.XplaToolDefaultName
-	armor, err := key.EncryptArmorPrivKey(xplac.GetPrivateKey(), key.DefaultEncryptPassphrase)
-	if err != nil {
-		return nil, xplac.GetLogger().Err(err)
-	}
-
-	err = clientCtx.Keyring.ImportPrivKey(fromName, armor, key.DefaultEncryptPassphrase)
-	if err != nil {
-		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrKeyNotFound, err))
-	}
-
-	clientCtx.WithSignModeStr("direct")
-
-	clientCtx, txFactory, newTx, err := readTxAndInitContexts(xplac.GetLogger(), clientCtx, signTxMsg.UnsignedFileName)
+	clientCtx, _, newTx, err := readTxAndInitContexts(xplac.GetLogger(), clientCtx, signTxMsg.UnsignedFileName)
 	if err != nil {
 		return nil, err
 	}
@@ -236,18 +242,18 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 			multisigAccSeq = signerAcc.GetSequence()
 		}
 
-		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).
-			WithChainID(xplac.GetChainId()).
-			WithAccountNumber(multisigAccNum).
-			WithSequence(multisigAccSeq)
-
 		if !isTxSigner(multisigAddr, txBuilder.GetTx().GetSigners()) {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, "invalid signer"))
 		}
 
-		err = tx.Sign(txFactory, fromName, txBuilder, signTxMsg.Overwrite)
+		signerData := authsigning.SignerData{
+			ChainID:       xplac.GetChainId(),
+			AccountNumber: multisigAccNum,
+			Sequence:      multisigAccSeq,
+		}
+		err = txSignWithSigner(xplac, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder, signTxMsg.Overwrite)
 		if err != nil {
-			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+			return nil, err
 		}
 		signatureOnly = true
 	} else {
@@ -265,13 +271,13 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 		}
 
-		privs := []cryptotypes.PrivKey{xplac.GetPrivateKey()}
+		signers := []key.Signer{xplac.GetSigner()}
 		accNums := []uint64{accNumU64}
 		accSeqs := []uint64{accSeqU64}
 
 		var sigsV2 []signing.SignatureV2
 
-		err = txSignRound(xplac, sigsV2, privs, accSeqs, accNums, txBuilder)
+		err = txSignRound(xplac, sigsV2, signers, accSeqs, accNums, txBuilder)
 		if err != nil {
 			return nil, err
 		}
@@ -325,15 +331,9 @@ func (xplac *xplaClient) MultiSign(txMultiSignMsg types.TxMultiSignMsg) ([]byte,
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, "invalid keyring backend, must be "+util.BackendFile+", "+util.BackendTest+" or "+util.BackendMemory))
 	}
 
-	keyringPath := txMultiSignMsg.KeyringPath
-	if (txMultiSignMsg.KeyringBackend == keyring.BackendFile ||
-		txMultiSignMsg.KeyringBackend == keyring.BackendTest) && txMultiSignMsg.KeyringPath == "" {
-		userHomeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
-		}
-
-		keyringPath = filepath.Join(userHomeDir, ".xpla")
+	keyringPath, err := util.KeyringPath(txMultiSignMsg.KeyringBackend, txMultiSignMsg.KeyringPath)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(err)
 	}
 
 	newKeyring, err := util.NewKeyring(txMultiSignMsg.KeyringBackend, keyringPath)
@@ -471,11 +471,6 @@ func (xplac *xplaClient) MultiSign(txMultiSignMsg types.TxMultiSignMsg) ([]byte,
 
 // Create and sign transaction of evm.
 func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
-	ethPrivKey, err := toECDSA(xplac.GetPrivateKey())
-	if err != nil {
-		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
-	}
-
 	chainId, err := util.ConvertEvmChainId(xplac.GetChainId())
 	if err != nil {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
@@ -485,11 +480,16 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 		xplac.GetLogger().Info("no create output document as tx of evm")
 	}
 
-	gasPrice, err := util.FromStringToBigInt(xplac.GetGasPrice())
+	gasPrice, err := util.EvmGasPrice(xplac.GetGasPrice())
 	if err != nil {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 	}
 
+	dynamicFee, err := getEvmDynamicFee(xplac)
+	if err != nil {
+		return nil, err
+	}
+
 	switch {
 	case xplac.GetMsgType() == mevm.EvmSendCoinMsgType:
 		gasLimit := xplac.GetGasLimit()
@@ -515,7 +515,7 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 		}
 
-		return evmTxSignRound(xplac, toAddr, gasPrice, gasLimit, amount, nil, chainId, ethPrivKey)
+		return evmTxSignRound(xplac, toAddr, gasPrice, dynamicFee, gasLimit, amount, nil, chainId)
 
 	case xplac.GetMsgType() == mevm.EvmDeploySolContractMsgType:
 		gasLimit := xplac.GetGasLimit()
@@ -543,13 +543,19 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 		}
 
 		tx := mevm.DeploySolTx{
-			ChainId:  chainId,
-			Nonce:    nonce,
-			Value:    value,
-			GasLimit: gasLimitU64,
-			GasPrice: gasPrice,
-			ABI:      convertMsg.Abi,
-			Bytecode: convertMsg.Bytecode,
+			ChainId:   chainId,
+			Nonce:     nonce,
+			Value:     value,
+			GasLimit:  gasLimitU64,
+			GasPrice:  gasPrice,
+			GasFeeCap: dynamicFee.GasFeeCap,
+			GasTipCap: dynamicFee.GasTipCap,
+			ABI:       convertMsg.Abi,
+			Bytecode:  convertMsg.Bytecode,
+		}
+		// The gas price is not used for the dynamic fee transaction.
+		if dynamicFee.GasFeeCap != nil {
+			tx.GasPrice = nil
 		}
 
 		txbytes, err := util.JsonMarshalData(tx)
@@ -565,8 +571,7 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, "invalid msg"))
 		}
 		var invokeByteData []byte
-		invokeByteData, err = util.GetAbiPack(convertMsg.ContractFuncCallName, convertMsg.ABI, convertMsg.Bytecode, mevm.Args...)
-		mevm.Args = nil
+		invokeByteData, err = util.GetAbiPack(convertMsg.ContractFuncCallName, convertMsg.ABI, convertMsg.Bytecode, convertMsg.Args...)
 		if err != nil {
 			return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
 		}
@@ -594,7 +599,7 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			gasLimit = gasLimitAdjustment
 		}
 
-		return evmTxSignRound(xplac, toAddr, gasPrice, gasLimit, amount, invokeByteData, chainId, ethPrivKey)
+		return evmTxSignRound(xplac, toAddr, gasPrice, dynamicFee, gasLimit, amount, invokeByteData, chainId)
 
 	default:
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, "invalid EVM message type"))
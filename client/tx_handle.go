@@ -2,11 +2,11 @@ package client
 
 import (
 	"bytes"
-	"crypto/ecdsa"
 	"math/big"
 	"os"
 
 	"github.com/xpladev/xpla.go/controller"
+	mevm "github.com/xpladev/xpla.go/core/evm"
 	"github.com/xpladev/xpla.go/key"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
@@ -14,18 +14,18 @@ import (
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
 // Set message for transaction builder.
 // Interface type messages are converted to correct type.
+// If messages are appended in the xpla client, all of them are included in the transaction
+// with the current message, and the appended messages are removed from the xpla client.
 func setTxBuilderMsg(xplac *xplaClient) (cmclient.TxBuilder, error) {
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
@@ -33,21 +33,85 @@ func setTxBuilderMsg(xplac *xplaClient) (cmclient.TxBuilder, error) {
 
 	builder := xplac.GetEncoding().TxConfig.NewTxBuilder()
 
-	return controller.Controller().Get(xplac.GetModule()).
-		NewTxRouter(xplac.GetLogger(), builder, xplac.GetMsgType(), xplac.GetMsg())
+	if xplac.msgsErr == nil && len(xplac.GetMsgs()) == 0 {
+		return controller.Controller().Get(xplac.GetModule()).
+			NewTxRouter(xplac.GetLogger(), builder, xplac.GetMsgType(), xplac.GetMsg())
+	}
+
+	defer xplac.ClearMsgs()
+	if xplac.msgsErr != nil {
+		return nil, xplac.msgsErr
+	}
+
+	msgs := xplac.GetMsgs()
+	memo := xplac.msgsMemo
+	if xplac.GetModule() != "" {
+		currentMsgs, currentMemo, err := convertTxMsgs(xplac)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, currentMsgs...)
+
+		memo, err = mergeMsgMemo(xplac, memo, currentMemo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := builder.SetMsgs(msgs...)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	builder.SetMemo(memo)
+
+	return builder, nil
+}
+
+// Convert the message of the xpla client to messages of the cosmos sdk by using tx router of the module.
+// The memo which is set by the tx router, e.g. the peer address of create validator, is returned together.
+func convertTxMsgs(xplac *xplaClient) ([]sdk.Msg, string, error) {
+	if xplac.GetModule() == "" {
+		return nil, "", xplac.GetLogger().Err(types.ErrWrap(types.ErrInsufficientParams, "no message to append"))
+	}
+	if xplac.GetModule() == mevm.EvmModule {
+		return nil, "", xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "evm message cannot be included in the transaction with other messages"))
+	}
+
+	builder, err := controller.Controller().Get(xplac.GetModule()).
+		NewTxRouter(xplac.GetLogger(), xplac.GetEncoding().TxConfig.NewTxBuilder(), xplac.GetMsgType(), xplac.GetMsg())
+	if err != nil {
+		return nil, "", err
+	}
+
+	tx := builder.GetTx()
+	return tx.GetMsgs(), tx.GetMemo(), nil
+}
+
+// Merge memos which are set by tx routers of messages in the transaction.
+// A transaction has only one memo, so different memos cannot be included together.
+func mergeMsgMemo(xplac *xplaClient, memo, msgMemo string) (string, error) {
+	if msgMemo == "" || msgMemo == memo {
+		return memo, nil
+	}
+	if memo != "" {
+		return "", xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, "messages which have different memos cannot be included in the same transaction"))
+	}
+	return msgMemo, nil
+}
+
+// Remove data of the transaction request, i.e. appended messages and the memo,
+// so they are not included in the next transaction.
+func clearTxRequest(xplac *xplaClient) {
+	xplac.ClearMsgs()
+	xplac.WithMemo("")
 }
 
 // Set information for transaction builder.
 func convertAndSetBuilder(xplac *xplaClient, builder cmclient.TxBuilder, gasLimit string, feeAmount string) (cmclient.TxBuilder, error) {
-	feeAmountDenomRemove, err := util.FromStringToBigInt(util.DenomRemove(feeAmount))
+	feeAmountCoins, err := util.ParseFeeCoins(feeAmount)
 	if err != nil {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 	}
-	feeAmountCoin := sdk.Coin{
-		Amount: sdk.NewIntFromBigInt(feeAmountDenomRemove),
-		Denom:  types.XplaDenom,
-	}
-	feeAmountCoins := sdk.NewCoins(feeAmountCoin)
 
 	if xplac.GetTimeoutHeight() != "" {
 		h, err := util.FromStringToUint64(xplac.GetTimeoutHeight())
@@ -56,9 +120,8 @@ func convertAndSetBuilder(xplac *xplaClient, builder cmclient.TxBuilder, gasLimi
 		}
 		builder.SetTimeoutHeight(h)
 	}
-	if types.Memo != "" {
-		builder.SetMemo(types.Memo)
-		types.Memo = ""
+	if xplac.GetMemo() != "" {
+		builder.SetMemo(xplac.GetMemo())
 	}
 	gasLimitStr, err := util.FromStringToUint64(gasLimit)
 	if err != nil {
@@ -72,17 +135,17 @@ func convertAndSetBuilder(xplac *xplaClient, builder cmclient.TxBuilder, gasLimi
 	return builder, nil
 }
 
-// Sign transaction by using given private key.
+// Sign transaction by using given signers.
 func txSignRound(xplac *xplaClient,
 	sigsV2 []signing.SignatureV2,
-	privs []cryptotypes.PrivKey,
+	signers []key.Signer,
 	accSeqs []uint64,
 	accNums []uint64,
 	builder cmclient.TxBuilder) error {
 
-	for i, priv := range privs {
+	for i, signer := range signers {
 		sigV2 := signing.SignatureV2{
-			PubKey: priv.PubKey(),
+			PubKey: signer.PubKey(),
 			Data: &signing.SingleSignatureData{
 				SignMode:  xplac.GetSignMode(),
 				Signature: nil,
@@ -98,17 +161,17 @@ func txSignRound(xplac *xplaClient,
 	}
 
 	sigsV2 = []signing.SignatureV2{}
-	for i, priv := range privs {
+	for i, signer := range signers {
 		signerData := xauthsigning.SignerData{
 			ChainID:       xplac.GetChainId(),
 			AccountNumber: accNums[i],
 			Sequence:      accSeqs[i],
 		}
-		sigV2, err := tx.SignWithPrivKey(
+		sigV2, err := signWithSigner(
 			xplac.GetSignMode(),
 			signerData,
 			builder,
-			priv,
+			signer,
 			xplac.GetEncoding().TxConfig,
 			accSeqs[i],
 		)
@@ -127,15 +190,96 @@ func txSignRound(xplac *xplaClient,
 	return nil
 }
 
-// Sign evm transaction by using given private key.
+// Sign transaction by using the signer of the xpla client with the sign mode.
+// It is same as tx.Sign of the cosmos sdk, but the signer is used instead of the keyring.
+// If overwrite is false, the signature is appended to the existing signatures of the transaction.
+func txSignWithSigner(xplac *xplaClient,
+	signMode signing.SignMode,
+	signerData xauthsigning.SignerData,
+	builder cmclient.TxBuilder,
+	overwrite bool) error {
+
+	signer := xplac.GetSigner()
+
+	// Signer infos are needed to generate sign bytes of the sign mode direct.
+	sig := signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: nil,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	var prevSignatures []signing.SignatureV2
+	var err error
+	if !overwrite {
+		prevSignatures, err = builder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+		}
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	sig, err = signWithSigner(signMode, signerData, builder, signer, xplac.GetEncoding().TxConfig, signerData.Sequence)
+	if err != nil {
+		return xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	if overwrite {
+		err = builder.SetSignatures(sig)
+	} else {
+		err = builder.SetSignatures(append(prevSignatures, sig)...)
+	}
+	if err != nil {
+		return xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	return nil
+}
+
+// Generate sign bytes of the transaction and sign them by using the signer.
+// It is same as tx.SignWithPrivKey of the cosmos sdk, but the private key is not needed.
+func signWithSigner(
+	signMode signing.SignMode,
+	signerData xauthsigning.SignerData,
+	builder cmclient.TxBuilder,
+	signer key.Signer,
+	txConfig cmclient.TxConfig,
+	accSeq uint64) (signing.SignatureV2, error) {
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	signature, err := signer.Sign(signBytes)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: signature,
+		},
+		Sequence: accSeq,
+	}, nil
+}
+
+// Sign evm transaction by using the signer of the xpla client.
+// If the max fee of the dynamic fee is not nil, the EIP-1559 dynamic fee transaction is signed instead of the legacy transaction.
 func evmTxSignRound(xplac *xplaClient,
 	toAddr common.Address,
 	gasPrice *big.Int,
+	dynamicFee evmDynamicFee,
 	gasLimit string,
 	amount *big.Int,
 	invokeByteData []byte,
-	chainId *big.Int,
-	ethPrivKey *ecdsa.PrivateKey) ([]byte, error) {
+	chainId *big.Int) ([]byte, error) {
 
 	seqU64, err := util.FromStringToUint64(xplac.GetSequence())
 	if err != nil {
@@ -146,18 +290,33 @@ func evmTxSignRound(xplac *xplaClient,
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 	}
 
-	tx := evmtypes.NewTransaction(
-		seqU64,
-		toAddr,
-		amount,
-		gasLimitStr,
-		gasPrice,
-		invokeByteData,
-	)
+	var tx *evmtypes.Transaction
+	if dynamicFee.GasFeeCap != nil {
+		tx = evmtypes.NewTx(&evmtypes.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     seqU64,
+			GasTipCap: dynamicFee.GasTipCap,
+			GasFeeCap: dynamicFee.GasFeeCap,
+			Gas:       gasLimitStr,
+			To:        &toAddr,
+			Value:     amount,
+			Data:      invokeByteData,
+		})
+	} else {
+		tx = evmtypes.NewTransaction(
+			seqU64,
+			toAddr,
+			amount,
+			gasLimitStr,
+			gasPrice,
+			invokeByteData,
+		)
+	}
 
-	signer := evmtypes.NewEIP155Signer(chainId)
+	// The london signer signs both of the legacy transaction (EIP-155) and the dynamic fee transaction.
+	signer := evmtypes.NewLondonSigner(chainId)
 
-	signedTx, err := evmtypes.SignTx(tx, signer, ethPrivKey)
+	signedTx, err := signEvmTx(xplac.GetSigner(), signer, tx)
 	if err != nil {
 		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
 	}
@@ -169,6 +328,64 @@ func evmTxSignRound(xplac *xplaClient,
 	return txbytes, nil
 }
 
+// Fee of the EIP-1559 dynamic fee transaction.
+type evmDynamicFee struct {
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// Get the max fee and the max priority fee of the evm dynamic fee transaction.
+// The fee is empty if the xpla client makes the legacy transaction, and the fee which is not set is suggested
+// by the fee history of the evm RPC.
+func getEvmDynamicFee(xplac *xplaClient) (evmDynamicFee, error) {
+	if !xplac.GetEvmDynamicFee() && xplac.GetGasFeeCap() == "" && xplac.GetGasTipCap() == "" {
+		return evmDynamicFee{}, nil
+	}
+
+	var gasFeeCap, gasTipCap *big.Int
+	var err error
+	if xplac.GetGasTipCap() != "" {
+		gasTipCap, err = util.FromStringToBigInt(util.DenomRemove(xplac.GetGasTipCap()))
+		if err != nil {
+			return evmDynamicFee{}, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+		}
+	}
+	if xplac.GetGasFeeCap() != "" {
+		gasFeeCap, err = util.FromStringToBigInt(util.DenomRemove(xplac.GetGasFeeCap()))
+		if err != nil {
+			return evmDynamicFee{}, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+		}
+	}
+
+	if gasFeeCap == nil || gasTipCap == nil {
+		if xplac.GetEvmRpc() == "" {
+			return evmDynamicFee{}, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist in order to suggest the dynamic fee"))
+		}
+		evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+		if err != nil {
+			return evmDynamicFee{}, xplac.GetLogger().Err(err)
+		}
+
+		suggestedFeeCap, suggestedTipCap, err := evmClient.SuggestDynamicFee(gasTipCap)
+		if err != nil {
+			return evmDynamicFee{}, xplac.GetLogger().Err(err)
+		}
+		if gasFeeCap == nil {
+			gasFeeCap = suggestedFeeCap
+		}
+		gasTipCap = suggestedTipCap
+	}
+
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		return evmDynamicFee{}, xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, "max fee per gas", gasFeeCap, "is less than max priority fee per gas", gasTipCap))
+	}
+
+	return evmDynamicFee{
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+	}, nil
+}
+
 // Read transaction file and make standard transaction.
 func readTxAndInitContexts(l types.Logger, clientCtx cmclient.Context, filename string) (cmclient.Context, tx.Factory, sdk.Tx, error) {
 	stdTx, err := authclient.ReadTxFromFile(clientCtx, filename)
@@ -204,9 +421,19 @@ func unmarshalSignatureJSON(xplac *xplaClient, clientCtx cmclient.Context, filen
 	return clientCtx.TxConfig.UnmarshalSignatureJSON(bytes)
 }
 
-// The secp-256k1 private key converts ECDSA privatkey for using evm module.
-func toECDSA(privKey key.PrivateKey) (*ecdsa.PrivateKey, error) {
-	return ethcrypto.ToECDSA(privKey.Bytes())
+// Sign the evm transaction by using the recoverable signature of the signer.
+func signEvmTx(signer key.Signer, evmSigner evmtypes.Signer, tx *evmtypes.Transaction) (*evmtypes.Transaction, error) {
+	hash := evmSigner.Hash(tx)
+	sig, err := signer.SignEvmHash(hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(evmSigner, sig)
+}
+
+// Get the evm address of the signer.
+func evmAddress(signer key.Signer) common.Address {
+	return common.BytesToAddress(signer.PubKey().Address())
 }
 
 // Get multiple signatures information. It returns keyring of cosmos sdk.
@@ -244,18 +471,11 @@ func getGasLimitFeeAmount(xplac *xplaClient, builder cmclient.TxBuilder) (string
 
 	feeAmount := xplac.GetFeeAmount()
 	if xplac.GetFeeAmount() == "" {
-		gasLimitBigInt, err := util.FromStringToBigInt(gasLimit)
-		if err != nil {
-			return "", "", xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
-		}
-
-		gasPriceBigInt, err := util.FromStringToBigInt(xplac.GetGasPrice())
+		feeCoins, err := util.FeeCoinsByGasPrices(xplac.GetGasPrice(), gasLimit)
 		if err != nil {
 			return "", "", xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
 		}
-
-		feeAmountBigInt := util.MulBigInt(gasLimitBigInt, gasPriceBigInt)
-		feeAmount = util.FromBigIntToString(feeAmountBigInt)
+		feeAmount = feeCoins.String()
 	}
 
 	return gasLimit, feeAmount, nil
@@ -272,20 +492,119 @@ func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
 	return false
 }
 
+// Release the sequence which is reserved from the sequence manager when the transaction is not created,
+// so the next transaction is signed with the sequence.
+func releaseSequence(xplac *xplaClient) {
+	if xplac.GetSequenceManager() == nil {
+		return
+	}
+
+	seq, err := util.FromStringToUint64(xplac.GetSequence())
+	if err != nil {
+		return
+	}
+	xplac.GetSequenceManager().ReleaseSequence(sdk.AccAddress(xplac.GetSigner().PubKey().Address()).String(), seq)
+}
+
 // Get account number and sequence
+// If the xpla client has the sequence manager, the sequence is reserved from it instead of the option.
 func getAccNumAndSeq(xplac *xplaClient) (*xplaClient, error) {
+	if xplac.GetSequenceManager() != nil {
+		accNum, seq, err := xplac.GetSequenceManager().NextSequence(
+			sdk.AccAddress(xplac.GetSigner().PubKey().Address()).String(),
+			func() (uint64, uint64, error) { return loadAccNumAndSeq(xplac) },
+		)
+		if err != nil {
+			return nil, err
+		}
+		xplac.WithAccountNumber(util.FromUint64ToString(accNum))
+		xplac.WithSequence(util.FromUint64ToString(seq))
+		return xplac, nil
+	}
+
 	if xplac.GetAccountNumber() == "" || xplac.GetSequence() == "" {
-		if xplac.GetLcdURL() == "" && xplac.GetGrpcUrl() == "" {
-			xplac.WithAccountNumber(util.FromUint64ToString(types.DefaultAccNum))
-			xplac.WithSequence(util.FromUint64ToString(types.DefaultAccSeq))
-		} else {
-			account, err := xplac.LoadAccount(sdk.AccAddress(xplac.GetPrivateKey().PubKey().Address()))
-			if err != nil {
-				return nil, err
-			}
-			xplac.WithAccountNumber(util.FromUint64ToString(account.GetAccountNumber()))
-			xplac.WithSequence(util.FromUint64ToString(account.GetSequence()))
+		accNum, seq, err := loadAccNumAndSeq(xplac)
+		if err != nil {
+			return nil, err
 		}
+		xplac.WithAccountNumber(util.FromUint64ToString(accNum))
+		xplac.WithSequence(util.FromUint64ToString(seq))
 	}
 	return xplac, nil
 }
+
+// Load account number and sequence of the signer from the chain.
+func loadAccNumAndSeq(xplac *xplaClient) (uint64, uint64, error) {
+	if xplac.GetLcdURL() == "" && xplac.GetGrpcUrl() == "" {
+		return types.DefaultAccNum, types.DefaultAccSeq, nil
+	}
+
+	account, err := xplac.LoadAccount(sdk.AccAddress(xplac.GetSigner().PubKey().Address()))
+	if err != nil {
+		return 0, 0, err
+	}
+	return account.GetAccountNumber(), account.GetSequence(), nil
+}
+
+// Sign the transaction again with the next sequence of the sequence manager.
+func resignTx(xplac *xplaClient, txBytes []byte) ([]byte, error) {
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	builder, err := xplac.GetEncoding().TxConfig.WrapTxBuilder(sdkTx)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	xplac, err = getAccNumAndSeq(xplac)
+	if err != nil {
+		return nil, err
+	}
+
+	accNumU64, err := util.FromStringToUint64(xplac.GetAccountNumber())
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+	}
+	accSeqU64, err := util.FromStringToUint64(xplac.GetSequence())
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+	}
+
+	var sigsV2 []signing.SignatureV2
+	err = txSignRound(xplac, sigsV2, []key.Signer{xplac.GetSigner()}, []uint64{accSeqU64}, []uint64{accNumU64}, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err = xplac.GetEncoding().TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	return txBytes, nil
+}
+
+// Get the sequence of the signature in the transaction.
+func getTxSequence(xplac *xplaClient, txBytes []byte) (uint64, error) {
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return 0, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+
+	sigTx, ok := sdkTx.(xauthsigning.SigVerifiableTx)
+	if !ok {
+		return 0, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, "invalid transaction type"))
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return 0, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	if len(sigs) == 0 {
+		return 0, xplac.GetLogger().Err(types.ErrWrap(types.ErrInsufficientParams, "no signature in the transaction"))
+	}
+
+	return sigs[0].Sequence, nil
+}
@@ -14,9 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	xapp "github.com/xpladev/xpla/app"
@@ -204,6 +206,218 @@ func (suite *TestSuite) TestSimulateCreateAndSignTx() {
 	suite.Require().Equal(txbytes, newTxbytes)
 }
 
+func (suite *TestSuite) TestSimulateMultiMsgCreateAndSignTx() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 4)
+	from := accounts[0]
+	to := accounts[1]
+	validator := sdk.ValAddress(accounts[2].Address)
+
+	xplac := NewXplaClient(testutil.TestChainId)
+	xplac.WithPrivateKey(from.PrivKey)
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      "1000axpla",
+	}
+	delegateMsg := types.DelegateMsg{
+		Amount:  "1000axpla",
+		ValAddr: validator.String(),
+	}
+
+	txbytes, err := xplac.
+		BankSend(bankSendMsg).AppendMsg().
+		BankSend(bankSendMsg).AppendMsg().
+		Delegate(delegateMsg).
+		CreateAndSignTx()
+	suite.Require().NoError(err)
+	suite.Require().Empty(xplac.GetMsgs())
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+
+	msgs := sdkTx.GetMsgs()
+	suite.Require().Len(msgs, 3)
+	suite.Require().IsType(&banktypes.MsgSend{}, msgs[0])
+	suite.Require().IsType(&banktypes.MsgSend{}, msgs[1])
+	suite.Require().IsType(&stakingtypes.MsgDelegate{}, msgs[2])
+
+	// single signature
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	suite.Require().True(ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	suite.Require().Len(sigs, 1)
+
+	// evm message cannot be appended
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      "1000",
+	}
+	_, err = xplac.EvmSendCoin(sendCoinMsg).AppendMsg().BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().Error(err)
+	suite.Require().Empty(xplac.GetMsgs())
+
+	// evm message cannot be created with appended messages
+	_, err = xplac.BankSend(bankSendMsg).AppendMsg().EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	suite.Require().Error(err)
+	suite.Require().Empty(xplac.GetMsgs())
+
+	// appended messages are removed even if the transaction is not created
+	_, err = xplac.BankSend(bankSendMsg).AppendMsg().WithErr(types.ErrWrap(types.ErrInvalidRequest, "test")).CreateAndSignTx()
+	suite.Require().Error(err)
+	suite.Require().Empty(xplac.GetMsgs())
+	xplac.WithErr(nil)
+
+	// memo of create validator is kept in the transaction
+	createValidatorMsg := types.CreateValidatorMsg{
+		NodeKey:          `{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"F20DGZKfFFCqgXe2AxF6855KrzfqVasdunk2LMG/EBV+U3gf7GVokgm+X8JP0WG1dyzZ7UddnmC9LGpUMRRQmQ=="}}`,
+		PrivValidatorKey: `{"address":"3C5042645BAD50A98F0A7D567F862E1A861C23C5","pub_key":{"type":"tendermint/PubKeyEd25519","value":"/0bCEBBwUIrjqYr+pKfzHly+SBMjkA/hcCR9oswxnrk="},"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"iks74YM/Di06VI4JPZ3zOxrKfQ0iwwgXhNa6aIzaduf/RsIQEHBQiuOpiv6kp/MeXL5IEyOQD+FwJH2izDGeuQ=="}}`,
+		ValidatorAddress: sdk.ValAddress(from.Address).String(),
+		Moniker:          "moniker",
+		Amount:           "1000000000axpla",
+		ServerIp:         "127.0.0.1",
+	}
+	txbytes, err = xplac.
+		CreateValidator(createValidatorMsg).AppendMsg().
+		BankSend(bankSendMsg).
+		CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	sdkTx, err = xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+	suite.Require().Len(sdkTx.GetMsgs(), 2)
+
+	memoTx, ok := sdkTx.(sdk.TxWithMemo)
+	suite.Require().True(ok)
+	suite.Require().True(strings.HasSuffix(memoTx.GetMemo(), "@127.0.0.1:26656"))
+}
+
+func (suite *TestSuite) TestSimulateMemoIsRemovedAfterTx() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	from := accounts[0]
+	to := accounts[1]
+
+	xplac := NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithMemo("memo")
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      "1000axpla",
+	}
+
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+	suite.Require().Empty(xplac.GetMemo())
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+	memoTx, ok := sdkTx.(sdk.TxWithMemo)
+	suite.Require().True(ok)
+	suite.Require().Equal("memo", memoTx.GetMemo())
+
+	// the next transaction has no memo
+	txbytes, err = xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	sdkTx, err = xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+	memoTx, ok = sdkTx.(sdk.TxWithMemo)
+	suite.Require().True(ok)
+	suite.Require().Empty(memoTx.GetMemo())
+
+	// unsigned transaction
+	txbytes, err = xplac.WithMemo("memo").BankSend(bankSendMsg).CreateUnsignedTx()
+	suite.Require().NoError(err)
+	suite.Require().Empty(xplac.GetMemo())
+
+	sdkTx, err = xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+	memoTx, ok = sdkTx.(sdk.TxWithMemo)
+	suite.Require().True(ok)
+	suite.Require().Equal("memo", memoTx.GetMemo())
+}
+
+func (suite *TestSuite) TestSimulateFeeWithArbitraryDenoms() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	from := accounts[0]
+	to := accounts[1]
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	// amount of several coins which have arbitrary denoms
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      "1000" + ibcDenom + ",10axpla",
+	}
+
+	// fee by gas prices of several denoms
+	xplac := NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithGasLimit("200000").
+		WithGasPrice("0.025" + ibcDenom + ",850000000000axpla")
+
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+
+	msgSend, ok := sdkTx.GetMsgs()[0].(*banktypes.MsgSend)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000), sdk.NewInt64Coin(types.XplaDenom, 10)), msgSend.Amount)
+
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 5000), sdk.NewInt64Coin(types.XplaDenom, 170000000000000000)), feeTx.GetFee())
+
+	// fee amount is set directly
+	xplac = NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithGasLimit("200000").
+		WithFeeAmount("100" + ibcDenom)
+
+	txbytes, err = xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	sdkTx, err = xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+
+	feeTx, ok = sdkTx.(sdk.FeeTx)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)), feeTx.GetFee())
+
+	// evm transaction needs the gas price of axpla
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      "1000",
+	}
+	_, err = NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		WithGasPrice("0.025" + ibcDenom).
+		EvmSendCoin(sendCoinMsg).
+		CreateAndSignTx()
+	suite.Require().Error(err)
+
+	// evm only transfers axpla
+	sendCoinMsg.Amount = "1000" + ibcDenom
+	_, err = NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(from.PrivKey).
+		EvmSendCoin(sendCoinMsg).
+		CreateAndSignTx()
+	suite.Require().Error(err)
+}
+
 func (suite *TestSuite) TestSimulateEVMCreateAndSignTx() {
 	s := rand.NewSource(1)
 	r := rand.New(s)
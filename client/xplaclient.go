@@ -29,6 +29,7 @@ import (
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -49,12 +50,19 @@ type xplaClient struct {
 	httpMutex      *sync.Mutex
 	logger         types.Logger
 
-	opts provider.Options
+	opts       provider.Options
+	pagination *query.PageRequest
 
-	module  string
-	msgType string
-	msg     interface{}
-	err     error
+	keyring       keyring.Keyring
+	keyringSigner *key.KeyringSigner
+
+	module   string
+	msgType  string
+	msg      interface{}
+	msgs     []sdk.Msg
+	msgsMemo string
+	msgsErr  error
+	err      error
 
 	externalCoreModule
 }
@@ -81,16 +89,23 @@ func (xplac *xplaClient) WithOptions(
 	return xplac.
 		WithPrivateKey(options.PrivateKey).
 		WithPublicKey(options.PublicKey).
+		WithSigner(options.Signer).
+		WithKeyring(options.KeyringBackend, options.KeyringDir).
+		WithFromName(options.FromName).
 		WithAccountNumber(options.AccountNumber).
 		WithBroadcastMode(options.BroadcastMode).
 		WithSequence(options.Sequence).
 		WithGasLimit(options.GasLimit).
-		WithGasPrice(util.DenomRemove(options.GasPrice)).
+		WithGasPrice(options.GasPrice).
 		WithGasAdjustment(options.GasAdjustment).
 		WithFeeAmount(options.FeeAmount).
+		WithEvmDynamicFee(options.EvmDynamicFee).
+		WithGasFeeCap(options.GasFeeCap).
+		WithGasTipCap(options.GasTipCap).
 		WithSignMode(options.SignMode).
 		WithFeeGranter(options.FeeGranter).
 		WithTimeoutHeight(options.TimeoutHeight).
+		WithMemo(options.Memo).
 		WithURL(options.LcdURL).
 		WithGrpc(options.GrpcURL).
 		WithRpc(options.RpcURL).
@@ -99,6 +114,8 @@ func (xplac *xplaClient) WithOptions(
 		WithOutputDocument(options.OutputDocument).
 		WithFromAddress(options.FromAddress).
 		WithVerbose(options.Verbose).
+		WithSequenceManager(options.SequenceManager).
+		WithDenomTraceCache(options.DenomTraceCache).
 		UpdateXplacInCoreModule()
 }
 
@@ -180,6 +197,8 @@ func (xplac *xplaClient) WithPrivateKey(privateKey key.PrivateKey) provider.Xpla
 			xplac.err = err
 			return xplac.UpdateXplacInCoreModule()
 		}
+		xplac.opts.Signer = nil
+		xplac.clearKeyringSigner()
 		// Automatically setting FromAddress and public key when xpla client has the private key
 		xplac.opts.FromAddress = addr
 		xplac.opts.PublicKey = privateKey.PubKey()
@@ -195,6 +214,76 @@ func (xplac *xplaClient) WithPublicKey(pubKey key.PublicKey) provider.XplaClient
 	return xplac.UpdateXplacInCoreModule()
 }
 
+// Set signer which signs transactions instead of the private key.
+// The private key, the signer and the key of the keyring replace each other, so the last one signs transactions.
+func (xplac *xplaClient) WithSigner(signer key.Signer) provider.XplaClient {
+	xplac.opts.Signer = signer
+	if signer != nil {
+		xplac.opts.PrivateKey = nil
+		xplac.clearKeyringSigner()
+		// Automatically setting FromAddress and public key when xpla client has the signer
+		xplac.opts.FromAddress = sdk.AccAddress(signer.PubKey().Address())
+		xplac.opts.PublicKey = signer.PubKey()
+	}
+	return xplac.UpdateXplacInCoreModule()
+}
+
+// Set keyring of the cosmos sdk which stores keys of the xpla client.
+// The backend is one of "file", "test" and "memory", and ~/.xpla is used as the directory
+// of the file and test backends if the directory is empty.
+func (xplac *xplaClient) WithKeyring(backend string, dir string) provider.XplaClient {
+	xplac.opts.KeyringBackend = backend
+	xplac.opts.KeyringDir = dir
+	xplac.keyring = nil
+	if backend != "" {
+		keyringPath, err := util.KeyringPath(backend, dir)
+		if err != nil {
+			xplac.err = err
+			return xplac.UpdateXplacInCoreModule()
+		}
+
+		kr, err := util.NewKeyring(backend, keyringPath)
+		if err != nil {
+			xplac.err = err
+			return xplac.UpdateXplacInCoreModule()
+		}
+		xplac.keyring = kr
+	}
+	return xplac.updateKeyringSigner()
+}
+
+// Set name of the key in the keyring which signs transactions.
+func (xplac *xplaClient) WithFromName(fromName string) provider.XplaClient {
+	xplac.opts.FromName = fromName
+	return xplac.updateKeyringSigner()
+}
+
+// Make the signer of the keyring when both of the keyring and the key name are set.
+func (xplac *xplaClient) updateKeyringSigner() provider.XplaClient {
+	xplac.keyringSigner = nil
+	if xplac.keyring != nil && xplac.opts.FromName != "" {
+		signer, err := key.NewKeyringSigner(xplac.keyring, xplac.opts.FromName)
+		if err != nil {
+			xplac.err = err
+			return xplac.UpdateXplacInCoreModule()
+		}
+		xplac.keyringSigner = signer
+		xplac.opts.PrivateKey = nil
+		xplac.opts.Signer = nil
+		// Automatically setting FromAddress and public key when xpla client has the key of the keyring
+		xplac.opts.FromAddress = sdk.AccAddress(signer.PubKey().Address())
+		xplac.opts.PublicKey = signer.PubKey()
+	}
+	return xplac.UpdateXplacInCoreModule()
+}
+
+// Remove the key name and the signer of the keyring when the private key or the signer is set.
+// The keyring is kept to manage keys.
+func (xplac *xplaClient) clearKeyringSigner() {
+	xplac.opts.FromName = ""
+	xplac.keyringSigner = nil
+}
+
 // Set LCD URL
 func (xplac *xplaClient) WithURL(lcdURL string) provider.XplaClient {
 	xplac.opts.LcdURL = lcdURL
@@ -252,7 +341,8 @@ func (xplac *xplaClient) WithGasLimit(gasLimit string) provider.XplaClient {
 	return xplac.UpdateXplacInCoreModule()
 }
 
-// Set Gas price
+// Set gas prices, e.g. "850000000000axpla" or "850000000000axpla,0.025ibc/...".
+// The default denom (axpla) is used if the gas price has no denom, and the evm transaction uses the gas price of axpla.
 func (xplac *xplaClient) WithGasPrice(gasPrice string) provider.XplaClient {
 	xplac.opts.GasPrice = gasPrice
 	return xplac.UpdateXplacInCoreModule()
@@ -264,12 +354,32 @@ func (xplac *xplaClient) WithGasAdjustment(gasAdjustment string) provider.XplaCl
 	return xplac.UpdateXplacInCoreModule()
 }
 
-// Set fee amount
+// Set fee amount which can have several coins of arbitrary denoms, e.g. "1000000axpla,10ibc/...".
+// The default denom (axpla) is used if the fee amount has no denom.
 func (xplac *xplaClient) WithFeeAmount(feeAmount string) provider.XplaClient {
 	xplac.opts.FeeAmount = feeAmount
 	return xplac.UpdateXplacInCoreModule()
 }
 
+// Set whether the evm transaction is the EIP-1559 dynamic fee transaction.
+// If the max fee or the max priority fee is not set, it is suggested by the fee history of the evm RPC.
+func (xplac *xplaClient) WithEvmDynamicFee(evmDynamicFee bool) provider.XplaClient {
+	xplac.opts.EvmDynamicFee = evmDynamicFee
+	return xplac.UpdateXplacInCoreModule()
+}
+
+// Set max fee per gas (axpla) of the evm dynamic fee transaction.
+func (xplac *xplaClient) WithGasFeeCap(gasFeeCap string) provider.XplaClient {
+	xplac.opts.GasFeeCap = gasFeeCap
+	return xplac.UpdateXplacInCoreModule()
+}
+
+// Set max priority fee per gas (axpla) of the evm dynamic fee transaction.
+func (xplac *xplaClient) WithGasTipCap(gasTipCap string) provider.XplaClient {
+	xplac.opts.GasTipCap = gasTipCap
+	return xplac.UpdateXplacInCoreModule()
+}
+
 // Set transaction sign mode
 func (xplac *xplaClient) WithSignMode(signMode signing.SignMode) provider.XplaClient {
 	xplac.opts.SignMode = signMode
@@ -288,6 +398,12 @@ func (xplac *xplaClient) WithTimeoutHeight(timeoutHeight string) provider.XplaCl
 	return xplac.UpdateXplacInCoreModule()
 }
 
+// Set memo of transaction
+func (xplac *xplaClient) WithMemo(memo string) provider.XplaClient {
+	xplac.opts.Memo = memo
+	return xplac.UpdateXplacInCoreModule()
+}
+
 // Set pagination
 func (xplac *xplaClient) WithPagination(pagination types.Pagination) provider.XplaClient {
 	emptyPagination := types.Pagination{}
@@ -297,9 +413,9 @@ func (xplac *xplaClient) WithPagination(pagination types.Pagination) provider.Xp
 			xplac.err = err
 			return xplac.UpdateXplacInCoreModule()
 		}
-		core.PageRequest = pageReq
+		xplac.pagination = pageReq
 	} else {
-		core.PageRequest = core.DefaultPagination()
+		xplac.pagination = core.DefaultPagination()
 	}
 
 	return xplac.UpdateXplacInCoreModule()
@@ -325,6 +441,20 @@ func (xplac *xplaClient) WithVerbose(verbose int) provider.XplaClient {
 	return xplac.UpdateXplacInCoreModule()
 }
 
+// Set sequence manager which tracks the sequence of the account locally.
+// The sequence manager can be shared by xpla clients which use the same account in several goroutines.
+func (xplac *xplaClient) WithSequenceManager(sequenceManager *util.SequenceManager) provider.XplaClient {
+	xplac.opts.SequenceManager = sequenceManager
+	return xplac.UpdateXplacInCoreModule()
+}
+
+// Set denom trace cache which keeps traces of IBC denominations.
+// The denom trace cache can be shared by xpla clients which query the same chain.
+func (xplac *xplaClient) WithDenomTraceCache(denomTraceCache *util.DenomTraceCache) provider.XplaClient {
+	xplac.opts.DenomTraceCache = denomTraceCache
+	return xplac.UpdateXplacInCoreModule()
+}
+
 // Set module name
 func (xplac *xplaClient) WithModule(module string) provider.XplaClient {
 	xplac.module = module
@@ -349,12 +479,47 @@ func (xplac *xplaClient) WithErr(err error) provider.XplaClient {
 	return xplac.UpdateXplacInCoreModule()
 }
 
+// Append the message of the xpla client to the message list in order to build
+// a transaction which includes multiple messages of several modules.
+// The message of the xpla client is removed after appended.
+func (xplac *xplaClient) AppendMsg() provider.XplaClient {
+	if xplac.GetErr() != nil {
+		xplac.msgsErr = xplac.GetErr()
+		return xplac
+	}
+
+	msgs, memo, err := convertTxMsgs(xplac)
+	if err != nil {
+		xplac.msgsErr = err
+		return xplac.WithErr(err)
+	}
+	memo, err = mergeMsgMemo(xplac, xplac.msgsMemo, memo)
+	if err != nil {
+		xplac.msgsErr = err
+		return xplac.WithErr(err)
+	}
+	xplac.msgs = append(xplac.msgs, msgs...)
+	xplac.msgsMemo = memo
+
+	return provider.ResetModuleAndMsgXplac(xplac)
+}
+
+// Remove appended messages of the xpla client.
+func (xplac *xplaClient) ClearMsgs() provider.XplaClient {
+	xplac.msgs = nil
+	xplac.msgsMemo = ""
+	xplac.msgsErr = nil
+	return xplac.UpdateXplacInCoreModule()
+}
+
 // Get parameters of the xpla client
 func (xplac *xplaClient) GetChainId() string                    { return xplac.chainId }
 func (xplac *xplaClient) GetPrivateKey() key.PrivateKey         { return xplac.opts.PrivateKey }
 func (xplac *xplaClient) GetPublicKey() key.PublicKey           { return xplac.opts.PublicKey }
 func (xplac *xplaClient) GetEncoding() paramsapp.EncodingConfig { return xplac.encodingConfig }
 func (xplac *xplaClient) GetContext() context.Context           { return xplac.context }
+func (xplac *xplaClient) GetKeyring() keyring.Keyring           { return xplac.keyring }
+func (xplac *xplaClient) GetFromName() string                   { return xplac.opts.FromName }
 func (xplac *xplaClient) GetLcdURL() string                     { return xplac.opts.LcdURL }
 func (xplac *xplaClient) GetGrpcUrl() string                    { return xplac.opts.GrpcURL }
 func (xplac *xplaClient) GetGrpcClient() grpc1.ClientConn       { return xplac.grpc }
@@ -367,10 +532,14 @@ func (xplac *xplaClient) GetGasLimit() string                   { return xplac.o
 func (xplac *xplaClient) GetGasPrice() string                   { return xplac.opts.GasPrice }
 func (xplac *xplaClient) GetGasAdjustment() string              { return xplac.opts.GasAdjustment }
 func (xplac *xplaClient) GetFeeAmount() string                  { return xplac.opts.FeeAmount }
+func (xplac *xplaClient) GetEvmDynamicFee() bool                { return xplac.opts.EvmDynamicFee }
+func (xplac *xplaClient) GetGasFeeCap() string                  { return xplac.opts.GasFeeCap }
+func (xplac *xplaClient) GetGasTipCap() string                  { return xplac.opts.GasTipCap }
 func (xplac *xplaClient) GetSignMode() signing.SignMode         { return xplac.opts.SignMode }
 func (xplac *xplaClient) GetFeeGranter() sdk.AccAddress         { return xplac.opts.FeeGranter }
 func (xplac *xplaClient) GetTimeoutHeight() string              { return xplac.opts.TimeoutHeight }
-func (xplac *xplaClient) GetPagination() *query.PageRequest     { return core.PageRequest }
+func (xplac *xplaClient) GetMemo() string                       { return xplac.opts.Memo }
+func (xplac *xplaClient) GetPagination() *query.PageRequest     { return xplac.pagination }
 func (xplac *xplaClient) GetOutputDocument() string             { return xplac.opts.OutputDocument }
 func (xplac *xplaClient) GetFromAddress() sdk.AccAddress        { return xplac.opts.FromAddress }
 func (xplac *xplaClient) GetHttpMutex() *sync.Mutex             { return xplac.httpMutex }
@@ -378,4 +547,26 @@ func (xplac *xplaClient) GetLogger() types.Logger               { return xplac.l
 func (xplac *xplaClient) GetModule() string                     { return xplac.module }
 func (xplac *xplaClient) GetMsgType() string                    { return xplac.msgType }
 func (xplac *xplaClient) GetMsg() interface{}                   { return xplac.msg }
+func (xplac *xplaClient) GetMsgs() []sdk.Msg                    { return xplac.msgs }
 func (xplac *xplaClient) GetErr() error                         { return xplac.err }
+func (xplac *xplaClient) GetSequenceManager() *util.SequenceManager {
+	return xplac.opts.SequenceManager
+}
+func (xplac *xplaClient) GetDenomTraceCache() *util.DenomTraceCache {
+	return xplac.opts.DenomTraceCache
+}
+
+// Get the signer of the xpla client.
+// If the signer is not set, the key of the keyring or the private key of the xpla client is used as the signer.
+func (xplac *xplaClient) GetSigner() key.Signer {
+	if xplac.opts.Signer != nil {
+		return xplac.opts.Signer
+	}
+	if xplac.keyringSigner != nil {
+		return xplac.keyringSigner
+	}
+	if xplac.opts.PrivateKey != nil {
+		return key.NewPrivKeySigner(xplac.opts.PrivateKey)
+	}
+	return nil
+}
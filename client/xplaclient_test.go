@@ -2,7 +2,9 @@ package client_test
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/xpladev/xpla.go/client"
@@ -14,6 +16,7 @@ import (
 	"github.com/xpladev/xpla.go/util/testutil/network"
 
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -26,6 +29,7 @@ var (
 	testRpcUrl         = "https://cube-rpc.xpla.dev"
 	testEvmRpcUrl      = "https://cube-evm-rpc.xpla.dev"
 	testOutputDocument = "./document.json"
+	testMemo           = "memo"
 )
 
 func TestNewXplaClient(t *testing.T) {
@@ -64,6 +68,7 @@ func TestNewXplaClient(t *testing.T) {
 		SignMode:       signing.SignMode_SIGN_MODE_DIRECT,
 		FeeGranter:     feegranter.Address,
 		TimeoutHeight:  testTimeoutHeight,
+		Memo:           testMemo,
 		LcdURL:         testLcdUrl,
 		GrpcURL:        testGrpcUrl,
 		RpcURL:         testRpcUrl,
@@ -75,7 +80,7 @@ func TestNewXplaClient(t *testing.T) {
 	xplac := client.NewXplaClient(testutil.TestChainId).WithOptions(newClientOption)
 	xplac.Total()
 
-	totalMsg, err := mbank.MakeTotalSupplyMsg()
+	totalMsg, err := mbank.MakeTotalSupplyMsg(xplac.GetPagination())
 	assert.NoError(t, err)
 
 	assert.Equal(t, testutil.TestChainId, xplac.GetChainId())
@@ -95,6 +100,7 @@ func TestNewXplaClient(t *testing.T) {
 	assert.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, xplac.GetSignMode())
 	assert.Equal(t, feegranter.Address, xplac.GetFeeGranter())
 	assert.Equal(t, testTimeoutHeight, xplac.GetTimeoutHeight())
+	assert.Equal(t, testMemo, xplac.GetMemo())
 	assert.Equal(t, testPagination.Reverse, xplac.GetPagination().Reverse)
 	assert.Equal(t, testOutputDocument, xplac.GetOutputDocument())
 	assert.Equal(t, mbank.BankModule, xplac.GetModule())
@@ -103,6 +109,41 @@ func TestNewXplaClient(t *testing.T) {
 	assert.Equal(t, totalMsg, xplac.GetMsg())
 }
 
+func TestConcurrentXplaClients(t *testing.T) {
+	clientNumber := 20
+
+	var wg sync.WaitGroup
+	errs := make(chan error, clientNumber)
+	for i := 1; i <= clientNumber; i++ {
+		wg.Add(1)
+		go func(limit uint64) {
+			defer wg.Done()
+
+			memo := util.FromUint64ToString(limit)
+			xplac := client.NewXplaClient(testutil.TestChainId).
+				WithPagination(types.Pagination{Limit: limit}).
+				WithMemo(memo)
+
+			xplac.Total()
+			if xplac.GetErr() != nil {
+				errs <- xplac.GetErr()
+				return
+			}
+
+			totalMsg, ok := xplac.GetMsg().(banktypes.QueryTotalSupplyRequest)
+			if !ok || totalMsg.Pagination.Limit != limit || xplac.GetMemo() != memo {
+				errs <- fmt.Errorf("request data of client %d is changed by other clients", limit)
+			}
+		}(uint64(i))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
+
 var (
 	validatorNumber = 5
 	testSendAmount  = "1000"
@@ -0,0 +1,138 @@
+// Command xplabind generates go bindings of contracts which are called through the XplaClient.
+//
+// Usage:
+//
+//	xplabind wasm -schema ./schema -pkg cw721 [-type Cw721] [-out cw721.go]
+//	xplabind evm -abi ./abi.json [-bin ./bytecode.json] -pkg token -type Token [-out token.go]
+//
+// It can be used with go generate, e.g.
+//
+//	//go:generate go run github.com/xpladev/xpla.go/cmd/xplabind wasm -schema ./schema -pkg cw721 -out cw721.go
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/xpladev/xpla.go/bind"
+	"github.com/xpladev/xpla.go/util"
+)
+
+const usage = `usage: xplabind <command> [flags]
+
+commands:
+  wasm    generate the binding of the cosmwasm contract from JSON schemas
+  evm     generate the binding of the solidity contract from the ABI and the bytecode
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "wasm":
+		err = runWasm(os.Args[2:])
+	case "evm":
+		err = runEvm(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "xplabind:", err)
+		os.Exit(1)
+	}
+}
+
+func runWasm(args []string) error {
+	flags := flag.NewFlagSet("wasm", flag.ExitOnError)
+	schemaDir := flags.String("schema", "schema", "directory of JSON schemas of the contract")
+	pkg := flags.String("pkg", "", "package name of the generated code")
+	typeName := flags.String("type", "", "type name of the binding, the contract name of the schema by default")
+	out := flags.String("out", "", "output file, the standard output by default")
+	flags.Parse(args)
+
+	wasmSchema, err := bind.LoadWasmSchema(*schemaDir)
+	if err != nil {
+		return err
+	}
+
+	code, err := bind.BindWasm(wasmSchema, *pkg, *typeName)
+	if err != nil {
+		return err
+	}
+
+	return write(*out, code)
+}
+
+func runEvm(args []string) error {
+	flags := flag.NewFlagSet("evm", flag.ExitOnError)
+	abiFile := flags.String("abi", "", "ABI JSON file of the contract")
+	binFile := flags.String("bin", "", "bytecode file of the contract, the JSON which has \"object\" or the hex string")
+	pkg := flags.String("pkg", "", "package name of the generated code")
+	typeName := flags.String("type", "", "type name of the binding")
+	out := flags.String("out", "", "output file, the standard output by default")
+	flags.Parse(args)
+
+	if *abiFile == "" {
+		return fmt.Errorf("empty ABI file")
+	}
+	abiJson, err := util.AbiParsing(*abiFile)
+	if err != nil {
+		return err
+	}
+
+	bytecode, err := readBytecode(*binFile)
+	if err != nil {
+		return err
+	}
+
+	code, err := bind.BindEvm(abiJson, bytecode, *pkg, *typeName)
+	if err != nil {
+		return err
+	}
+
+	return write(*out, code)
+}
+
+// The bytecode file is the JSON which has the bytecode in "object", e.g. the output of solc, or the hex string.
+func readBytecode(binFile string) (string, error) {
+	if binFile == "" {
+		return "", nil
+	}
+	bz, err := os.ReadFile(binFile)
+	if err != nil {
+		return "", err
+	}
+
+	bytecode := strings.TrimSpace(string(bz))
+	if !strings.HasPrefix(bytecode, "{") {
+		return bytecode, nil
+	}
+
+	var bytecodeJson struct {
+		Object string `json:"object"`
+	}
+	if err := json.Unmarshal(bz, &bytecodeJson); err != nil {
+		return "", err
+	}
+	if bytecodeJson.Object == "" {
+		return "", fmt.Errorf("no object in the bytecode file %s", binFile)
+	}
+	return bytecodeJson.Object, nil
+}
+
+func write(out string, code string) error {
+	if out == "" {
+		_, err := fmt.Print(code)
+		return err
+	}
+	return os.WriteFile(out, []byte(code), 0o644)
+}
@@ -59,7 +59,7 @@ func (e AuthExternal) AccAddress(queryAccAddresMsg types.QueryAccAddressMsg) pro
 
 // Query all accounts.
 func (e AuthExternal) Accounts() provider.XplaClient {
-	msg, err := MakeQueryAccountsMsg()
+	msg, err := MakeQueryAccountsMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(AuthQueryAccountsMsgType, err)
 	}
@@ -32,7 +32,7 @@ func (s *IntegrationTestSuite) TestAuth() {
 	// accounts
 	s.xplac.Accounts()
 
-	accountsMsg, err := mauth.MakeQueryAccountsMsg()
+	accountsMsg, err := mauth.MakeQueryAccountsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(accountsMsg, s.xplac.GetMsg())
@@ -1,9 +1,9 @@
 package auth
 
 import (
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -24,9 +24,9 @@ func MakeQueryAccAddressMsg(queryAccAddressMsg types.QueryAccAddressMsg) (authty
 }
 
 // (Query) make msg - auth accounts
-func MakeQueryAccountsMsg() (authtypes.QueryAccountsRequest, error) {
+func MakeQueryAccountsMsg(pageRequest *query.PageRequest) (authtypes.QueryAccountsRequest, error) {
 	return authtypes.QueryAccountsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -7,17 +7,31 @@ import (
 	"github.com/xpladev/xpla.go/util"
 
 	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for auth module.
 func QueryAuth(i core.QueryClient) (string, error) {
+	res, err := QueryAuthProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for auth module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryAuthProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcAuth(i)
 	} else {
@@ -25,7 +39,10 @@ func QueryAuth(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcAuth(i core.QueryClient) (string, error) {
+func queryByGrpcAuth(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := authtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -37,7 +54,7 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Auth account
@@ -48,7 +65,7 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Auth accounts
@@ -59,59 +76,54 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Auth tx by event
 	case i.Ixplac.GetMsgType() == AuthQueryTxsByEventsMsgType:
 		if i.Ixplac.GetRpc() == "" {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "query txs by events, need RPC URL when txs methods"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "query txs by events, need RPC URL when txs methods"))
 		}
 		convertMsg := i.Ixplac.GetMsg().(QueryTxsByEventParseMsg)
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 
 		res, err = authtx.QueryTxsByEvents(clientCtx, convertMsg.TmEvents, convertMsg.Page, convertMsg.Limit, "")
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
 		}
 
 	// Auth tx
 	case i.Ixplac.GetMsgType() == AuthQueryTxMsgType:
 		if i.Ixplac.GetRpc() == "" {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "auth query tx msg, need RPC URL when txs methods"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "auth query tx msg, need RPC URL when txs methods"))
 		}
 		convertMsg := i.Ixplac.GetMsg().(QueryTxParseMsg)
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 
 		if convertMsg.TxType == "hash" {
 			res, err = authtx.QueryTx(clientCtx, convertMsg.TmEvents[0])
 			if err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
+				return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
 			}
 		} else {
 			res, err = authtx.QueryTxsByEvents(clientCtx, convertMsg.TmEvents, rest.DefaultPage, rest.DefaultLimit, "")
 			if err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
+				return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrRpcRequest, err))
 			}
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
-	}
-
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -120,30 +132,35 @@ const (
 	authTxsLabel      = "txs"
 )
 
-func queryByLcdAuth(i core.QueryClient) (string, error) {
+func queryByLcdAuth(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
 
 	url := util.MakeQueryLcdUrl(authv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Auth params
 	case i.Ixplac.GetMsgType() == AuthQueryParamsMsgType:
+		res = &authtypes.QueryParamsResponse{}
 		url = url + authParamsLabel
 
 	// Auth account
 	case i.Ixplac.GetMsgType() == AuthQueryAccAddressMsgType:
+		res = &authtypes.QueryAccountResponse{}
 		convertMsg := i.Ixplac.GetMsg().(authtypes.QueryAccountRequest)
 		url = url + util.MakeQueryLabels(authAccountsLabel, convertMsg.Address)
 
 	// Auth accounts
 	case i.Ixplac.GetMsgType() == AuthQueryAccountsMsgType:
+		res = &authtypes.QueryAccountsResponse{}
 		url = url + authAccountsLabel
 
 	// Auth tx by event
 	case i.Ixplac.GetMsgType() == AuthQueryTxsByEventsMsgType:
+		res = &txtypes.GetTxsEventResponse{}
 		convertMsg := i.Ixplac.GetMsg().(QueryTxsByEventParseMsg)
 
 		if len(convertMsg.TmEvents) > 1 {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "support only one event on the LCD"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "support only one event on the LCD"))
 		}
 
 		parsedEvent := convertMsg.TmEvents[0]
@@ -162,7 +179,7 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(QueryTxParseMsg)
 
 		if len(convertMsg.TmEvents) > 1 {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "support only one event on the LCD"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "support only one event on the LCD"))
 		}
 
 		parsedValue := convertMsg.TmEvents
@@ -170,17 +187,19 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 
 		url = "/cosmos/tx/v1beta1/"
 		if parsedTxType == "hash" {
+			res = &txtypes.GetTxResponse{}
 			url = url + util.MakeQueryLabels(authTxsLabel, parsedValue[0])
 
 		} else if parsedTxType == "signature" {
 			// inactivate
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "inactivate GetTxEvent('signature') when using LCD because of sometimes generating parsing error that based64 encoded signature has '='"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "inactivate GetTxEvent('signature') when using LCD because of sometimes generating parsing error that based64 encoded signature has '='"))
 			// events := "?events=" + parsedValue
 			// page := "&pagination.page=" + util.FromIntToString(rest.DefaultPage)
 			// limit := "&pagination.limit=" + util.FromIntToString(rest.DefaultLimit)
 
 			// url = url + authTxsLabel + events + page + limit
 		} else {
+			res = &txtypes.GetTxsEventResponse{}
 			events := "?events=" + parsedValue[0]
 			page := "&pagination.page=" + util.FromIntToString(rest.DefaultPage)
 			limit := "&pagination.limit=" + util.FromIntToString(rest.DefaultLimit)
@@ -189,16 +208,41 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	// Responses of txs are converted to the same type of results of querying by using RPC.
+	switch lcdRes := res.(type) {
+	case *txtypes.GetTxResponse:
+		return lcdRes.TxResponse, nil
+
+	case *txtypes.GetTxsEventResponse:
+		page, limit := rest.DefaultPage, rest.DefaultLimit
+		if i.Ixplac.GetMsgType() == AuthQueryTxsByEventsMsgType {
+			convertMsg := i.Ixplac.GetMsg().(QueryTxsByEventParseMsg)
+			page, limit = convertMsg.Page, convertMsg.Limit
+		}
+
+		return sdk.NewSearchTxsResult(
+			lcdRes.GetPagination().GetTotal(),
+			uint64(len(lcdRes.TxResponses)),
+			uint64(page),
+			uint64(limit),
+			lcdRes.TxResponses,
+		), nil
+	}
+
+	return res, nil
 }
@@ -14,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	ethermint "github.com/evmos/ethermint/types"
@@ -181,28 +180,15 @@ func (s *IntegrationTestSuite) TestQueryTxByEventAndQueryTx() {
 		res1, err := s.xplac.Tx(queryTxMsg).Query()
 		s.Require().NoError(err)
 
-		if i == 0 {
-			var getTxsEventResponse tx.GetTxsEventResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &getTxsEventResponse)
-
-			s.Require().Equal(2, len(getTxsEventResponse.TxResponses))
-			s.Require().Equal(2, len(getTxsEventResponse.Txs))
-
-			var txResponse tx.GetTxResponse
-			jsonpb.Unmarshal(strings.NewReader(res1), &txResponse)
+		var searchTxsResult sdk.SearchTxsResult
+		jsonpb.Unmarshal(strings.NewReader(res), &searchTxsResult)
 
-			s.Require().Equal(txHash, txResponse.TxResponse.TxHash)
-		} else {
-			var searchTxsResult sdk.SearchTxsResult
-			jsonpb.Unmarshal(strings.NewReader(res), &searchTxsResult)
-
-			s.Require().Equal(2, len(searchTxsResult.Txs))
+		s.Require().Equal(2, len(searchTxsResult.Txs))
 
-			var txResponse sdk.TxResponse
-			jsonpb.Unmarshal(strings.NewReader(res1), &txResponse)
+		var txResponse sdk.TxResponse
+		jsonpb.Unmarshal(strings.NewReader(res1), &txResponse)
 
-			s.Require().Equal(txHash, txResponse.TxHash)
-		}
+		s.Require().Equal(txHash, txResponse.TxHash)
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
@@ -74,7 +74,7 @@ func (e AuthzExternal) AuthzExec(authzExecMsg types.AuthzExecMsg) provider.XplaC
 func (e AuthzExternal) QueryAuthzGrants(queryAuthzGrantMsg types.QueryAuthzGrantMsg) provider.XplaClient {
 	switch {
 	case queryAuthzGrantMsg.Grantee != "" && queryAuthzGrantMsg.Granter != "":
-		msg, err := MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg)
+		msg, err := MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(AuthzQueryGrantMsgType, err)
 		}
@@ -82,7 +82,7 @@ func (e AuthzExternal) QueryAuthzGrants(queryAuthzGrantMsg types.QueryAuthzGrant
 		return e.ToExternal(AuthzQueryGrantMsgType, msg)
 
 	case queryAuthzGrantMsg.Grantee != "" && queryAuthzGrantMsg.Granter == "":
-		msg, err := MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg)
+		msg, err := MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(AuthzQueryGrantsByGranteeMsgType, err)
 		}
@@ -90,7 +90,7 @@ func (e AuthzExternal) QueryAuthzGrants(queryAuthzGrantMsg types.QueryAuthzGrant
 		return e.ToExternal(AuthzQueryGrantsByGranteeMsgType, msg)
 
 	case queryAuthzGrantMsg.Grantee == "" && queryAuthzGrantMsg.Granter != "":
-		msg, err := MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg)
+		msg, err := MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(AuthzQueryGrantsByGranterMsgType, err)
 		}
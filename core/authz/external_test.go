@@ -16,7 +16,7 @@ func (s *IntegrationTestSuite) TestAuthzTx() {
 		Granter:           account0.Address.String(),
 		Grantee:           account1.Address.String(),
 		AuthorizationType: "send",
-		SpendLimit:        "1000",
+		SpendLimit:        "1000axpla",
 	}
 	s.xplac.AuthzGrant(authzGrantMsg)
 
@@ -61,7 +61,7 @@ func (s *IntegrationTestSuite) TestAuthzTx() {
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: account0.Address.String(),
 		ToAddress:   account1.Address.String(),
-		Amount:      "1000",
+		Amount:      "1000axpla",
 	}
 
 	txbytesBankSend, err := s.xplac.BankSend(bankSendMsg).CreateAndSignTx()
@@ -102,7 +102,7 @@ func (s *IntegrationTestSuite) TestAuthz() {
 	}
 	s.xplac.QueryAuthzGrants(queryAuthzGrantMsg)
 
-	authzGrantsMsg, err := mauthz.MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg)
+	authzGrantsMsg, err := mauthz.MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(authzGrantsMsg, s.xplac.GetMsg())
@@ -115,7 +115,7 @@ func (s *IntegrationTestSuite) TestAuthz() {
 	}
 	s.xplac.QueryAuthzGrants(queryAuthzGrantMsg)
 
-	authzGrantsByGranteeMsg, err := mauthz.MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg)
+	authzGrantsByGranteeMsg, err := mauthz.MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(authzGrantsByGranteeMsg, s.xplac.GetMsg())
@@ -128,7 +128,7 @@ func (s *IntegrationTestSuite) TestAuthz() {
 	}
 	s.xplac.QueryAuthzGrants(queryAuthzGrantMsg)
 
-	authzGrantsByGranterMsg, err := mauthz.MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg)
+	authzGrantsByGranterMsg, err := mauthz.MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(authzGrantsByGranterMsg, s.xplac.GetMsg())
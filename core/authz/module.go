@@ -6,6 +6,7 @@ import (
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -51,3 +52,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryAuthz(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryAuthzProto(q)
+}
@@ -29,7 +29,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Granter:           accounts[0].Address.String(),
 		Grantee:           accounts[1].Address.String(),
 		AuthorizationType: "send",
-		SpendLimit:        "1000",
+		SpendLimit:        "1000axpla",
 	}
 
 	makeAuthzGrantMsg, err := authz.MakeAuthzGrantMsg(authzGrantMsg, s.xplac.GetFromAddress())
@@ -60,7 +60,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: accounts[0].Address.String(),
 		ToAddress:   accounts[1].Address.String(),
-		Amount:      "1000",
+		Amount:      "1000axpla",
 	}
 
 	txbytesBankSend, err := s.xplac.BankSend(bankSendMsg).CreateAndSignTx()
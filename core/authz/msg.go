@@ -2,6 +2,7 @@ package authz
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla/app/params"
@@ -23,16 +24,16 @@ func MakeAuthzExecMsg(authzExecMsg types.AuthzExecMsg, encodingConfig params.Enc
 }
 
 // (Query) make msg - authz grants
-func MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGrantsRequest, error) {
-	return parseQueryAuthzGrantsArgs(queryAuthzGrantMsg)
+func MakeQueryAuthzGrantsMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGrantsRequest, error) {
+	return parseQueryAuthzGrantsArgs(queryAuthzGrantMsg, pageRequest)
 }
 
 // (Query) make msg - authz grants by grantee
-func MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGranteeGrantsRequest, error) {
-	return parseQueryAuthzGrantsByGranteeArgs(queryAuthzGrantMsg)
+func MakeQueryAuthzGrantsByGranteeMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGranteeGrantsRequest, error) {
+	return parseQueryAuthzGrantsByGranteeArgs(queryAuthzGrantMsg, pageRequest)
 }
 
 // (Query) make msg - authz grants by granter
-func MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGranterGrantsRequest, error) {
-	return parseQueryAuthzGrantsByGranterArgs(queryAuthzGrantMsg)
+func MakeQueryAuthzGrantsByGranterMsg(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGranterGrantsRequest, error) {
+	return parseQueryAuthzGrantsByGranterArgs(queryAuthzGrantMsg, pageRequest)
 }
@@ -4,11 +4,11 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 	"github.com/xpladev/xpla/app/params"
@@ -36,7 +36,7 @@ func parseAuthzGrantArgs(authzGrantMsg types.AuthzGrantMsg, granter sdk.AccAddre
 		if limit == "" {
 			return authz.MsgGrant{}, types.ErrWrap(types.ErrInsufficientParams, "require bank spend limit")
 		}
-		spendLimit, err := sdk.ParseCoinsNormalized(util.DenomAdd(limit))
+		spendLimit, err := util.ParseCoins(limit)
 		if err != nil {
 			return authz.MsgGrant{}, types.ErrWrap(types.ErrParse, err)
 		}
@@ -59,7 +59,7 @@ func parseAuthzGrantArgs(authzGrantMsg types.AuthzGrantMsg, granter sdk.AccAddre
 
 		var delegateLimit *sdk.Coin
 
-		spendLimit, err := sdk.ParseCoinsNormalized(util.DenomAdd(limit))
+		spendLimit, err := util.ParseCoins(limit)
 		if err != nil {
 			return authz.MsgGrant{}, types.ErrWrap(types.ErrParse, err)
 		}
@@ -148,7 +148,7 @@ func parseAuthzExecArgs(authzExecMsg types.AuthzExecMsg, encodingConfig params.E
 }
 
 // Parsing - authz grants
-func parseQueryAuthzGrantsArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGrantsRequest, error) {
+func parseQueryAuthzGrantsArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGrantsRequest, error) {
 	granter, err := sdk.AccAddressFromBech32(queryAuthzGrantMsg.Granter)
 	if err != nil {
 		return authz.QueryGrantsRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -170,12 +170,12 @@ func parseQueryAuthzGrantsArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (aut
 		Granter:    granter.String(),
 		Grantee:    grantee.String(),
 		MsgTypeUrl: msgAuthorized,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // Parsing - authz grants by grantee
-func parseQueryAuthzGrantsByGranteeArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGranteeGrantsRequest, error) {
+func parseQueryAuthzGrantsByGranteeArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGranteeGrantsRequest, error) {
 	grantee, err := sdk.AccAddressFromBech32(queryAuthzGrantMsg.Grantee)
 	if err != nil {
 		return authz.QueryGranteeGrantsRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -183,12 +183,12 @@ func parseQueryAuthzGrantsByGranteeArgs(queryAuthzGrantMsg types.QueryAuthzGrant
 
 	return authz.QueryGranteeGrantsRequest{
 		Grantee:    grantee.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // Parsing - authz grants by granter
-func parseQueryAuthzGrantsByGranterArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg) (authz.QueryGranterGrantsRequest, error) {
+func parseQueryAuthzGrantsByGranterArgs(queryAuthzGrantMsg types.QueryAuthzGrantMsg, pageRequest *query.PageRequest) (authz.QueryGranterGrantsRequest, error) {
 	granter, err := sdk.AccAddressFromBech32(queryAuthzGrantMsg.Granter)
 	if err != nil {
 		return authz.QueryGranterGrantsRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -196,6 +196,6 @@ func parseQueryAuthzGrantsByGranterArgs(queryAuthzGrantMsg types.QueryAuthzGrant
 
 	return authz.QueryGranterGrantsRequest{
 		Granter:    granter.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
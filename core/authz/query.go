@@ -10,12 +10,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for authz module.
 func QueryAuthz(i core.QueryClient) (string, error) {
+	res, err := QueryAuthzProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for authz module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryAuthzProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcAuthz(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryAuthz(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcAuthz(i core.QueryClient) (string, error) {
+func queryByGrpcAuthz(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := authz.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcAuthz(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Authz grant by grantee
@@ -46,7 +61,7 @@ func queryByGrpcAuthz(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Authz grant by granter
@@ -57,32 +72,29 @@ func queryByGrpcAuthz(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
 	authzGrantsLabel = "grants"
 )
 
-func queryByLcdAuthz(i core.QueryClient) (string, error) {
+func queryByLcdAuthz(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
 
 	url := util.MakeQueryLcdUrl(authzv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Authz grant
 	case i.Ixplac.GetMsgType() == AuthzQueryGrantMsgType:
+		res = &authz.QueryGrantsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(authz.QueryGrantsRequest)
 		parsedGranter := convertMsg.Granter
 		parsedGrantee := convertMsg.Grantee
@@ -94,6 +106,7 @@ func queryByLcdAuthz(i core.QueryClient) (string, error) {
 
 	// Authz grant by grantee
 	case i.Ixplac.GetMsgType() == AuthzQueryGrantsByGranteeMsgType:
+		res = &authz.QueryGranteeGrantsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(authz.QueryGranteeGrantsRequest)
 		grantee := convertMsg.Grantee
 
@@ -101,22 +114,27 @@ func queryByLcdAuthz(i core.QueryClient) (string, error) {
 
 	// Authz grant by granter
 	case i.Ixplac.GetMsgType() == AuthzQueryGrantsByGranterMsgType:
+		res = &authz.QueryGranterGrantsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(authz.QueryGranterGrantsRequest)
 		granter := convertMsg.Granter
 
 		url = url + util.MakeQueryLabels(authzGrantsLabel, "granter", granter)
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
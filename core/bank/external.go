@@ -53,7 +53,7 @@ func (e BankExternal) BankSend(bankSendMsg types.BankSendMsg) provider.XplaClien
 func (e BankExternal) BankBalances(bankBalancesMsg types.BankBalancesMsg) provider.XplaClient {
 	switch {
 	case bankBalancesMsg.Denom == "":
-		msg, err := MakeBankAllBalancesMsg(bankBalancesMsg)
+		msg, err := MakeBankAllBalancesMsg(bankBalancesMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(BankAllBalancesMsgType, err)
 		}
@@ -74,7 +74,7 @@ func (e BankExternal) BankBalances(bankBalancesMsg types.BankBalancesMsg) provid
 func (e BankExternal) DenomMetadata(denomMetadataMsg ...types.DenomMetadataMsg) provider.XplaClient {
 	switch {
 	case len(denomMetadataMsg) == 0:
-		msg, err := MakeDenomsMetaDataMsg()
+		msg, err := MakeDenomsMetaDataMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(BankDenomsMetadataMsgType, err)
 		}
@@ -97,7 +97,7 @@ func (e BankExternal) DenomMetadata(denomMetadataMsg ...types.DenomMetadataMsg)
 // Query the total supply of coins of the chain.
 func (e BankExternal) Total(totalMsg ...types.TotalMsg) provider.XplaClient {
 	if len(totalMsg) == 0 {
-		msg, err := MakeTotalSupplyMsg()
+		msg, err := MakeTotalSupplyMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(BankTotalMsgType, err)
 		}
@@ -15,7 +15,7 @@ func (s *IntegrationTestSuite) TestBankTx() {
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: account0.Address.String(),
 		ToAddress:   account1.Address.String(),
-		Amount:      "1000",
+		Amount:      "1000axpla",
 	}
 	s.xplac.BankSend(bankSendMsg)
 
@@ -32,6 +32,17 @@ func (s *IntegrationTestSuite) TestBankTx() {
 	bankSendJsonTxbytes, err := s.xplac.EncodedTxbytesToJsonTx(bankSendTxbytes)
 	s.Require().NoError(err)
 	s.Require().Equal(testutil.BankSendTxTemplates, string(bankSendJsonTxbytes))
+
+	// the amount must have the denom
+	bankSendMsg.Amount = "1000"
+	_, err = mbank.MakeBankSendMsg(bankSendMsg)
+	s.Require().Error(err)
+
+	// arbitrary denoms
+	bankSendMsg.Amount = "1000axpla,10ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	makeBankSendMsg, err = mbank.MakeBankSendMsg(bankSendMsg)
+	s.Require().NoError(err)
+	s.Require().Len(makeBankSendMsg.Amount, 2)
 }
 
 func (s *IntegrationTestSuite) TestBank() {
@@ -43,7 +54,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	}
 	s.xplac.BankBalances(bankBalancesMsg)
 
-	makeBankAllBalancesMsg, err := mbank.MakeBankAllBalancesMsg(bankBalancesMsg)
+	makeBankAllBalancesMsg, err := mbank.MakeBankAllBalancesMsg(bankBalancesMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeBankAllBalancesMsg, s.xplac.GetMsg())
@@ -67,7 +78,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	// denoms metadata
 	s.xplac.DenomMetadata()
 
-	makeDenomsMetaDataMsg, err := mbank.MakeDenomsMetaDataMsg()
+	makeDenomsMetaDataMsg, err := mbank.MakeDenomsMetaDataMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeDenomsMetaDataMsg, s.xplac.GetMsg())
@@ -90,7 +101,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	// total supply
 	s.xplac.Total()
 
-	makeTotalSupplyMsg, err := mbank.MakeTotalSupplyMsg()
+	makeTotalSupplyMsg, err := mbank.MakeTotalSupplyMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeTotalSupplyMsg, s.xplac.GetMsg())
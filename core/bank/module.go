@@ -6,6 +6,7 @@ import (
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -37,3 +38,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryBank(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryBankProto(q)
+}
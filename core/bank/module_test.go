@@ -28,7 +28,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: accounts[0].Address.String(),
 		ToAddress:   accounts[1].Address.String(),
-		Amount:      "1000",
+		Amount:      "1000axpla",
 	}
 
 	makeBankSendMsg, err := bank.MakeBankSendMsg(bankSendMsg)
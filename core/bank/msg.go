@@ -1,9 +1,9 @@
 package bank
 
 import (
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -13,12 +13,12 @@ func MakeBankSendMsg(bankSendMsg types.BankSendMsg) (banktypes.MsgSend, error) {
 }
 
 // (Query) make msg - all balances
-func MakeBankAllBalancesMsg(bankBalancesMsg types.BankBalancesMsg) (banktypes.QueryAllBalancesRequest, error) {
+func MakeBankAllBalancesMsg(bankBalancesMsg types.BankBalancesMsg, pageRequest *query.PageRequest) (banktypes.QueryAllBalancesRequest, error) {
 	if (types.BankBalancesMsg{}) == bankBalancesMsg {
 		return banktypes.QueryAllBalancesRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 
-	return parseBankAllBalancesArgs(bankBalancesMsg)
+	return parseBankAllBalancesArgs(bankBalancesMsg, pageRequest)
 }
 
 // (Query) make msg - balance
@@ -31,8 +31,8 @@ func MakeBankBalanceMsg(bankBalancesMsg types.BankBalancesMsg) (banktypes.QueryB
 }
 
 // (Query) make msg - denominations metadata
-func MakeDenomsMetaDataMsg() (banktypes.QueryDenomsMetadataRequest, error) {
-	return banktypes.QueryDenomsMetadataRequest{}, nil
+func MakeDenomsMetaDataMsg(pageRequest *query.PageRequest) (banktypes.QueryDenomsMetadataRequest, error) {
+	return banktypes.QueryDenomsMetadataRequest{Pagination: pageRequest}, nil
 }
 
 // (Query) make msg - denomination metadata
@@ -43,8 +43,8 @@ func MakeDenomMetaDataMsg(denomMetadataMsg types.DenomMetadataMsg) (banktypes.Qu
 }
 
 // (Query) make msg - total supply
-func MakeTotalSupplyMsg() (banktypes.QueryTotalSupplyRequest, error) {
-	return banktypes.QueryTotalSupplyRequest{Pagination: core.PageRequest}, nil
+func MakeTotalSupplyMsg(pageRequest *query.PageRequest) (banktypes.QueryTotalSupplyRequest, error) {
+	return banktypes.QueryTotalSupplyRequest{Pagination: pageRequest}, nil
 }
 
 // (Query) make msg - supply of
@@ -1,31 +1,29 @@
 package bank
 
 import (
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Parsing - bank send
 func parseBankSendArgs(bankSendMsg types.BankSendMsg) (banktypes.MsgSend, error) {
-	denom := types.XplaDenom
-
 	if bankSendMsg.FromAddress == "" || bankSendMsg.ToAddress == "" || bankSendMsg.Amount == "" {
 		return banktypes.MsgSend{}, types.ErrWrap(types.ErrInsufficientParams, "no parameters")
 	}
 
-	amountBigInt, ok := sdk.NewIntFromString(util.DenomRemove(bankSendMsg.Amount))
-	if !ok {
-		return banktypes.MsgSend{}, types.ErrWrap(types.ErrParse, "Wrong amount parameter")
+	amount, err := util.ParseCoins(bankSendMsg.Amount)
+	if err != nil {
+		return banktypes.MsgSend{}, types.ErrWrap(types.ErrParse, "Wrong amount parameter", err)
 	}
 
 	msg := banktypes.MsgSend{
 		FromAddress: bankSendMsg.FromAddress,
 		ToAddress:   bankSendMsg.ToAddress,
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, amountBigInt)),
+		Amount:      amount,
 	}
 
 	return msg, nil
@@ -33,13 +31,13 @@ func parseBankSendArgs(bankSendMsg types.BankSendMsg) (banktypes.MsgSend, error)
 }
 
 // Parsing - all balances
-func parseBankAllBalancesArgs(bankBalancesMsg types.BankBalancesMsg) (banktypes.QueryAllBalancesRequest, error) {
+func parseBankAllBalancesArgs(bankBalancesMsg types.BankBalancesMsg, pageRequest *query.PageRequest) (banktypes.QueryAllBalancesRequest, error) {
 	addr, err := sdk.AccAddressFromBech32(bankBalancesMsg.Address)
 	if err != nil {
 		return banktypes.QueryAllBalancesRequest{}, types.ErrWrap(types.ErrParse, err)
 	}
 
-	params := *banktypes.NewQueryAllBalancesRequest(addr, core.PageRequest)
+	params := *banktypes.NewQueryAllBalancesRequest(addr, pageRequest)
 	return params, nil
 }
 
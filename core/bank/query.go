@@ -10,12 +10,24 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for bank module.
 func QueryBank(i core.QueryClient) (string, error) {
+	res, err := QueryBankProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for bank module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryBankProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcBank(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryBank(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcBank(i core.QueryClient) (string, error) {
+func queryByGrpcBank(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := banktypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Bank balance
@@ -46,7 +61,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Bank denominations metadata
@@ -57,7 +72,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Bank denomination metadata
@@ -68,7 +83,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Bank total
@@ -79,7 +94,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Bank total supply
@@ -90,19 +105,14 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -111,50 +121,63 @@ const (
 	bankSupplyLabel        = "supply"
 )
 
-func queryByLcdBank(i core.QueryClient) (string, error) {
+func queryByLcdBank(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(bankv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Bank balances
 	case i.Ixplac.GetMsgType() == BankAllBalancesMsgType:
+		res = &banktypes.QueryAllBalancesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(banktypes.QueryAllBalancesRequest)
 		url = url + util.MakeQueryLabels(bankBalancesLabel, convertMsg.Address)
 
 	// Bank balance
 	case i.Ixplac.GetMsgType() == BankBalanceMsgType:
+		res = &banktypes.QueryBalanceResponse{}
 		// not supported now.
 		convertMsg := i.Ixplac.GetMsg().(banktypes.QueryBalanceRequest)
 		url = url + util.MakeQueryLabels(bankBalancesLabel, convertMsg.Address, convertMsg.Denom)
 
 	// Bank denominations metadata
 	case i.Ixplac.GetMsgType() == BankDenomsMetadataMsgType:
-		url = url + bankDenomMetadataLabel
+		res = &banktypes.QueryDenomsMetadataResponse{}
+		convertMsg := i.Ixplac.GetMsg().(banktypes.QueryDenomsMetadataRequest)
+		url = url + bankDenomMetadataLabel + core.LcdPaginationQuery(convertMsg.Pagination)
 
 	// Bank denomination metadata
 	case i.Ixplac.GetMsgType() == BankDenomMetadataMsgType:
+		res = &banktypes.QueryDenomMetadataResponse{}
 		convertMsg := i.Ixplac.GetMsg().(banktypes.QueryDenomMetadataRequest)
 		url = url + util.MakeQueryLabels(bankDenomMetadataLabel, convertMsg.Denom)
 
 	// Bank total
 	case i.Ixplac.GetMsgType() == BankTotalMsgType:
+		res = &banktypes.QueryTotalSupplyResponse{}
 		url = url + bankSupplyLabel
 
 	// Bank total supply
 	case i.Ixplac.GetMsgType() == BankTotalSupplyOfMsgType:
+		res = &banktypes.QuerySupplyOfResponse{}
 		convertMsg := i.Ixplac.GetMsg().(banktypes.QuerySupplyOfRequest)
 		url = url + util.MakeQueryLabels(bankSupplyLabel, convertMsg.Denom)
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
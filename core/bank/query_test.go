@@ -124,6 +124,24 @@ func (s *IntegrationTestSuite) TestDenomMetadata() {
 		s.Require().Equal(types.XplaDenom, denomsMetadataResponse.Metadatas[0].Base)
 		s.Require().Equal("node0token", denomsMetadataResponse.Metadatas[1].Base)
 
+		// every option of the pagination is applied to the metadata list
+		s.xplac.WithPagination(types.Pagination{
+			Limit:      1,
+			CountTotal: true,
+			Reverse:    true,
+		})
+		res, err = s.xplac.DenomMetadata().Query()
+		s.Require().NoError(err)
+		s.xplac.WithPagination(types.Pagination{})
+
+		denomsMetadataResponse = banktypes.QueryDenomsMetadataResponse{}
+		jsonpb.Unmarshal(strings.NewReader(res), &denomsMetadataResponse)
+
+		s.Require().Equal(1, len(denomsMetadataResponse.Metadatas))
+		s.Require().Equal("node0token", denomsMetadataResponse.Metadatas[0].Base)
+		s.Require().Equal(uint64(2), denomsMetadataResponse.Pagination.Total)
+		s.Require().NotEmpty(denomsMetadataResponse.Pagination.NextKey)
+
 		denomMetadataMsg := types.DenomMetadataMsg{
 			Denom: types.XplaDenom,
 		}
@@ -181,6 +199,38 @@ func (s *IntegrationTestSuite) TestBankTotal() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *IntegrationTestSuite) TestQueryProto() {
+	validator := s.network.Validators[0]
+	addr := validator.Address.String()
+
+	var responses []*banktypes.QueryAllBalancesResponse
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		bankBalancesMsg := types.BankBalancesMsg{
+			Address: addr,
+		}
+
+		res, err := s.xplac.BankBalances(bankBalancesMsg).QueryProto()
+		s.Require().NoError(err)
+
+		allBalancesResponse, ok := res.(*banktypes.QueryAllBalancesResponse)
+		s.Require().True(ok)
+		s.Require().Equal(2, len(allBalancesResponse.Balances))
+		s.Require().Equal(types.XplaDenom, allBalancesResponse.Balances[0].Denom)
+
+		responses = append(responses, allBalancesResponse)
+	}
+
+	// same results regardless of the query type
+	s.Require().Equal(responses[0].Balances, responses[1].Balances)
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = validatorNumber
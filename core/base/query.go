@@ -11,21 +11,51 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
-// Query client for bank module.
+// Query client for base module.
 func QueryBase(i core.QueryClient) (string, error) {
+	// Query block by using RPC if the RPC URL exists.
+	if i.Ixplac.GetRpc() != "" {
+		switch {
+		// Latest block
+		case i.Ixplac.GetMsgType() == BaseLatestBlockMsgtype:
+			var height *int64
+			return queryBlockByRpc(i, height)
+
+		// Block by height
+		case i.Ixplac.GetMsgType() == BaseBlockByHeightMsgType:
+			convertMsg := i.Ixplac.GetMsg().(tmservice.GetBlockByHeightRequest)
+			height := &convertMsg.Height
+			return queryBlockByRpc(i, height)
+		}
+	}
+
+	res, err := QueryBaseProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for base module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryBaseProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcBase(i)
 	} else {
 		return queryByLcdBase(i)
 	}
-
 }
 
-func queryByGrpcBase(i core.QueryClient) (string, error) {
+func queryByGrpcBase(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	serviceClient := tmservice.NewServiceClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -37,7 +67,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Syncing
@@ -48,41 +78,29 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Latest block
 	case i.Ixplac.GetMsgType() == BaseLatestBlockMsgtype:
-		if i.Ixplac.GetRpc() != "" {
-			var height *int64
-			return queryBlockByRpc(i, height)
-
-		} else {
-			convertMsg := i.Ixplac.GetMsg().(tmservice.GetLatestBlockRequest)
-			res, err = serviceClient.GetLatestBlock(
-				i.Ixplac.GetContext(),
-				&convertMsg,
-			)
-			if err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-			}
+		convertMsg := i.Ixplac.GetMsg().(tmservice.GetLatestBlockRequest)
+		res, err = serviceClient.GetLatestBlock(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Block by height
 	case i.Ixplac.GetMsgType() == BaseBlockByHeightMsgType:
 		convertMsg := i.Ixplac.GetMsg().(tmservice.GetBlockByHeightRequest)
-		if i.Ixplac.GetRpc() != "" {
-			height := &convertMsg.Height
-			return queryBlockByRpc(i, height)
-
-		} else {
-			res, err = serviceClient.GetBlockByHeight(
-				i.Ixplac.GetContext(),
-				&convertMsg,
-			)
-			if err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-			}
+		res, err = serviceClient.GetBlockByHeight(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Latest validator set
@@ -93,7 +111,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Validator set by height
@@ -104,19 +122,14 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -127,49 +140,61 @@ const (
 	baseValidatorsetsLabel = "validatorsets"
 )
 
-func queryByLcdBase(i core.QueryClient) (string, error) {
+func queryByLcdBase(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(tmv1beta1.Service_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Node info
 	case i.Ixplac.GetMsgType() == BaseNodeInfoMsgType:
+		res = &tmservice.GetNodeInfoResponse{}
 		url = url + baseNodeInfoLabel
 
 	// Syncing
 	case i.Ixplac.GetMsgType() == BaseSyncingMsgType:
+		res = &tmservice.GetSyncingResponse{}
 		url = url + baseSyncingLabel
 
 	// Latest block
 	case i.Ixplac.GetMsgType() == BaseLatestBlockMsgtype:
-		url = util.MakeQueryLabels("/", baseBlocksLabel, baseLatestLabel)
+		res = &tmservice.GetLatestBlockResponse{}
+		url = url + util.MakeQueryLabels(baseBlocksLabel, baseLatestLabel)
 
 	// Block by height
 	case i.Ixplac.GetMsgType() == BaseBlockByHeightMsgType:
+		res = &tmservice.GetBlockByHeightResponse{}
 		convertMsg := i.Ixplac.GetMsg().(tmservice.GetBlockByHeightRequest)
-		url = util.MakeQueryLabels("/", baseBlocksLabel, util.FromInt64ToString(convertMsg.Height))
+		url = url + util.MakeQueryLabels(baseBlocksLabel, util.FromInt64ToString(convertMsg.Height))
 
 	// Latest validator set
 	case i.Ixplac.GetMsgType() == BaseLatestValidatorSetMsgType:
+		res = &tmservice.GetLatestValidatorSetResponse{}
 		url = url + util.MakeQueryLabels(baseValidatorsetsLabel, baseLatestLabel)
 
 	// Validator set by height
 	case i.Ixplac.GetMsgType() == BaseValidatorSetByHeightMsgType:
+		res = &tmservice.GetValidatorSetByHeightResponse{}
 		convertMsg := i.Ixplac.GetMsg().(tmservice.GetValidatorSetByHeightRequest)
 		url = url + util.MakeQueryLabels(baseValidatorsetsLabel, util.FromInt64ToString(convertMsg.Height))
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
 
 func queryBlockByRpc(i core.QueryClient, height *int64) (string, error) {
@@ -1,7 +1,6 @@
 package base_test
 
 import (
-	"encoding/json"
 	"strings"
 	"testing"
 
@@ -15,7 +14,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/stretchr/testify/suite"
-	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	"github.com/xpladev/xpla.go/util/testutil/network"
 )
 
@@ -110,28 +108,15 @@ func (s *IntegrationTestSuite) TestBlock() {
 		res2, err := s.xplac.Block(blockMsg).Query()
 		s.Require().NoError(err)
 
-		if i == 0 {
-			var resultBlock ctypes.ResultBlock
-			json.Unmarshal([]byte(res1), &resultBlock)
-
-			s.Require().Equal(testutil.TestChainId, resultBlock.Block.ChainID)
-
-			json.Unmarshal([]byte(res2), &resultBlock)
-
-			s.Require().Equal(testutil.TestChainId, resultBlock.Block.ChainID)
+		var getLatestBlockResponse tmservice.GetLatestBlockResponse
+		jsonpb.Unmarshal(strings.NewReader(res1), &getLatestBlockResponse)
 
-		} else {
-			var getLatestBlockResponse tmservice.GetLatestBlockResponse
-			jsonpb.Unmarshal(strings.NewReader(res1), &getLatestBlockResponse)
-
-			s.Require().Equal(testutil.TestChainId, getLatestBlockResponse.Block.Header.ChainID)
+		s.Require().Equal(testutil.TestChainId, getLatestBlockResponse.Block.Header.ChainID)
 
-			var getBlockByHeightResponse tmservice.GetBlockByHeightResponse
-			jsonpb.Unmarshal(strings.NewReader(res2), &getBlockByHeightResponse)
-
-			s.Require().Equal(testutil.TestChainId, getBlockByHeightResponse.Block.Header.ChainID)
-		}
+		var getBlockByHeightResponse tmservice.GetBlockByHeightResponse
+		jsonpb.Unmarshal(strings.NewReader(res2), &getBlockByHeightResponse)
 
+		s.Require().Equal(testutil.TestChainId, getBlockByHeightResponse.Block.Header.ChainID)
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
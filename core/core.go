@@ -4,6 +4,7 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/gogo/protobuf/proto"
 )
 
 // The standard form for a module in the core package.
@@ -18,4 +19,9 @@ type CoreModule interface {
 	// Route query requests by gRPC or HTTP.
 	// Queries are returned with string type regardless of communication protocol.
 	NewQueryRouter(QueryClient) (string, error)
+
+	// Route query requests by gRPC or HTTP.
+	// Queries are returned with the protobuf response message of the module.
+	// The type of the response is same regardless of communication protocol.
+	NewQueryProtoRouter(QueryClient) (proto.Message, error)
 }
@@ -6,6 +6,7 @@ import (
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -37,3 +38,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return "", q.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, c.Name(), "module has not query"))
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return nil, q.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, c.Name(), "module has not query"))
+}
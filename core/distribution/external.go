@@ -121,7 +121,7 @@ func (e DistributionExternal) DistCommission(queryDistCommissionMsg types.QueryD
 
 // Query distribution validator slashes.
 func (e DistributionExternal) DistSlashes(queryDistSlashesMsg types.QueryDistSlashesMsg) provider.XplaClient {
-	msg, err := MakeQueryDistSlashesMsg(queryDistSlashesMsg)
+	msg, err := MakeQueryDistSlashesMsg(queryDistSlashesMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(DistributionQuerySlashesMsgType, err)
 	}
@@ -17,7 +17,7 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 	s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// fund community pool
 	fundCommunityPoolMsg := types.FundCommunityPoolMsg{
-		Amount: "1000",
+		Amount: "1000axpla",
 	}
 	s.xplac.FundCommunityPool(fundCommunityPoolMsg)
 
@@ -35,13 +35,17 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 	s.Require().NoError(err)
 	s.Require().Equal(testutil.DistFundCommunityPoolTxTemplates, string(distFundCommunityPoolJsonTxbytes))
 
+	// the amount must have the denom
+	_, err = mdist.MakeFundCommunityPoolMsg(types.FundCommunityPoolMsg{Amount: "1000"}, s.xplac.GetFromAddress())
+	s.Require().Error(err)
+
 	// community pool spend
 	communityPoolSpendMsg := types.CommunityPoolSpendMsg{
 		Title:       "community pool spend",
 		Description: "pay me",
 		Recipient:   accounts[0].Address.String(),
-		Amount:      "1000",
-		Deposit:     "1000",
+		Amount:      "1000axpla",
+		Deposit:     "1000axpla",
 	}
 	s.xplac.CommunityPoolSpend(communityPoolSpendMsg)
 
@@ -150,7 +154,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	}
 	s.xplac.DistSlashes(queryDistSlashesMsg)
 
-	makeQueryDistSlashesMsg, err := mdist.MakeQueryDistSlashesMsg(queryDistSlashesMsg)
+	makeQueryDistSlashesMsg, err := mdist.MakeQueryDistSlashesMsg(queryDistSlashesMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDistSlashesMsg, s.xplac.GetMsg())
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -67,3 +68,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryDistribution(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryDistributionProto(q)
+}
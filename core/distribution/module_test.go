@@ -27,7 +27,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 
 	// fund community pool
 	fundCommunityPoolMsg := types.FundCommunityPoolMsg{
-		Amount: "1000",
+		Amount: "1000axpla",
 	}
 
 	makeFundCommunityPoolMsg, err := distribution.MakeFundCommunityPoolMsg(fundCommunityPoolMsg, s.xplac.GetFromAddress())
@@ -43,8 +43,8 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Title:       "community pool spend",
 		Description: "pay me",
 		Recipient:   accounts[0].Address.String(),
-		Amount:      "1000",
-		Deposit:     "1000",
+		Amount:      "1000axpla",
+		Deposit:     "1000axpla",
 	}
 
 	makeProposalCommunityPoolSpendMsg, err := distribution.MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg, s.xplac.GetFromAddress(), s.xplac.GetEncoding())
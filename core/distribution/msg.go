@@ -6,6 +6,7 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
@@ -53,8 +54,8 @@ func MakeQueryDistCommissionMsg(queryDistCommissionMsg types.QueryDistCommission
 }
 
 // (Query) make msg - distribution slashes
-func MakeQueryDistSlashesMsg(queryDistSlashesMsg types.QueryDistSlashesMsg) (disttypes.QueryValidatorSlashesRequest, error) {
-	return parseDistSlashesArgs(queryDistSlashesMsg)
+func MakeQueryDistSlashesMsg(queryDistSlashesMsg types.QueryDistSlashesMsg, pageRequest *query.PageRequest) (disttypes.QueryValidatorSlashesRequest, error) {
+	return parseDistSlashesArgs(queryDistSlashesMsg, pageRequest)
 }
 
 // (Query) make msg - distribution rewards
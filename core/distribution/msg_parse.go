@@ -3,11 +3,11 @@ package distribution
 import (
 	"context"
 
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	distcli "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -17,7 +17,7 @@ import (
 
 // Parsing - fund community pool
 func parseFundCommunityPoolArgs(fundCommunityPoolMsg types.FundCommunityPoolMsg, depositorAddr sdk.AccAddress) (disttypes.MsgFundCommunityPool, error) {
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(fundCommunityPoolMsg.Amount))
+	amount, err := util.ParseCoins(fundCommunityPoolMsg.Amount)
 	if err != nil {
 		return disttypes.MsgFundCommunityPool{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -44,12 +44,12 @@ func parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg types.CommunityPo
 		proposal.Deposit = communityPoolSpendMsg.Deposit
 	}
 
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(proposal.Amount))
+	amount, err := util.ParseCoins(proposal.Amount)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
 
-	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(proposal.Deposit))
+	deposit, err := util.ParseCoins(proposal.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -158,7 +158,7 @@ func parseQueryDistCommissionArgs(queryDistCommissionMsg types.QueryDistCommissi
 }
 
 // Parsing - distribution slashes
-func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg) (disttypes.QueryValidatorSlashesRequest, error) {
+func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg, pageRequest *query.PageRequest) (disttypes.QueryValidatorSlashesRequest, error) {
 	valAddr, err := sdk.ValAddressFromBech32(queryDistSlashesMsg.ValidatorAddr)
 	if err != nil {
 		return disttypes.QueryValidatorSlashesRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -172,7 +172,7 @@ func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg) (distty
 		return disttypes.QueryValidatorSlashesRequest{}, types.ErrWrap(types.ErrConvert, err)
 	}
 
-	pageReq := core.PageRequest
+	pageReq := pageRequest
 
 	return disttypes.QueryValidatorSlashesRequest{
 		ValidatorAddress: valAddr.String(),
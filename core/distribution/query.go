@@ -10,12 +10,24 @@ import (
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for distribution module.
 func QueryDistribution(i core.QueryClient) (string, error) {
+	res, err := QueryDistributionProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for distribution module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryDistributionProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcDist(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryDistribution(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcDist(i core.QueryClient) (string, error) {
+func queryByGrpcDist(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := disttypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution validator outstanding rewards
@@ -46,7 +61,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution commission
@@ -57,7 +72,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution slashes
@@ -68,7 +83,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution rewards
@@ -79,7 +94,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution total rewards
@@ -90,7 +105,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Distribution community pool
@@ -101,19 +116,14 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -127,59 +137,72 @@ const (
 	distCommunityPoolLabel      = "community_pool"
 )
 
-func queryByLcdDist(i core.QueryClient) (string, error) {
+func queryByLcdDist(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(distv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Distribution params
 	case i.Ixplac.GetMsgType() == DistributionQueryDistributionParamsMsgType:
+		res = &disttypes.QueryParamsResponse{}
 		url = url + distParamsLabel
 
 	// Distribution validator outstanding rewards
 	case i.Ixplac.GetMsgType() == DistributionValidatorOutstandingRewardsMsgType:
+		res = &disttypes.QueryValidatorOutstandingRewardsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(disttypes.QueryValidatorOutstandingRewardsRequest)
 
 		url = url + util.MakeQueryLabels(distValidatorLabel, convertMsg.ValidatorAddress, distOutstandingRewardsLabel)
 
 	// Distribution commission
 	case i.Ixplac.GetMsgType() == DistributionQueryDistCommissionMsgType:
+		res = &disttypes.QueryValidatorCommissionResponse{}
 		convertMsg := i.Ixplac.GetMsg().(disttypes.QueryValidatorCommissionRequest)
 
 		url = url + util.MakeQueryLabels(distValidatorLabel, convertMsg.ValidatorAddress, distCommissionLabel)
 
 	// Distribution slashes
 	case i.Ixplac.GetMsgType() == DistributionQuerySlashesMsgType:
+		res = &disttypes.QueryValidatorSlashesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(disttypes.QueryValidatorSlashesRequest)
 
 		url = url + util.MakeQueryLabels(distValidatorLabel, convertMsg.ValidatorAddress, distSlashesLabel)
 
 	// Distribution rewards
 	case i.Ixplac.GetMsgType() == DistributionQueryRewardsMsgType:
+		res = &disttypes.QueryDelegationRewardsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(disttypes.QueryDelegationRewardsRequest)
 
 		url = url + util.MakeQueryLabels(distDelegatorLabel, convertMsg.DelegatorAddress, distRewardsLabel, convertMsg.ValidatorAddress)
 
 	// Distribution total rewards
 	case i.Ixplac.GetMsgType() == DistributionQueryTotalRewardsMsgType:
+		res = &disttypes.QueryDelegationTotalRewardsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(disttypes.QueryDelegationTotalRewardsRequest)
 
 		url = url + util.MakeQueryLabels(distDelegatorLabel, convertMsg.DelegatorAddress, distRewardsLabel)
 
 	// Distribution community pool
 	case i.Ixplac.GetMsgType() == DistributionQueryCommunityPoolMsgType:
+		res = &disttypes.QueryCommunityPoolResponse{}
 		url = url + distCommunityPoolLabel
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
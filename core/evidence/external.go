@@ -42,7 +42,7 @@ func (e EvidenceExternal) QueryEvidence(queryEvidenceMsg ...types.QueryEvidenceM
 	switch {
 
 	case len(queryEvidenceMsg) == 0:
-		msg, err := MakeQueryAllEvidenceMsg()
+		msg, err := MakeQueryAllEvidenceMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(EvidenceQueryAllMsgType, err)
 		}
@@ -11,7 +11,7 @@ func (s *IntegrationTestSuite) TestEvidence() {
 	// all evidence
 	s.xplac.QueryEvidence()
 
-	makeQueryAllEvidenceMsg, err := mevidence.MakeQueryAllEvidenceMsg()
+	makeQueryAllEvidenceMsg, err := mevidence.MakeQueryAllEvidenceMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryAllEvidenceMsg, s.xplac.GetMsg())
@@ -5,6 +5,7 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -24,3 +25,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, _ cmclient.TxBuilder, _ st
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryEvidence(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryEvidenceProto(q)
+}
@@ -1,8 +1,8 @@
 package evidence
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 )
 
@@ -12,8 +12,8 @@ func MakeQueryEvidenceMsg(queryEvidenceMsg types.QueryEvidenceMsg) (evidencetype
 }
 
 // (Query) make msg - all evidences
-func MakeQueryAllEvidenceMsg() (evidencetypes.QueryAllEvidenceRequest, error) {
+func MakeQueryAllEvidenceMsg(pageRequest *query.PageRequest) (evidencetypes.QueryAllEvidenceRequest, error) {
 	return evidencetypes.QueryAllEvidenceRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
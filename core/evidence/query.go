@@ -10,12 +10,24 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for evidence module.
 func QueryEvidence(i core.QueryClient) (string, error) {
+	res, err := QueryEvidenceProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for evidence module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryEvidenceProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcEvidence(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryEvidence(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcEvidence(i core.QueryClient) (string, error) {
+func queryByGrpcEvidence(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := evidencetypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcEvidence(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Query evidence
@@ -46,51 +61,54 @@ func queryByGrpcEvidence(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
 	evidenceEvidenceLabel = "evidence"
 )
 
-func queryByLcdEvidence(i core.QueryClient) (string, error) {
+func queryByLcdEvidence(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(evidencev1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Query all evidences
 	case i.Ixplac.GetMsgType() == EvidenceQueryAllMsgType:
+		res = &evidencetypes.QueryAllEvidenceResponse{}
 		url = url + evidenceEvidenceLabel
 
 	// Query evidence
 	case i.Ixplac.GetMsgType() == EvidenceQueryMsgType:
+		res = &evidencetypes.QueryEvidenceResponse{}
 		convertMsg := i.Ixplac.GetMsg().(evidencetypes.QueryEvidenceRequest)
 
 		url = url + util.MakeQueryLabels(evidenceEvidenceLabel, convertMsg.EvidenceHash.String())
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 
 }
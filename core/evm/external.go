@@ -67,6 +67,76 @@ func (e EvmExternal) InvokeSolidityContract(invokeSolContractMsg types.InvokeSol
 	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
 }
 
+// Transfer ERC-20 tokens to the recipient.
+func (e EvmExternal) Erc20Transfer(erc20TransferMsg types.Erc20TransferMsg) provider.XplaClient {
+	msg, err := MakeErc20TransferMsg(erc20TransferMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Approve the spender to transfer ERC-20 tokens of the sender.
+func (e EvmExternal) Erc20Approve(erc20ApproveMsg types.Erc20ApproveMsg) provider.XplaClient {
+	msg, err := MakeErc20ApproveMsg(erc20ApproveMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Transfer ERC-20 tokens of the owner to the recipient by using the allowance of the sender.
+func (e EvmExternal) Erc20TransferFrom(erc20TransferFromMsg types.Erc20TransferFromMsg) provider.XplaClient {
+	msg, err := MakeErc20TransferFromMsg(erc20TransferFromMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Transfer the ERC-721 token safely, which checks that the receiver contract can receive the token.
+func (e EvmExternal) Erc721SafeTransferFrom(erc721SafeTransferFromMsg types.Erc721SafeTransferFromMsg) provider.XplaClient {
+	msg, err := MakeErc721SafeTransferFromMsg(erc721SafeTransferFromMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Approve the address to transfer the ERC-721 token.
+func (e EvmExternal) Erc721Approve(erc721ApproveMsg types.Erc721ApproveMsg) provider.XplaClient {
+	msg, err := MakeErc721ApproveMsg(erc721ApproveMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Approve or remove the operator to transfer all ERC-721 tokens of the sender.
+func (e EvmExternal) Erc721SetApprovalForAll(erc721SetApprovalForAllMsg types.Erc721SetApprovalForAllMsg) provider.XplaClient {
+	msg, err := MakeErc721SetApprovalForAllMsg(erc721SetApprovalForAllMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
+// Transfer amounts of multiple ERC-1155 tokens safely.
+func (e EvmExternal) Erc1155SafeBatchTransferFrom(erc1155SafeBatchTransferFromMsg types.Erc1155SafeBatchTransferFromMsg) provider.XplaClient {
+	msg, err := MakeErc1155SafeBatchTransferFromMsg(erc1155SafeBatchTransferFromMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(EvmInvokeSolContractMsgType, err)
+	}
+
+	return e.ToExternal(EvmInvokeSolContractMsgType, msg)
+}
+
 // Query
 
 // Call(as query) solidity contract.
@@ -281,3 +351,83 @@ func (e EvmExternal) EthGetLogs(ethGetLogsMsg types.EthGetLogsMsg) provider.Xpla
 func (e EvmExternal) EthCoinbase() provider.XplaClient {
 	return e.ToExternal(EvmEthCoinbaseMsgType, nil)
 }
+
+// Query ERC-20 token balance of the account.
+func (e EvmExternal) Erc20BalanceOf(erc20BalanceOfMsg types.Erc20BalanceOfMsg) provider.XplaClient {
+	msg, err := MakeErc20BalanceOfMsg(erc20BalanceOfMsg)
+	if err != nil {
+		return e.Err(EvmErc20BalanceOfMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc20BalanceOfMsgType, msg)
+}
+
+// Query ERC-20 token amount which the spender is allowed to transfer from the owner.
+func (e EvmExternal) Erc20Allowance(erc20AllowanceMsg types.Erc20AllowanceMsg) provider.XplaClient {
+	msg, err := MakeErc20AllowanceMsg(erc20AllowanceMsg)
+	if err != nil {
+		return e.Err(EvmErc20AllowanceMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc20AllowanceMsgType, msg)
+}
+
+// Query ERC-20 token metadata which includes name, symbol, decimals and total supply.
+func (e EvmExternal) Erc20TokenInfo(erc20TokenInfoMsg types.Erc20TokenInfoMsg) provider.XplaClient {
+	msg, err := MakeErc20TokenInfoMsg(erc20TokenInfoMsg)
+	if err != nil {
+		return e.Err(EvmErc20TokenInfoMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc20TokenInfoMsgType, msg)
+}
+
+// Query the owner of the ERC-721 token.
+func (e EvmExternal) Erc721OwnerOf(erc721OwnerOfMsg types.Erc721OwnerOfMsg) provider.XplaClient {
+	msg, err := MakeErc721OwnerOfMsg(erc721OwnerOfMsg)
+	if err != nil {
+		return e.Err(EvmErc721OwnerOfMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc721OwnerOfMsgType, msg)
+}
+
+// Query the metadata URI of the ERC-721 token.
+func (e EvmExternal) Erc721TokenURI(erc721TokenURIMsg types.Erc721TokenURIMsg) provider.XplaClient {
+	msg, err := MakeErc721TokenURIMsg(erc721TokenURIMsg)
+	if err != nil {
+		return e.Err(EvmErc721TokenURIMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc721TokenURIMsgType, msg)
+}
+
+// Query the number of ERC-721 tokens of the owner.
+func (e EvmExternal) Erc721BalanceOf(erc721BalanceOfMsg types.Erc721BalanceOfMsg) provider.XplaClient {
+	msg, err := MakeErc721BalanceOfMsg(erc721BalanceOfMsg)
+	if err != nil {
+		return e.Err(EvmErc721BalanceOfMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc721BalanceOfMsgType, msg)
+}
+
+// Query balances of multiple accounts and ERC-1155 tokens.
+func (e EvmExternal) Erc1155BalanceOfBatch(erc1155BalanceOfBatchMsg types.Erc1155BalanceOfBatchMsg) provider.XplaClient {
+	msg, err := MakeErc1155BalanceOfBatchMsg(erc1155BalanceOfBatchMsg)
+	if err != nil {
+		return e.Err(EvmErc1155BalanceOfBatchMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc1155BalanceOfBatchMsgType, msg)
+}
+
+// Query the metadata URI of the ERC-1155 token.
+func (e EvmExternal) Erc1155Uri(erc1155UriMsg types.Erc1155UriMsg) provider.XplaClient {
+	msg, err := MakeErc1155UriMsg(erc1155UriMsg)
+	if err != nil {
+		return e.Err(EvmErc1155UriMsgType, err)
+	}
+
+	return e.ToExternal(EvmErc1155UriMsgType, msg)
+}
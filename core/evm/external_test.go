@@ -1,6 +1,8 @@
 package evm_test
 
 import (
+	"math/big"
+
 	mevm "github.com/xpladev/xpla.go/core/evm"
 	"github.com/xpladev/xpla.go/types"
 )
@@ -62,6 +64,134 @@ func (s *IntegrationTestSuite) TestEvmTx() {
 	s.Require().Equal(makeInvokeSolContractMsg, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// erc20 transfer
+	erc20TransferMsg := types.Erc20TransferMsg{
+		ContractAddress: testSolContractAddress,
+		Recipient:       account1.PubKey.Address().String(),
+		Amount:          "1.5",
+		Decimals:        "18",
+	}
+	s.xplac.Erc20Transfer(erc20TransferMsg)
+
+	makeErc20TransferMsg, err := mevm.MakeErc20TransferMsg(erc20TransferMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20TransferMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+	s.Require().Equal("1500000000000000000", makeErc20TransferMsg.Args[1].(*big.Int).String())
+
+	// erc20 approve
+	erc20ApproveMsg := types.Erc20ApproveMsg{
+		ContractAddress: testSolContractAddress,
+		Spender:         account1.PubKey.Address().String(),
+		Amount:          "1000",
+	}
+	s.xplac.Erc20Approve(erc20ApproveMsg)
+
+	makeErc20ApproveMsg, err := mevm.MakeErc20ApproveMsg(erc20ApproveMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20ApproveMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// erc20 transfer from
+	erc20TransferFromMsg := types.Erc20TransferFromMsg{
+		ContractAddress: testSolContractAddress,
+		Owner:           account1.PubKey.Address().String(),
+		Recipient:       account0.PubKey.Address().String(),
+		Amount:          "1000",
+	}
+	s.xplac.Erc20TransferFrom(erc20TransferFromMsg)
+
+	makeErc20TransferFromMsg, err := mevm.MakeErc20TransferFromMsg(erc20TransferFromMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20TransferFromMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// erc721 safe transfer from
+	erc721SafeTransferFromMsg := types.Erc721SafeTransferFromMsg{
+		ContractAddress: testSolContractAddress,
+		From:            account0.PubKey.Address().String(),
+		To:              account1.PubKey.Address().String(),
+		TokenId:         "1",
+		Data:            "0x1234",
+	}
+	s.xplac.Erc721SafeTransferFrom(erc721SafeTransferFromMsg)
+
+	makeErc721SafeTransferFromMsg, err := mevm.MakeErc721SafeTransferFromMsg(erc721SafeTransferFromMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721SafeTransferFromMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+	s.Require().Equal(mevm.Erc721ABI, makeErc721SafeTransferFromMsg.ABI)
+	s.Require().Equal([]byte{0x12, 0x34}, makeErc721SafeTransferFromMsg.Args[3])
+
+	// erc721 approve
+	erc721ApproveMsg := types.Erc721ApproveMsg{
+		ContractAddress: testSolContractAddress,
+		To:              account1.PubKey.Address().String(),
+		TokenId:         "1",
+	}
+	s.xplac.Erc721Approve(erc721ApproveMsg)
+
+	makeErc721ApproveMsg, err := mevm.MakeErc721ApproveMsg(erc721ApproveMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721ApproveMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// erc721 set approval for all
+	erc721SetApprovalForAllMsg := types.Erc721SetApprovalForAllMsg{
+		ContractAddress: testSolContractAddress,
+		Operator:        account1.PubKey.Address().String(),
+		Approved:        true,
+	}
+	s.xplac.Erc721SetApprovalForAll(erc721SetApprovalForAllMsg)
+
+	makeErc721SetApprovalForAllMsg, err := mevm.MakeErc721SetApprovalForAllMsg(erc721SetApprovalForAllMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721SetApprovalForAllMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// erc1155 safe batch transfer from
+	erc1155SafeBatchTransferFromMsg := types.Erc1155SafeBatchTransferFromMsg{
+		ContractAddress: testSolContractAddress,
+		From:            account0.PubKey.Address().String(),
+		To:              account1.PubKey.Address().String(),
+		Ids:             []string{"1", "2"},
+		Amounts:         []string{"10", "20"},
+	}
+	s.xplac.Erc1155SafeBatchTransferFrom(erc1155SafeBatchTransferFromMsg)
+
+	makeErc1155SafeBatchTransferFromMsg, err := mevm.MakeErc1155SafeBatchTransferFromMsg(erc1155SafeBatchTransferFromMsg, s.xplac.GetFromAddress())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc1155SafeBatchTransferFromMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+	s.Require().Equal(mevm.Erc1155ABI, makeErc1155SafeBatchTransferFromMsg.ABI)
+
+	// the number of ids and amounts are not same
+	erc1155SafeBatchTransferFromMsg.Amounts = []string{"10"}
+	_, err = mevm.MakeErc1155SafeBatchTransferFromMsg(erc1155SafeBatchTransferFromMsg, s.xplac.GetFromAddress())
+	s.Require().Error(err)
+
+	// invalid erc20 amount
+	_, err = mevm.MakeErc20TransferMsg(types.Erc20TransferMsg{
+		ContractAddress: testSolContractAddress,
+		Recipient:       account1.PubKey.Address().String(),
+		Amount:          "1.5",
+	}, s.xplac.GetFromAddress())
+	s.Require().Error(err)
 }
 
 func (s *IntegrationTestSuite) TestEvm() {
@@ -353,4 +483,131 @@ func (s *IntegrationTestSuite) TestEvm() {
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmEthCoinbaseMsgType, s.xplac.GetMsgType())
+
+	// erc20 balance of
+	erc20BalanceOfMsg := types.Erc20BalanceOfMsg{
+		ContractAddress: testSolContractAddress,
+		Account:         account0.PubKey.Address().String(),
+	}
+	s.xplac.Erc20BalanceOf(erc20BalanceOfMsg)
+
+	makeErc20BalanceOfMsg, err := mevm.MakeErc20BalanceOfMsg(erc20BalanceOfMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20BalanceOfMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc20BalanceOfMsgType, s.xplac.GetMsgType())
+
+	// erc20 allowance
+	erc20AllowanceMsg := types.Erc20AllowanceMsg{
+		ContractAddress: testSolContractAddress,
+		Owner:           account0.PubKey.Address().String(),
+		Spender:         account0.PubKey.Address().String(),
+	}
+	s.xplac.Erc20Allowance(erc20AllowanceMsg)
+
+	makeErc20AllowanceMsg, err := mevm.MakeErc20AllowanceMsg(erc20AllowanceMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20AllowanceMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc20AllowanceMsgType, s.xplac.GetMsgType())
+
+	// erc20 token info
+	erc20TokenInfoMsg := types.Erc20TokenInfoMsg{
+		ContractAddress: testSolContractAddress,
+	}
+	s.xplac.Erc20TokenInfo(erc20TokenInfoMsg)
+
+	makeErc20TokenInfoMsg, err := mevm.MakeErc20TokenInfoMsg(erc20TokenInfoMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc20TokenInfoMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc20TokenInfoMsgType, s.xplac.GetMsgType())
+
+	// erc721 owner of
+	erc721OwnerOfMsg := types.Erc721OwnerOfMsg{
+		ContractAddress: testSolContractAddress,
+		TokenId:         "1",
+	}
+	s.xplac.Erc721OwnerOf(erc721OwnerOfMsg)
+
+	makeErc721OwnerOfMsg, err := mevm.MakeErc721OwnerOfMsg(erc721OwnerOfMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721OwnerOfMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc721OwnerOfMsgType, s.xplac.GetMsgType())
+
+	// erc721 token URI
+	erc721TokenURIMsg := types.Erc721TokenURIMsg{
+		ContractAddress: testSolContractAddress,
+		TokenId:         "1",
+	}
+	s.xplac.Erc721TokenURI(erc721TokenURIMsg)
+
+	makeErc721TokenURIMsg, err := mevm.MakeErc721TokenURIMsg(erc721TokenURIMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721TokenURIMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc721TokenURIMsgType, s.xplac.GetMsgType())
+
+	// erc721 balance of
+	erc721BalanceOfMsg := types.Erc721BalanceOfMsg{
+		ContractAddress: testSolContractAddress,
+		Owner:           account0.PubKey.Address().String(),
+	}
+	s.xplac.Erc721BalanceOf(erc721BalanceOfMsg)
+
+	makeErc721BalanceOfMsg, err := mevm.MakeErc721BalanceOfMsg(erc721BalanceOfMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc721BalanceOfMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc721BalanceOfMsgType, s.xplac.GetMsgType())
+
+	// erc1155 balance of batch
+	erc1155BalanceOfBatchMsg := types.Erc1155BalanceOfBatchMsg{
+		ContractAddress: testSolContractAddress,
+		Accounts:        []string{account0.PubKey.Address().String(), account0.PubKey.Address().String()},
+		Ids:             []string{"1", "2"},
+	}
+	s.xplac.Erc1155BalanceOfBatch(erc1155BalanceOfBatchMsg)
+
+	makeErc1155BalanceOfBatchMsg, err := mevm.MakeErc1155BalanceOfBatchMsg(erc1155BalanceOfBatchMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc1155BalanceOfBatchMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc1155BalanceOfBatchMsgType, s.xplac.GetMsgType())
+
+	// erc1155 uri
+	erc1155UriMsg := types.Erc1155UriMsg{
+		ContractAddress: testSolContractAddress,
+		Id:              "1",
+	}
+	s.xplac.Erc1155Uri(erc1155UriMsg)
+
+	makeErc1155UriMsg, err := mevm.MakeErc1155UriMsg(erc1155UriMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeErc1155UriMsg, s.xplac.GetMsg())
+	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
+	s.Require().Equal(mevm.EvmErc1155UriMsgType, s.xplac.GetMsgType())
+
+	// invalid token ID
+	_, err = mevm.MakeErc721OwnerOfMsg(types.Erc721OwnerOfMsg{
+		ContractAddress: testSolContractAddress,
+		TokenId:         "-1",
+	})
+	s.Require().Error(err)
+
+	// invalid evm address
+	_, err = mevm.MakeErc20BalanceOfMsg(types.Erc20BalanceOfMsg{
+		ContractAddress: testSolContractAddress,
+		Account:         "invalid",
+	})
+	s.Require().Error(err)
 }
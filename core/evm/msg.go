@@ -2,6 +2,8 @@ package evm
 
 import (
 	"github.com/xpladev/xpla.go/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // (Tx) make msg - send coin
@@ -19,6 +21,41 @@ func MakeInvokeSolContractMsg(InvokeSolContractMsg types.InvokeSolContractMsg) (
 	return parseInvokeSolContractArgs(InvokeSolContractMsg)
 }
 
+// (Tx) make msg - erc20 transfer
+func MakeErc20TransferMsg(erc20TransferMsg types.Erc20TransferMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc20TransferArgs(erc20TransferMsg, addr)
+}
+
+// (Tx) make msg - erc20 approve
+func MakeErc20ApproveMsg(erc20ApproveMsg types.Erc20ApproveMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc20ApproveArgs(erc20ApproveMsg, addr)
+}
+
+// (Tx) make msg - erc20 transfer from
+func MakeErc20TransferFromMsg(erc20TransferFromMsg types.Erc20TransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc20TransferFromArgs(erc20TransferFromMsg, addr)
+}
+
+// (Tx) make msg - erc721 safe transfer from
+func MakeErc721SafeTransferFromMsg(erc721SafeTransferFromMsg types.Erc721SafeTransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc721SafeTransferFromArgs(erc721SafeTransferFromMsg, addr)
+}
+
+// (Tx) make msg - erc721 approve
+func MakeErc721ApproveMsg(erc721ApproveMsg types.Erc721ApproveMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc721ApproveArgs(erc721ApproveMsg, addr)
+}
+
+// (Tx) make msg - erc721 set approval for all
+func MakeErc721SetApprovalForAllMsg(erc721SetApprovalForAllMsg types.Erc721SetApprovalForAllMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc721SetApprovalForAllArgs(erc721SetApprovalForAllMsg, addr)
+}
+
+// (Tx) make msg - erc1155 safe batch transfer from
+func MakeErc1155SafeBatchTransferFromMsg(erc1155SafeBatchTransferFromMsg types.Erc1155SafeBatchTransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	return parseErc1155SafeBatchTransferFromArgs(erc1155SafeBatchTransferFromMsg, addr)
+}
+
 // (Query) make msg - call solidity contract
 func MakeCallSolContractMsg(callSolContractMsg types.CallSolContractMsg) (CallSolContractParseMsg, error) {
 	return parseCallSolContractArgs(callSolContractMsg)
@@ -76,15 +113,55 @@ func MakeEthUninstallFilterMsg(ethUninstallFilter types.EthUninstallFilterMsg) (
 
 // (Query) make msg - eth get filter changes
 func MakeEthGetFilterChangesMsg(ethGetFilterChangesMsg types.EthGetFilterChangesMsg) (types.EthGetFilterChangesMsg, error) {
-	return ethGetFilterChangesMsg, nil
+	return parseEthGetFilterChangesArgs(ethGetFilterChangesMsg)
 }
 
 // (Query) make msg - eth get filter logs
 func MakeEthGetFilterLogsMsg(ethGetFilterLogsMsg types.EthGetFilterLogsMsg) (types.EthGetFilterLogsMsg, error) {
-	return ethGetFilterLogsMsg, nil
+	return parseEthGetFilterLogsArgs(ethGetFilterLogsMsg)
 }
 
 // (Query) make msg - eth get logs
 func MakeEthGetLogsMsg(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMsg, error) {
 	return parseEthGetLogsArgs(ethGetLogsMsg)
 }
+
+// (Query) make msg - erc20 balance of
+func MakeErc20BalanceOfMsg(erc20BalanceOfMsg types.Erc20BalanceOfMsg) (TokenQueryParseMsg, error) {
+	return parseTokenQueryArgs(erc20BalanceOfMsg.ContractAddress, erc20BalanceOfMsg.Account)
+}
+
+// (Query) make msg - erc20 allowance
+func MakeErc20AllowanceMsg(erc20AllowanceMsg types.Erc20AllowanceMsg) (TokenQueryParseMsg, error) {
+	return parseTokenQueryArgs(erc20AllowanceMsg.ContractAddress, erc20AllowanceMsg.Owner, erc20AllowanceMsg.Spender)
+}
+
+// (Query) make msg - erc20 token info
+func MakeErc20TokenInfoMsg(erc20TokenInfoMsg types.Erc20TokenInfoMsg) (TokenQueryParseMsg, error) {
+	return parseTokenQueryArgs(erc20TokenInfoMsg.ContractAddress)
+}
+
+// (Query) make msg - erc721 owner of
+func MakeErc721OwnerOfMsg(erc721OwnerOfMsg types.Erc721OwnerOfMsg) (TokenQueryParseMsg, error) {
+	return parseTokenIdQueryArgs(erc721OwnerOfMsg.ContractAddress, erc721OwnerOfMsg.TokenId)
+}
+
+// (Query) make msg - erc721 token URI
+func MakeErc721TokenURIMsg(erc721TokenURIMsg types.Erc721TokenURIMsg) (TokenQueryParseMsg, error) {
+	return parseTokenIdQueryArgs(erc721TokenURIMsg.ContractAddress, erc721TokenURIMsg.TokenId)
+}
+
+// (Query) make msg - erc721 balance of
+func MakeErc721BalanceOfMsg(erc721BalanceOfMsg types.Erc721BalanceOfMsg) (TokenQueryParseMsg, error) {
+	return parseTokenQueryArgs(erc721BalanceOfMsg.ContractAddress, erc721BalanceOfMsg.Owner)
+}
+
+// (Query) make msg - erc1155 balance of batch
+func MakeErc1155BalanceOfBatchMsg(erc1155BalanceOfBatchMsg types.Erc1155BalanceOfBatchMsg) (TokenQueryParseMsg, error) {
+	return parseErc1155BalanceOfBatchArgs(erc1155BalanceOfBatchMsg)
+}
+
+// (Query) make msg - erc1155 uri
+func MakeErc1155UriMsg(erc1155UriMsg types.Erc1155UriMsg) (TokenQueryParseMsg, error) {
+	return parseTokenIdQueryArgs(erc1155UriMsg.ContractAddress, erc1155UriMsg.Id)
+}
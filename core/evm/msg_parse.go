@@ -1,17 +1,26 @@
 package evm
 
 import (
+	"math/big"
+	"strconv"
+
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // Parsing - send coin
 func parseSendCoinArgs(sendCoinMsg types.SendCoinMsg) (types.SendCoinMsg, error) {
 	sendCoinMsg.Amount = util.DenomRemove(sendCoinMsg.Amount)
+	// The evm only transfers the default denom (axpla)
+	if _, err := util.FromStringToBigInt(sendCoinMsg.Amount); err != nil {
+		return types.SendCoinMsg{}, types.ErrWrap(types.ErrParse, "evm coin must be", types.XplaDenom, err)
+	}
 	return sendCoinMsg, nil
 }
 
@@ -34,15 +43,10 @@ func parseDeploySolContractArgs(deploySolContractMsg types.DeploySolContractMsg)
 		}
 	}
 
-	if deploySolContractMsg.Args == nil || len(deploySolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = deploySolContractMsg.Args
-	}
-
 	return ContractInfo{
 		Abi:      abi,
 		Bytecode: bytecode,
+		Args:     deploySolContractMsg.Args,
 	}, nil
 }
 
@@ -62,11 +66,6 @@ func parseInvokeSolContractArgs(invokeSolContractMsg types.InvokeSolContractMsg)
 			return types.InvokeSolContractMsg{}, types.ErrWrap(types.ErrParse, err)
 		}
 	}
-	if invokeSolContractMsg.Args == nil || len(invokeSolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = invokeSolContractMsg.Args
-	}
 
 	invokeSolContractMsg.ContractAddress = util.FromStringToTypeHexString(invokeSolContractMsg.ContractAddress)
 
@@ -92,12 +91,7 @@ func parseCallSolContractArgs(callSolContractMsg types.CallSolContractMsg) (Call
 		}
 	}
 
-	if callSolContractMsg.Args == nil || len(callSolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = callSolContractMsg.Args
-	}
-	callByteData, err := util.GetAbiPack(callSolContractMsg.ContractFuncCallName, abi, bytecode, Args...)
+	callByteData, err := util.GetAbiPack(callSolContractMsg.ContractFuncCallName, abi, bytecode, callSolContractMsg.Args...)
 	if err != nil {
 		return CallSolContractParseMsg{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -321,6 +315,11 @@ func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMs
 		topics = append(topics, []common.Hash{})
 	}
 
+	abi, err := parseEventAbi(ethGetLogsMsg.ABI, ethGetLogsMsg.ABIJsonFilePath)
+	if err != nil {
+		return EthNewFilterParseMsg{}, err
+	}
+
 	if ethGetLogsMsg.BlockHash != "" {
 		blockHash = util.FromStringHexToHash(ethGetLogsMsg.BlockHash)
 
@@ -330,6 +329,7 @@ func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMs
 			ToBlock:   nil,
 			Addresses: addresses,
 			Topics:    topics,
+			ABI:       abi,
 		}
 
 		return varInput, nil
@@ -341,7 +341,318 @@ func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMs
 		ToBlock:   &toBlock,
 		Addresses: addresses,
 		Topics:    topics,
+		ABI:       abi,
 	}
 
 	return varInput, nil
 }
+
+// Parsing - get filter changes
+func parseEthGetFilterChangesArgs(ethGetFilterChangesMsg types.EthGetFilterChangesMsg) (types.EthGetFilterChangesMsg, error) {
+	abi, err := parseEventAbi(ethGetFilterChangesMsg.ABI, ethGetFilterChangesMsg.ABIJsonFilePath)
+	if err != nil {
+		return types.EthGetFilterChangesMsg{}, err
+	}
+
+	ethGetFilterChangesMsg.ABI = abi
+	ethGetFilterChangesMsg.ABIJsonFilePath = ""
+	return ethGetFilterChangesMsg, nil
+}
+
+// Parsing - get filter logs
+func parseEthGetFilterLogsArgs(ethGetFilterLogsMsg types.EthGetFilterLogsMsg) (types.EthGetFilterLogsMsg, error) {
+	abi, err := parseEventAbi(ethGetFilterLogsMsg.ABI, ethGetFilterLogsMsg.ABIJsonFilePath)
+	if err != nil {
+		return types.EthGetFilterLogsMsg{}, err
+	}
+
+	ethGetFilterLogsMsg.ABI = abi
+	ethGetFilterLogsMsg.ABIJsonFilePath = ""
+	return ethGetFilterLogsMsg, nil
+}
+
+// Get the ABI which decodes logs. The ABI file takes precedence over the ABI string.
+func parseEventAbi(abi string, abiJsonFilePath string) (string, error) {
+	if abiJsonFilePath != "" {
+		fileAbi, err := util.AbiParsing(abiJsonFilePath)
+		if err != nil {
+			return "", types.ErrWrap(types.ErrParse, err)
+		}
+		abi = fileAbi
+	}
+	return abi, nil
+}
+
+// Parsing - erc20 transfer
+func parseErc20TransferArgs(erc20TransferMsg types.Erc20TransferMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	recipient, err := parseEvmAddress(erc20TransferMsg.Recipient)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	amount, err := parseErc20Amount(erc20TransferMsg.Amount, erc20TransferMsg.Decimals)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc20TransferMsg.ContractAddress, Erc20ABI, "transfer", addr, recipient, amount)
+}
+
+// Parsing - erc20 approve
+func parseErc20ApproveArgs(erc20ApproveMsg types.Erc20ApproveMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	spender, err := parseEvmAddress(erc20ApproveMsg.Spender)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	amount, err := parseErc20Amount(erc20ApproveMsg.Amount, erc20ApproveMsg.Decimals)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc20ApproveMsg.ContractAddress, Erc20ABI, "approve", addr, spender, amount)
+}
+
+// Parsing - erc20 transfer from
+func parseErc20TransferFromArgs(erc20TransferFromMsg types.Erc20TransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	owner, err := parseEvmAddress(erc20TransferFromMsg.Owner)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	recipient, err := parseEvmAddress(erc20TransferFromMsg.Recipient)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	amount, err := parseErc20Amount(erc20TransferFromMsg.Amount, erc20TransferFromMsg.Decimals)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc20TransferFromMsg.ContractAddress, Erc20ABI, "transferFrom", addr, owner, recipient, amount)
+}
+
+// Parsing - token queries of accounts
+func parseTokenQueryArgs(contractAddress string, accounts ...string) (TokenQueryParseMsg, error) {
+	contractAddr, err := parseEvmAddress(contractAddress)
+	if err != nil {
+		return TokenQueryParseMsg{}, err
+	}
+
+	var args []interface{}
+	for _, account := range accounts {
+		accountAddr, err := parseEvmAddress(account)
+		if err != nil {
+			return TokenQueryParseMsg{}, err
+		}
+		args = append(args, accountAddr)
+	}
+
+	return TokenQueryParseMsg{
+		ContractAddress: contractAddr,
+		Args:            args,
+	}, nil
+}
+
+// Token transactions are sent as invoking the contract by the embedded token ABI,
+// and the sender is used to estimate gas.
+func makeTokenInvokeMsg(contractAddress string, tokenAbi string, method string, addr sdk.AccAddress, args ...interface{}) (types.InvokeSolContractMsg, error) {
+	if _, err := parseEvmAddress(contractAddress); err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return parseInvokeSolContractArgs(types.InvokeSolContractMsg{
+		ContractAddress:      contractAddress,
+		ContractFuncCallName: method,
+		Args:                 args,
+		ABI:                  tokenAbi,
+		FromByteAddress:      common.BytesToAddress(addr).Hex(),
+	})
+}
+
+func parseErc20Amount(amount string, decimals string) (*big.Int, error) {
+	var decimalsU8 uint8
+	if decimals != "" {
+		d, err := strconv.ParseUint(decimals, 10, 8)
+		if err != nil {
+			return nil, types.ErrWrap(types.ErrConvert, err)
+		}
+		decimalsU8 = uint8(d)
+	}
+
+	return util.ParseTokenAmount(amount, decimalsU8)
+}
+
+func parseEvmAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, types.ErrWrap(types.ErrInvalidRequest, "invalid evm address:", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+// Parsing - erc721 safe transfer from
+func parseErc721SafeTransferFromArgs(erc721SafeTransferFromMsg types.Erc721SafeTransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	from, err := parseEvmAddress(erc721SafeTransferFromMsg.From)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	to, err := parseEvmAddress(erc721SafeTransferFromMsg.To)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	tokenId, err := parseUint256(erc721SafeTransferFromMsg.TokenId)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	data, err := parseTokenData(erc721SafeTransferFromMsg.Data)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc721SafeTransferFromMsg.ContractAddress, Erc721ABI, "safeTransferFrom", addr, from, to, tokenId, data)
+}
+
+// Parsing - erc721 approve
+func parseErc721ApproveArgs(erc721ApproveMsg types.Erc721ApproveMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	to, err := parseEvmAddress(erc721ApproveMsg.To)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	tokenId, err := parseUint256(erc721ApproveMsg.TokenId)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc721ApproveMsg.ContractAddress, Erc721ABI, "approve", addr, to, tokenId)
+}
+
+// Parsing - erc721 set approval for all
+func parseErc721SetApprovalForAllArgs(erc721SetApprovalForAllMsg types.Erc721SetApprovalForAllMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	operator, err := parseEvmAddress(erc721SetApprovalForAllMsg.Operator)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc721SetApprovalForAllMsg.ContractAddress, Erc721ABI, "setApprovalForAll", addr, operator, erc721SetApprovalForAllMsg.Approved)
+}
+
+// Parsing - erc1155 safe batch transfer from
+func parseErc1155SafeBatchTransferFromArgs(erc1155SafeBatchTransferFromMsg types.Erc1155SafeBatchTransferFromMsg, addr sdk.AccAddress) (types.InvokeSolContractMsg, error) {
+	from, err := parseEvmAddress(erc1155SafeBatchTransferFromMsg.From)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	to, err := parseEvmAddress(erc1155SafeBatchTransferFromMsg.To)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	if len(erc1155SafeBatchTransferFromMsg.Ids) != len(erc1155SafeBatchTransferFromMsg.Amounts) {
+		return types.InvokeSolContractMsg{}, types.ErrWrap(types.ErrInvalidRequest, "the number of ids and amounts must be same")
+	}
+
+	ids, err := parseUint256s(erc1155SafeBatchTransferFromMsg.Ids)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	amounts, err := parseUint256s(erc1155SafeBatchTransferFromMsg.Amounts)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	data, err := parseTokenData(erc1155SafeBatchTransferFromMsg.Data)
+	if err != nil {
+		return types.InvokeSolContractMsg{}, err
+	}
+
+	return makeTokenInvokeMsg(erc1155SafeBatchTransferFromMsg.ContractAddress, Erc1155ABI, "safeBatchTransferFrom", addr, from, to, ids, amounts, data)
+}
+
+// Parsing - token queries of the token ID
+func parseTokenIdQueryArgs(contractAddress string, tokenId string) (TokenQueryParseMsg, error) {
+	contractAddr, err := parseEvmAddress(contractAddress)
+	if err != nil {
+		return TokenQueryParseMsg{}, err
+	}
+
+	id, err := parseUint256(tokenId)
+	if err != nil {
+		return TokenQueryParseMsg{}, err
+	}
+
+	return TokenQueryParseMsg{
+		ContractAddress: contractAddr,
+		Args:            []interface{}{id},
+	}, nil
+}
+
+// Parsing - erc1155 balance of batch
+func parseErc1155BalanceOfBatchArgs(erc1155BalanceOfBatchMsg types.Erc1155BalanceOfBatchMsg) (TokenQueryParseMsg, error) {
+	contractAddr, err := parseEvmAddress(erc1155BalanceOfBatchMsg.ContractAddress)
+	if err != nil {
+		return TokenQueryParseMsg{}, err
+	}
+
+	if len(erc1155BalanceOfBatchMsg.Accounts) != len(erc1155BalanceOfBatchMsg.Ids) {
+		return TokenQueryParseMsg{}, types.ErrWrap(types.ErrInvalidRequest, "the number of accounts and ids must be same")
+	}
+
+	var accounts []common.Address
+	for _, account := range erc1155BalanceOfBatchMsg.Accounts {
+		accountAddr, err := parseEvmAddress(account)
+		if err != nil {
+			return TokenQueryParseMsg{}, err
+		}
+		accounts = append(accounts, accountAddr)
+	}
+
+	ids, err := parseUint256s(erc1155BalanceOfBatchMsg.Ids)
+	if err != nil {
+		return TokenQueryParseMsg{}, err
+	}
+
+	return TokenQueryParseMsg{
+		ContractAddress: contractAddr,
+		Args:            []interface{}{accounts, ids},
+	}, nil
+}
+
+func parseUint256(value string) (*big.Int, error) {
+	n, err := util.FromStringToBigInt(value)
+	if err != nil || n.Sign() < 0 {
+		return nil, types.ErrWrap(types.ErrConvert, "invalid uint256 value:", value)
+	}
+	return n, nil
+}
+
+func parseUint256s(values []string) ([]*big.Int, error) {
+	numbers := make([]*big.Int, len(values))
+	for i, value := range values {
+		n, err := parseUint256(value)
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// The data which is passed to the receiver contract is hex encoded, and it can be empty.
+func parseTokenData(data string) ([]byte, error) {
+	if data == "" {
+		return []byte{}, nil
+	}
+
+	bytes, err := hexutil.Decode(util.FromStringToTypeHexString(data))
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrConvert, err)
+	}
+	return bytes, nil
+}
@@ -40,6 +40,17 @@ const (
 	EvmEthGetFilterLogsMsgType                  = "eth-get-filter-logs"
 	EvmEthGetLogsMsgType                        = "eth-get-logs"
 	EvmEthCoinbaseMsgType                       = "eth-coinbase"
+	EvmErc20BalanceOfMsgType                    = "erc20-balance-of"
+	EvmErc20AllowanceMsgType                    = "erc20-allowance"
+	EvmErc20TokenInfoMsgType                    = "erc20-token-info"
+	EvmErc721OwnerOfMsgType                     = "erc721-owner-of"
+	EvmErc721TokenURIMsgType                    = "erc721-token-uri"
+	EvmErc721BalanceOfMsgType                   = "erc721-balance-of"
+	EvmErc1155BalanceOfBatchMsgType             = "erc1155-balance-of-batch"
+	EvmErc1155UriMsgType                        = "erc1155-uri"
+
+	Erc721Standard  = "erc721"
+	Erc1155Standard = "erc1155"
 )
 
 type CallSolContractParseMsg struct {
@@ -55,21 +66,29 @@ type EthNewFilterParseMsg struct {
 	ToBlock   *rpc.BlockNumber `json:"toBlock"`
 	Addresses interface{}      `json:"address"`
 	Topics    []interface{}    `json:"topics"`
+	// ABI is not the parameter of the filter, but it is used to decode logs.
+	ABI string `json:"-"`
 }
 
 type ContractInfo struct {
 	Abi      string
 	Bytecode string
+	Args     []interface{}
 }
 
 type DeploySolTx struct {
-	ChainId  *big.Int
-	Nonce    *big.Int
-	Value    *big.Int
-	GasLimit uint64
-	GasPrice *big.Int
-	ABI      string
-	Bytecode string
+	ChainId   *big.Int
+	Nonce     *big.Int
+	Value     *big.Int
+	GasLimit  uint64
+	GasPrice  *big.Int
+	GasFeeCap *big.Int `json:",omitempty"`
+	GasTipCap *big.Int `json:",omitempty"`
+	ABI       string
+	Bytecode  string
 }
 
-var Args []interface{}
+type TokenQueryParseMsg struct {
+	ContractAddress common.Address
+	Args            []interface{}
+}
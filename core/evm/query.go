@@ -1,12 +1,15 @@
 package evm
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/xpladev/xpla.go/core"
+	"github.com/xpladev/xpla.go/provider"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -17,32 +20,9 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		return "", i.Ixplac.GetLogger().Err(err)
 	}
 
-	gasAdj := i.Ixplac.GetGasAdjustment()
-	if i.Ixplac.GetGasAdjustment() == "" {
-		gasAdj = types.DefaultGasAdjustment
-	}
-
-	gasLimit := i.Ixplac.GetGasLimit()
-	if i.Ixplac.GetGasLimit() == "" {
-		gasLimitU64, err := util.FromStringToUint64(util.DefaultEvmQueryGasLimit)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
-		}
-		gasLimitAdjustment, err := util.GasLimitAdjustment(gasLimitU64, gasAdj)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
-		}
-		gasLimit = gasLimitAdjustment
-	}
-
-	gasPrice := i.Ixplac.GetGasPrice()
-	if i.Ixplac.GetGasPrice() == "" {
-		gasPrice = types.DefaultGasPrice
-	}
-
-	gasPriceBigInt, err := util.FromStringToBigInt(gasPrice)
+	gasLimit, gasPriceBigInt, err := evmQueryGas(i.Ixplac)
 	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+		return "", i.Ixplac.GetLogger().Err(err)
 	}
 
 	switch {
@@ -484,14 +464,25 @@ func QueryEvm(i core.QueryClient) (string, error) {
 	case i.Ixplac.GetMsgType() == EvmEthGetFilterChangesMsgType:
 		convertMsg := i.Ixplac.GetMsg().(types.EthGetFilterChangesMsg)
 
-		var result []string
+		var result json.RawMessage
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getFilterChanges", convertMsg.FilterId)
 		if err != nil {
 			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
 		}
 
-		ethGetFilterChangesResponse := types.EthGetFilterChangesResponse{
-			GetFilterChanges: result,
+		// The block filter and the pending transaction filter return hashes, and the log filter returns logs.
+		var ethGetFilterChangesResponse types.EthGetFilterChangesResponse
+		if err := json.Unmarshal(result, &ethGetFilterChangesResponse.GetFilterChanges); err != nil {
+			if err := json.Unmarshal(result, &ethGetFilterChangesResponse.Logs); err != nil {
+				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
+			}
+		}
+
+		if convertMsg.ABI != "" {
+			ethGetFilterChangesResponse.DecodedLogs, err = util.DecodeEvmLogs(convertMsg.ABI, ethGetFilterChangesResponse.Logs)
+			if err != nil {
+				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+			}
 		}
 
 		return jsonReturn(i.Ixplac.GetLogger(), ethGetFilterChangesResponse)
@@ -500,7 +491,7 @@ func QueryEvm(i core.QueryClient) (string, error) {
 	case i.Ixplac.GetMsgType() == EvmEthGetFilterLogsMsgType:
 		convertMsg := i.Ixplac.GetMsg().(types.EthGetFilterLogsMsg)
 
-		var result []string
+		var result []ethtypes.Log
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getFilterLogs", convertMsg.FilterId)
 		if err != nil {
 			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
@@ -510,20 +501,39 @@ func QueryEvm(i core.QueryClient) (string, error) {
 			GetFilterLogs: result,
 		}
 
+		if convertMsg.ABI != "" {
+			ethGetFilterLogsResponse.DecodedLogs, err = util.DecodeEvmLogs(convertMsg.ABI, result)
+			if err != nil {
+				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+			}
+		}
+
 		return jsonReturn(i.Ixplac.GetLogger(), ethGetFilterLogsResponse)
 
 	// get logs
 	case i.Ixplac.GetMsgType() == EvmEthGetLogsMsgType:
 		convertMsg := i.Ixplac.GetMsg().(EthNewFilterParseMsg)
 
-		var result interface{}
+		var result json.RawMessage
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getLogs", convertMsg)
 		if err != nil {
 			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
 		}
 
-		ethGetLogsResponse := types.EthGetLogsResponse{
-			GetLogs: result,
+		var ethGetLogsResponse types.EthGetLogsResponse
+		if err := json.Unmarshal(result, &ethGetLogsResponse.GetLogs); err != nil {
+			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
+		}
+
+		if convertMsg.ABI != "" {
+			var logs []ethtypes.Log
+			if err := json.Unmarshal(result, &logs); err != nil {
+				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
+			}
+			ethGetLogsResponse.DecodedLogs, err = util.DecodeEvmLogs(convertMsg.ABI, logs)
+			if err != nil {
+				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+			}
 		}
 
 		return jsonReturn(i.Ixplac.GetLogger(), ethGetLogsResponse)
@@ -543,6 +553,190 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		return jsonReturn(i.Ixplac.GetLogger(), ethCoinbaseResponse)
 
+	// erc20 balance of
+	case i.Ixplac.GetMsgType() == EvmErc20BalanceOfMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		balance, err := callToken(evmClient, callMsg, Erc20ABI, "balanceOf", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		decimals, err := callErc20Decimals(evmClient, callMsg)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc20BalanceOfResponse := types.Erc20BalanceOfResponse{
+			Balance:          balance.(*big.Int).String(),
+			Decimals:         decimals,
+			FormattedBalance: util.FormatTokenAmount(balance.(*big.Int), decimals),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc20BalanceOfResponse)
+
+	// erc20 allowance
+	case i.Ixplac.GetMsgType() == EvmErc20AllowanceMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		allowance, err := callToken(evmClient, callMsg, Erc20ABI, "allowance", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		decimals, err := callErc20Decimals(evmClient, callMsg)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc20AllowanceResponse := types.Erc20AllowanceResponse{
+			Allowance:          allowance.(*big.Int).String(),
+			Decimals:           decimals,
+			FormattedAllowance: util.FormatTokenAmount(allowance.(*big.Int), decimals),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc20AllowanceResponse)
+
+	// erc20 token info
+	case i.Ixplac.GetMsgType() == EvmErc20TokenInfoMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		var results []interface{}
+		for _, method := range []string{"name", "symbol", "decimals", "totalSupply"} {
+			result, err := callToken(evmClient, callMsg, Erc20ABI, method)
+			if err != nil {
+				return "", i.Ixplac.GetLogger().Err(err)
+			}
+			results = append(results, result)
+		}
+
+		erc20TokenInfoResponse := types.Erc20TokenInfoResponse{
+			Name:                 results[0].(string),
+			Symbol:               results[1].(string),
+			Decimals:             results[2].(uint8),
+			TotalSupply:          results[3].(*big.Int).String(),
+			FormattedTotalSupply: util.FormatTokenAmount(results[3].(*big.Int), results[2].(uint8)),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc20TokenInfoResponse)
+
+	// erc721 owner of
+	case i.Ixplac.GetMsgType() == EvmErc721OwnerOfMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		owner, err := callToken(evmClient, callMsg, Erc721ABI, "ownerOf", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc721OwnerOfResponse := types.Erc721OwnerOfResponse{
+			Owner: owner.(common.Address).Hex(),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc721OwnerOfResponse)
+
+	// erc721 token URI
+	case i.Ixplac.GetMsgType() == EvmErc721TokenURIMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		tokenURI, err := callToken(evmClient, callMsg, Erc721ABI, "tokenURI", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc721TokenURIResponse := types.Erc721TokenURIResponse{
+			TokenURI: tokenURI.(string),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc721TokenURIResponse)
+
+	// erc721 balance of
+	case i.Ixplac.GetMsgType() == EvmErc721BalanceOfMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		balance, err := callToken(evmClient, callMsg, Erc721ABI, "balanceOf", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc721BalanceOfResponse := types.Erc721BalanceOfResponse{
+			Balance: balance.(*big.Int).String(),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc721BalanceOfResponse)
+
+	// erc1155 balance of batch
+	case i.Ixplac.GetMsgType() == EvmErc1155BalanceOfBatchMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		balances, err := callToken(evmClient, callMsg, Erc1155ABI, "balanceOfBatch", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		var erc1155BalanceOfBatchResponse types.Erc1155BalanceOfBatchResponse
+		for _, balance := range balances.([]*big.Int) {
+			erc1155BalanceOfBatchResponse.Balances = append(erc1155BalanceOfBatchResponse.Balances, balance.String())
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc1155BalanceOfBatchResponse)
+
+	// erc1155 uri
+	case i.Ixplac.GetMsgType() == EvmErc1155UriMsgType:
+		convertMsg := i.Ixplac.GetMsg().(TokenQueryParseMsg)
+
+		callMsg, err := tokenCallMsg(convertMsg.ContractAddress, gasLimit, gasPriceBigInt)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		uri, err := callToken(evmClient, callMsg, Erc1155ABI, "uri", convertMsg.Args...)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+
+		erc1155UriResponse := types.Erc1155UriResponse{
+			Uri:         uri.(string),
+			ResolvedUri: ResolveErc1155Uri(uri.(string), convertMsg.Args[0].(*big.Int)),
+		}
+
+		return jsonReturn(i.Ixplac.GetLogger(), erc1155UriResponse)
+
 	default:
 		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
@@ -556,3 +750,78 @@ func jsonReturn(logger types.Logger, value interface{}) (string, error) {
 
 	return string(json), nil
 }
+
+// The gas limit and the gas price of calling the contract are the default values for query if options are not set.
+func evmQueryGas(xplac provider.XplaClient) (string, *big.Int, error) {
+	gasAdj := xplac.GetGasAdjustment()
+	if xplac.GetGasAdjustment() == "" {
+		gasAdj = types.DefaultGasAdjustment
+	}
+
+	gasLimit := xplac.GetGasLimit()
+	if xplac.GetGasLimit() == "" {
+		gasLimitU64, err := util.FromStringToUint64(util.DefaultEvmQueryGasLimit)
+		if err != nil {
+			return "", nil, types.ErrWrap(types.ErrConvert, err)
+		}
+		gasLimitAdjustment, err := util.GasLimitAdjustment(gasLimitU64, gasAdj)
+		if err != nil {
+			return "", nil, types.ErrWrap(types.ErrParse, err)
+		}
+		gasLimit = gasLimitAdjustment
+	}
+
+	gasPrice := xplac.GetGasPrice()
+	if xplac.GetGasPrice() == "" {
+		gasPrice = types.DefaultGasPrice
+	}
+
+	gasPriceBigInt, err := util.EvmGasPrice(gasPrice)
+	if err != nil {
+		return "", nil, types.ErrWrap(types.ErrConvert, err)
+	}
+
+	return gasLimit, gasPriceBigInt, nil
+}
+
+// Call (as query) the solidity contract and return outputs which are unpacked by the ABI.
+// Unlike the query of CallSolidityContract which returns outputs as strings, go types of outputs are kept,
+// e.g. *big.Int and common.Address, so generated contract bindings use it.
+func CallSolidityContractOutputs(xplac provider.XplaClient, callSolContractMsg types.CallSolContractMsg) ([]interface{}, error) {
+	if xplac.GetEvmRpc() == "" {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist"))
+	}
+
+	msg, err := MakeCallSolContractMsg(callSolContractMsg)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(err)
+	}
+
+	evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+	if err != nil {
+		return nil, xplac.GetLogger().Err(err)
+	}
+
+	gasLimit, gasPrice, err := evmQueryGas(xplac)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(err)
+	}
+
+	gasLimitU64, err := util.FromStringToUint64(gasLimit)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+	}
+	msg.CallMsg.Gas = gasLimitU64
+	msg.CallMsg.GasPrice = gasPrice
+
+	res, err := evmClient.Client.CallContract(evmClient.Ctx, msg.CallMsg, nil)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrEvmRpcRequest, err))
+	}
+
+	outputs, err := util.GetAbiUnpack(msg.CallName, msg.ABI, msg.Bytecode, res)
+	if err != nil {
+		return nil, xplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+	}
+	return outputs, nil
+}
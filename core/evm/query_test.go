@@ -8,25 +8,36 @@ import (
 	"testing"
 
 	"github.com/xpladev/xpla.go/client"
+	mevm "github.com/xpladev/xpla.go/core/evm"
+	"github.com/xpladev/xpla.go/key"
 	"github.com/xpladev/xpla.go/provider"
 
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 	"github.com/xpladev/xpla.go/util/testutil"
+	"github.com/xpladev/xpla.go/util/testutil/bindings/erc20"
 	"github.com/xpladev/xpla.go/util/testutil/network"
 
-	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"golang.org/x/crypto/sha3"
 )
 
 var (
-	validatorNumber          = 2
-	testABIJsonFilePath      = "../../util/testutil/test_files/abi.json"
-	testBytecodeJsonFilePath = "../../util/testutil/test_files/bytecode.json"
+	validatorNumber                       = 2
+	testABIJsonFilePath                   = "../../util/testutil/test_files/abi.json"
+	testBytecodeJsonFilePath              = "../../util/testutil/test_files/bytecode.json"
+	testErc20ABIJsonFilePath              = "../../util/testutil/test_files/erc20_abi.json"
+	testErc20BytecodeJsonFilePath         = "../../util/testutil/test_files/erc20_bytecode.json"
+	testErc20DecimalsABIJsonFilePath      = "../../util/testutil/test_files/erc20_decimals_abi.json"
+	testErc20DecimalsBytecodeJsonFilePath = "../../util/testutil/test_files/erc20_decimals_bytecode.json"
 )
 
 type IntegrationTestSuite struct {
@@ -68,7 +79,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	txbytes, err := xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
 	s.Require().NoError(err)
 
-	_, err = xplac.Broadcast(txbytes)
+	_, err = xplac.BroadcastAndWait(txbytes)
 	s.Require().NoError(err)
 	s.Require().NoError(s.network.WaitForNextBlock())
 
@@ -78,12 +89,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	}).Query()
 	s.Require().NoError(err)
 
-	var getTxsEventResponse sdktx.GetTxsEventResponse
-	jsonpb.Unmarshal(strings.NewReader(txEventsRes), &getTxsEventResponse)
+	var searchTxsResult sdk.SearchTxsResult
+	jsonpb.Unmarshal(strings.NewReader(txEventsRes), &searchTxsResult)
 
 	// extract evm transaction
-	s.evmTestBlockHeight = getTxsEventResponse.TxResponses[0].Height
-	s.evmtestTxHash = getTxsEventResponse.TxResponses[0].Logs[0].Events[0].Attributes[1].Value
+	s.evmTestBlockHeight = searchTxsResult.Txs[0].Height
+	s.evmtestTxHash = searchTxsResult.Txs[0].Logs[0].Events[0].Attributes[1].Value
 
 	// query transaction receipte by evm tx hash
 	getTransactionReceiptMsg := types.GetTransactionReceiptMsg{
@@ -187,13 +198,10 @@ func (s *IntegrationTestSuite) TestGetBlockByHashOrHeight() {
 	blockMsg := types.BlockMsg{
 		Height: "1",
 	}
-	blockRes, err := s.xplac.Block(blockMsg).Query()
+	blockRes, err := s.xplac.Block(blockMsg).QueryProto()
 	s.Require().NoError(err)
 
-	var resultBlock ctypes.ResultBlock
-	json.Unmarshal([]byte(blockRes), &resultBlock)
-
-	blockHash := resultBlock.BlockID.Hash.String()
+	blockHash := tmbytes.HexBytes(blockRes.(*tmservice.GetBlockByHeightResponse).BlockId.Hash).String()
 
 	// get block by hash
 	getBlockByHashHeightMsg := types.GetBlockByHashHeightMsg{
@@ -368,11 +376,10 @@ func (s *IntegrationTestSuite) TestEthAccounts() {
 
 func (s *IntegrationTestSuite) TestEthGetBlockTransactionCount() {
 	testBlockHeight := util.FromInt64ToString(s.evmTestBlockHeight)
-	blockRes, err := s.xplac.Block(types.BlockMsg{Height: testBlockHeight}).Query()
+	blockRes, err := s.xplac.Block(types.BlockMsg{Height: testBlockHeight}).QueryProto()
 	s.Require().NoError(err)
 
-	var resultBlock ctypes.ResultBlock
-	json.Unmarshal([]byte(blockRes), &resultBlock)
+	blockHash := tmbytes.HexBytes(blockRes.(*tmservice.GetBlockByHeightResponse).BlockId.Hash).String()
 
 	// block height
 	ethGetBlockTransactionCountMsg := types.EthGetBlockTransactionCountMsg{
@@ -388,7 +395,7 @@ func (s *IntegrationTestSuite) TestEthGetBlockTransactionCount() {
 
 	// block hash
 	ethGetBlockTransactionCountMsg = types.EthGetBlockTransactionCountMsg{
-		BlockHash: resultBlock.BlockID.Hash.String(),
+		BlockHash: blockHash,
 	}
 	res, err = s.xplac.EthGetBlockTransactionCount(ethGetBlockTransactionCountMsg).Query()
 	s.Require().NoError(err)
@@ -422,14 +429,13 @@ func (s *IntegrationTestSuite) TestEstimateGas() {
 
 func (s *IntegrationTestSuite) TestEthGetTransactionByBlockHashAndIndex() {
 	testBlockHeight := util.FromInt64ToString(s.evmTestBlockHeight)
-	blockRes, err := s.xplac.Block(types.BlockMsg{Height: testBlockHeight}).Query()
+	blockRes, err := s.xplac.Block(types.BlockMsg{Height: testBlockHeight}).QueryProto()
 	s.Require().NoError(err)
 
-	var resultBlock ctypes.ResultBlock
-	json.Unmarshal([]byte(blockRes), &resultBlock)
+	blockHash := tmbytes.HexBytes(blockRes.(*tmservice.GetBlockByHeightResponse).BlockId.Hash).String()
 
 	getTransactionByBlockHashAndIndexMsg := types.GetTransactionByBlockHashAndIndexMsg{
-		BlockHash: resultBlock.BlockID.Hash.String(),
+		BlockHash: blockHash,
 		Index:     "0",
 	}
 	res, err := s.xplac.EthGetTransactionByBlockHashAndIndex(getTransactionByBlockHashAndIndexMsg).Query()
@@ -559,6 +565,291 @@ func (s *IntegrationTestSuite) TestEthGetFilterLogs() {
 	s.Require().Equal(0, len(ethGetFilterLogsResponse.GetFilterLogs))
 }
 
+func (s *IntegrationTestSuite) TestEthGetLogsDecodedByABI() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	account1 := s.network.Validators[1].AdditionalAccount
+	from := common.BytesToAddress(account0.Address.Bytes())
+	to := common.BytesToAddress(account1.Address.Bytes())
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithURL(s.network.Validators[0].APIAddress).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	// deploy erc20 contract
+	deploySolContractMsg := types.DeploySolContractMsg{
+		ABIJsonFilePath:      testErc20ABIJsonFilePath,
+		BytecodeJsonFilePath: testErc20BytecodeJsonFilePath,
+		Args:                 []interface{}{from, big.NewInt(1000)},
+	}
+	txbytes, err := xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	deployRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	contractAddr := deployRes.EvmReceipt.ContractAddress.String()
+
+	// emit the transfer event
+	invokeSolContractMsg := types.InvokeSolContractMsg{
+		ContractAddress:      contractAddr,
+		ContractFuncCallName: "transfer",
+		Args:                 []interface{}{to, big.NewInt(10)},
+		ABIJsonFilePath:      testErc20ABIJsonFilePath,
+		BytecodeJsonFilePath: testErc20BytecodeJsonFilePath,
+		FromByteAddress:      from.String(),
+	}
+	txbytes, err = xplac.WithGasLimit("").WithSequence("").InvokeSolidityContract(invokeSolContractMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// get logs
+	ethGetLogsMsg := types.EthGetLogsMsg{
+		Address:         []string{contractAddr},
+		FromBlock:       "earliest",
+		ToBlock:         "latest",
+		ABIJsonFilePath: testErc20ABIJsonFilePath,
+	}
+	res, err := xplac.EthGetLogs(ethGetLogsMsg).Query()
+	s.Require().NoError(err)
+
+	var ethGetLogsResponse types.EthGetLogsResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &ethGetLogsResponse))
+	s.Require().Len(ethGetLogsResponse.DecodedLogs, 1)
+
+	decodedLog := ethGetLogsResponse.DecodedLogs[0]
+	s.Require().Equal("Transfer", decodedLog.Event)
+	s.Require().Equal("Transfer(address,address,uint256)", decodedLog.Signature)
+	s.Require().Equal(from, common.HexToAddress(decodedLog.Indexed["from"].(string)))
+	s.Require().Equal(to, common.HexToAddress(decodedLog.Indexed["to"].(string)))
+	s.Require().Equal(float64(10), decodedLog.NonIndexed["value"])
+	s.Require().Equal(contractAddr, decodedLog.Log.Address.String())
+	s.Require().NotZero(decodedLog.Log.BlockNumber)
+	s.Require().NotEqual(common.Hash{}, decodedLog.Log.TxHash)
+
+	// get filter logs
+	newFilterRes, err := xplac.EthNewFilter(types.EthNewFilterMsg{
+		Address:   []string{contractAddr},
+		FromBlock: "earliest",
+		ToBlock:   "latest",
+	}).Query()
+	s.Require().NoError(err)
+
+	var ethNewFilterResponse types.EthNewFilterResponse
+	s.Require().NoError(json.Unmarshal([]byte(newFilterRes), &ethNewFilterResponse))
+
+	ethGetFilterLogsMsg := types.EthGetFilterLogsMsg{
+		FilterId:        ethNewFilterResponse.NewFilter.(string),
+		ABIJsonFilePath: testErc20ABIJsonFilePath,
+	}
+	res, err = xplac.EthGetFilterLogs(ethGetFilterLogsMsg).Query()
+	s.Require().NoError(err)
+
+	var ethGetFilterLogsResponse types.EthGetFilterLogsResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &ethGetFilterLogsResponse))
+	s.Require().Len(ethGetFilterLogsResponse.GetFilterLogs, 1)
+	s.Require().Len(ethGetFilterLogsResponse.DecodedLogs, 1)
+	s.Require().Equal("Transfer", ethGetFilterLogsResponse.DecodedLogs[0].Event)
+}
+
+func (s *IntegrationTestSuite) TestErc20() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	account1 := s.network.Validators[1].AdditionalAccount
+	owner := common.BytesToAddress(account0.Address.Bytes())
+	spender := common.BytesToAddress(account1.Address.Bytes())
+
+	newXplac := func(privKey key.PrivateKey) provider.XplaClient {
+		return client.NewXplaClient(testutil.TestChainId).
+			WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+			WithURL(s.network.Validators[0].APIAddress).
+			WithPrivateKey(privKey).
+			WithGasAdjustment(types.DefaultGasAdjustment)
+	}
+	ownerXplac := newXplac(account0.PrivKey)
+	spenderXplac := newXplac(account1.PrivKey)
+
+	// deploy the erc20 token which has 6 decimals and mint tokens to the owner
+	txbytes, err := ownerXplac.DeploySolidityContract(types.DeploySolContractMsg{
+		ABIJsonFilePath:      testErc20DecimalsABIJsonFilePath,
+		BytecodeJsonFilePath: testErc20DecimalsBytecodeJsonFilePath,
+		Args:                 []interface{}{"Test Token", "TT", uint8(6)},
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	deployRes, err := ownerXplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	contractAddr := deployRes.EvmReceipt.ContractAddress.String()
+
+	txbytes, err = ownerXplac.WithGasLimit("").WithSequence("").InvokeSolidityContract(types.InvokeSolContractMsg{
+		ContractAddress:      contractAddr,
+		ContractFuncCallName: "mint",
+		Args:                 []interface{}{owner, big.NewInt(1000000000)},
+		ABIJsonFilePath:      testErc20DecimalsABIJsonFilePath,
+		BytecodeJsonFilePath: testErc20DecimalsBytecodeJsonFilePath,
+		FromByteAddress:      owner.String(),
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = ownerXplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// token info
+	res, err := ownerXplac.Erc20TokenInfo(types.Erc20TokenInfoMsg{ContractAddress: contractAddr}).Query()
+	s.Require().NoError(err)
+
+	var erc20TokenInfoResponse types.Erc20TokenInfoResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &erc20TokenInfoResponse))
+	s.Require().Equal(types.Erc20TokenInfoResponse{
+		Name:                 "Test Token",
+		Symbol:               "TT",
+		Decimals:             6,
+		TotalSupply:          "1000000000",
+		FormattedTotalSupply: "1000",
+	}, erc20TokenInfoResponse)
+
+	// transfer and approve
+	txbytes, err = ownerXplac.WithGasLimit("").WithSequence("").Erc20Transfer(types.Erc20TransferMsg{
+		ContractAddress: contractAddr,
+		Recipient:       spender.String(),
+		Amount:          "1.5",
+		Decimals:        "6",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = ownerXplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	txbytes, err = ownerXplac.WithGasLimit("").WithSequence("").Erc20Approve(types.Erc20ApproveMsg{
+		ContractAddress: contractAddr,
+		Spender:         spender.String(),
+		Amount:          "10",
+		Decimals:        "6",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = ownerXplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// transfer from by the spender
+	txbytes, err = spenderXplac.Erc20TransferFrom(types.Erc20TransferFromMsg{
+		ContractAddress: contractAddr,
+		Owner:           owner.String(),
+		Recipient:       spender.String(),
+		Amount:          "2500000",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = spenderXplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// balance and allowance
+	res, err = ownerXplac.Erc20BalanceOf(types.Erc20BalanceOfMsg{
+		ContractAddress: contractAddr,
+		Account:         spender.String(),
+	}).Query()
+	s.Require().NoError(err)
+
+	var erc20BalanceOfResponse types.Erc20BalanceOfResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &erc20BalanceOfResponse))
+	s.Require().Equal("4000000", erc20BalanceOfResponse.Balance)
+	s.Require().Equal(uint8(6), erc20BalanceOfResponse.Decimals)
+	s.Require().Equal("4", erc20BalanceOfResponse.FormattedBalance)
+
+	res, err = ownerXplac.Erc20Allowance(types.Erc20AllowanceMsg{
+		ContractAddress: contractAddr,
+		Owner:           owner.String(),
+		Spender:         spender.String(),
+	}).Query()
+	s.Require().NoError(err)
+
+	var erc20AllowanceResponse types.Erc20AllowanceResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &erc20AllowanceResponse))
+	s.Require().Equal("7500000", erc20AllowanceResponse.Allowance)
+	s.Require().Equal("7.5", erc20AllowanceResponse.FormattedAllowance)
+}
+
+func (s *IntegrationTestSuite) TestErc20Binding() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	account1 := s.network.Validators[1].AdditionalAccount
+	owner := common.BytesToAddress(account0.Address.Bytes())
+	recipient := common.BytesToAddress(account1.Address.Bytes())
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithURL(s.network.Validators[0].APIAddress).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	// deploy by the binding with constructor arguments
+	txbytes, err := erc20.DeployErc20(xplac, owner, big.NewInt(1000)).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	deployRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	token, err := erc20.NewErc20(xplac, deployRes.EvmReceipt.ContractAddress)
+	s.Require().NoError(err)
+
+	totalSupply, err := token.TotalSupply()
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(1000), totalSupply)
+
+	// transfer and parse the event from the receipt
+	txbytes, err = token.Transfer(recipient, big.NewInt(300)).WithGasLimit("").WithSequence("").CreateAndSignTx()
+	s.Require().NoError(err)
+
+	transferRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Len(transferRes.EvmReceipt.Logs, 1)
+
+	transfer, err := token.ParseTransfer(*transferRes.EvmReceipt.Logs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(owner, transfer.From)
+	s.Require().Equal(recipient, transfer.To)
+	s.Require().Equal(big.NewInt(300), transfer.Value)
+
+	_, err = token.ParseApproval(*transferRes.EvmReceipt.Logs[0])
+	s.Require().Error(err)
+
+	// call
+	balance, err := token.BalanceOf(recipient)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(300), balance)
+
+	balance, err = token.BalanceOf(owner)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(700), balance)
+
+	// the token does not implement decimals, so the formatted amount is the raw amount
+	res, err := xplac.Erc20BalanceOf(types.Erc20BalanceOfMsg{
+		ContractAddress: deployRes.EvmReceipt.ContractAddress.String(),
+		Account:         recipient.String(),
+	}).Query()
+	s.Require().NoError(err)
+
+	var erc20BalanceOfResponse types.Erc20BalanceOfResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &erc20BalanceOfResponse))
+	s.Require().Equal(types.Erc20BalanceOfResponse{
+		Balance:          "300",
+		Decimals:         0,
+		FormattedBalance: "300",
+	}, erc20BalanceOfResponse)
+
+	res, err = xplac.Erc20Allowance(types.Erc20AllowanceMsg{
+		ContractAddress: deployRes.EvmReceipt.ContractAddress.String(),
+		Owner:           owner.String(),
+		Spender:         recipient.String(),
+	}).Query()
+	s.Require().NoError(err)
+
+	var erc20AllowanceResponse types.Erc20AllowanceResponse
+	s.Require().NoError(json.Unmarshal([]byte(res), &erc20AllowanceResponse))
+	s.Require().Equal("0", erc20AllowanceResponse.Allowance)
+	s.Require().Equal("0", erc20AllowanceResponse.FormattedAllowance)
+}
+
 func (s *IntegrationTestSuite) TestEthCoinbase() {
 	res, err := s.xplac.EthCoinbase().Query()
 	s.Require().NoError(err)
@@ -569,6 +860,169 @@ func (s *IntegrationTestSuite) TestEthCoinbase() {
 	s.Require().NotEqual("", ethCoinbaseResponse.Coinbase[2:])
 }
 
+func TestDecodeEvmLogs(t *testing.T) {
+	eventAbi := `[
+		{"type":"event","name":"Stored","anonymous":false,"inputs":[
+			{"indexed":true,"name":"owner","type":"address"},
+			{"indexed":false,"name":"value","type":"uint256"}]},
+		{"type":"event","name":"Noted","anonymous":true,"inputs":[
+			{"indexed":true,"name":"id","type":"uint256"},
+			{"indexed":false,"name":"memo","type":"string"}]}
+	]`
+	contractAbi, err := abi.JSON(strings.NewReader(eventAbi))
+	require.NoError(t, err)
+
+	owner := common.HexToAddress("0xf7777b36a51fb0b33dd0c5118361AfC94ff7f967")
+	storedData, err := contractAbi.Events["Stored"].Inputs.NonIndexed().Pack(big.NewInt(7))
+	require.NoError(t, err)
+	notedData, err := contractAbi.Events["Noted"].Inputs.NonIndexed().Pack("memo")
+	require.NoError(t, err)
+
+	logs := []ethtypes.Log{
+		{
+			Topics: []common.Hash{contractAbi.Events["Stored"].ID, common.BytesToHash(owner.Bytes())},
+			Data:   storedData,
+		},
+		{
+			Topics: []common.Hash{common.BigToHash(big.NewInt(3))},
+			Data:   notedData,
+		},
+		{
+			Topics: []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+		},
+	}
+
+	decodedLogs, err := util.DecodeEvmLogs(eventAbi, logs)
+	require.NoError(t, err)
+	require.Len(t, decodedLogs, 3)
+
+	require.Equal(t, "Stored", decodedLogs[0].Event)
+	require.Equal(t, owner, decodedLogs[0].Indexed["owner"])
+	require.Equal(t, big.NewInt(7), decodedLogs[0].NonIndexed["value"])
+	require.Empty(t, decodedLogs[0].DecodeErr)
+
+	require.Equal(t, "Noted", decodedLogs[1].Event)
+	require.True(t, decodedLogs[1].Anonymous)
+	require.Equal(t, big.NewInt(3), decodedLogs[1].Indexed["id"])
+	require.Equal(t, "memo", decodedLogs[1].NonIndexed["memo"])
+
+	require.Empty(t, decodedLogs[2].Event)
+	require.Contains(t, decodedLogs[2].DecodeErr, "unknown event")
+	require.Equal(t, logs[2].Topics, decodedLogs[2].Log.Topics)
+
+	_, err = util.DecodeEvmLogs("invalid abi", logs)
+	require.Error(t, err)
+
+	// the anonymous log is not decoded when several anonymous events can decode it
+	ambiguousAbi := `[
+		{"type":"event","name":"Noted","anonymous":true,"inputs":[
+			{"indexed":true,"name":"id","type":"uint256"},
+			{"indexed":false,"name":"memo","type":"string"}]},
+		{"type":"event","name":"Tagged","anonymous":true,"inputs":[
+			{"indexed":true,"name":"tag","type":"uint256"},
+			{"indexed":false,"name":"label","type":"string"}]}
+	]`
+	decodedLogs, err = util.DecodeEvmLogs(ambiguousAbi, logs[1:2])
+	require.NoError(t, err)
+	require.Len(t, decodedLogs, 1)
+	require.Empty(t, decodedLogs[0].Event)
+	require.Empty(t, decodedLogs[0].Indexed)
+	require.Contains(t, decodedLogs[0].DecodeErr, "ambiguous anonymous events Noted, Tagged")
+	require.Equal(t, logs[1], decodedLogs[0].Log)
+}
+
+func TestDecodeNftTransferLogs(t *testing.T) {
+	erc20Abi, err := abi.JSON(strings.NewReader(mevm.Erc20ABI))
+	require.NoError(t, err)
+	erc1155Abi, err := abi.JSON(strings.NewReader(mevm.Erc1155ABI))
+	require.NoError(t, err)
+
+	contract := common.HexToAddress("0x80E123317190cAf36292A04776b0De020136526F")
+	operator := common.HexToAddress("0x6577385b5d959644ae31263208a88E921273C774")
+	from := common.HexToAddress("0xf7777b36a51fb0b33dd0c5118361AfC94ff7f967")
+	to := common.HexToAddress("0xF9AC4736D8034F2CB3BFF22A977CD8759934F090")
+	transferId := erc20Abi.Events["Transfer"].ID
+
+	erc20Data, err := erc20Abi.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(100))
+	require.NoError(t, err)
+	singleData, err := erc1155Abi.Events["TransferSingle"].Inputs.NonIndexed().Pack(big.NewInt(7), big.NewInt(3))
+	require.NoError(t, err)
+	batchData, err := erc1155Abi.Events["TransferBatch"].Inputs.NonIndexed().Pack(
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		[]*big.Int{big.NewInt(10), big.NewInt(20)},
+	)
+	require.NoError(t, err)
+
+	logs := []ethtypes.Log{
+		// erc20 transfer
+		{
+			Address: contract,
+			Topics:  []common.Hash{transferId, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    erc20Data,
+		},
+		// erc721 transfer
+		{
+			Address: contract,
+			Topics:  []common.Hash{transferId, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()), common.BigToHash(big.NewInt(5))},
+		},
+		// erc1155 transfer single
+		{
+			Address: contract,
+			Topics:  []common.Hash{erc1155Abi.Events["TransferSingle"].ID, common.BytesToHash(operator.Bytes()), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    singleData,
+		},
+		// erc1155 transfer batch
+		{
+			Address: contract,
+			Topics:  []common.Hash{erc1155Abi.Events["TransferBatch"].ID, common.BytesToHash(operator.Bytes()), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    batchData,
+		},
+	}
+
+	nftTransfers, err := mevm.DecodeNftTransferLogs(logs)
+	require.NoError(t, err)
+	require.Len(t, nftTransfers, 3)
+
+	require.Equal(t, mevm.Erc721Standard, nftTransfers[0].Standard)
+	require.Equal(t, "Transfer", nftTransfers[0].Event)
+	require.Equal(t, contract.Hex(), nftTransfers[0].ContractAddress)
+	require.Equal(t, from.Hex(), nftTransfers[0].From)
+	require.Equal(t, to.Hex(), nftTransfers[0].To)
+	require.Equal(t, []string{"5"}, nftTransfers[0].TokenIds)
+	require.Equal(t, []string{"1"}, nftTransfers[0].Amounts)
+
+	require.Equal(t, mevm.Erc1155Standard, nftTransfers[1].Standard)
+	require.Equal(t, "TransferSingle", nftTransfers[1].Event)
+	require.Equal(t, operator.Hex(), nftTransfers[1].Operator)
+	require.Equal(t, []string{"7"}, nftTransfers[1].TokenIds)
+	require.Equal(t, []string{"3"}, nftTransfers[1].Amounts)
+
+	require.Equal(t, "TransferBatch", nftTransfers[2].Event)
+	require.Equal(t, from.Hex(), nftTransfers[2].From)
+	require.Equal(t, to.Hex(), nftTransfers[2].To)
+	require.Equal(t, []string{"1", "2"}, nftTransfers[2].TokenIds)
+	require.Equal(t, []string{"10", "20"}, nftTransfers[2].Amounts)
+}
+
+func TestResolveErc1155Uri(t *testing.T) {
+	require.Equal(t,
+		"https://token-cdn-domain/000000000000000000000000000000000000000000000000000000000004cce0.json",
+		mevm.ResolveErc1155Uri("https://token-cdn-domain/{id}.json", big.NewInt(314592)),
+	)
+	require.Equal(t, "https://token-cdn-domain/1.json", mevm.ResolveErc1155Uri("https://token-cdn-domain/1.json", big.NewInt(1)))
+}
+
+func TestCallSolidityContractOutputsWithoutEvmRpc(t *testing.T) {
+	xplac := client.NewXplaClient(testutil.TestChainId)
+	_, err := mevm.CallSolidityContractOutputs(xplac, types.CallSolContractMsg{
+		ContractAddress:      "0x80E123317190cAf36292A04776b0De020136526F",
+		ContractFuncCallName: "totalSupply",
+		ABI:                  mevm.Erc20ABI,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "evm JSON-RPC URL must exist")
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = validatorNumber
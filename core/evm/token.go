@@ -0,0 +1,165 @@
+package evm
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// The ABI of the standard ERC-20 token which is used by ERC-20 helpers.
+// It also can be used to decode Transfer and Approval events of ERC-20 tokens.
+//
+//go:embed erc20_abi.json
+var Erc20ABI string
+
+// The ABI of the standard ERC-721 token which is used by ERC-721 helpers.
+//
+//go:embed erc721_abi.json
+var Erc721ABI string
+
+// The ABI of the standard ERC-1155 token which is used by ERC-1155 helpers.
+//
+//go:embed erc1155_abi.json
+var Erc1155ABI string
+
+// Call the view function of the token contract by the ABI, and return the first output.
+func callToken(evmClient *util.EvmClient, callMsg ethereum.CallMsg, tokenAbi string, method string, args ...interface{}) (interface{}, error) {
+	data, err := util.GetAbiPack(method, tokenAbi, "", args...)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	callMsg.Data = data
+
+	res, err := evmClient.Client.CallContract(evmClient.Ctx, callMsg, nil)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrEvmRpcRequest, err)
+	}
+
+	unpacked, err := util.GetAbiUnpack(method, tokenAbi, "", res)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return unpacked[0], nil
+}
+
+func tokenCallMsg(contractAddr common.Address, gasLimit string, gasPrice *big.Int) (ethereum.CallMsg, error) {
+	gasLimitU64, err := util.FromStringToUint64(gasLimit)
+	if err != nil {
+		return ethereum.CallMsg{}, types.ErrWrap(types.ErrConvert, err)
+	}
+
+	return ethereum.CallMsg{
+		To:       &contractAddr,
+		Gas:      gasLimitU64,
+		GasPrice: gasPrice,
+	}, nil
+}
+
+// Call decimals of the ERC-20 token. Decimals is optional in ERC-20, so the token which does not implement it
+// has 0 decimals, and the formatted amount is the same as the raw amount.
+func callErc20Decimals(evmClient *util.EvmClient, callMsg ethereum.CallMsg) (uint8, error) {
+	data, err := util.GetAbiPack("decimals", Erc20ABI, "")
+	if err != nil {
+		return 0, types.ErrWrap(types.ErrParse, err)
+	}
+	callMsg.Data = data
+
+	res, err := evmClient.Client.CallContract(evmClient.Ctx, callMsg, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), vm.ErrExecutionReverted.Error()) {
+			return 0, nil
+		}
+		return 0, types.ErrWrap(types.ErrEvmRpcRequest, err)
+	}
+	if len(res) == 0 {
+		return 0, nil
+	}
+
+	unpacked, err := util.GetAbiUnpack("decimals", Erc20ABI, "", res)
+	if err != nil {
+		return 0, types.ErrWrap(types.ErrParse, err)
+	}
+	return unpacked[0].(uint8), nil
+}
+
+// Substitute "{id}" of the ERC-1155 URI by the token ID which is lowercase hex padded to 64 characters.
+func ResolveErc1155Uri(uri string, id *big.Int) string {
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+}
+
+// Decode NFT transfers from evm logs.
+// Transfer events of ERC-721 and TransferSingle and TransferBatch events of ERC-1155 are decoded,
+// and other logs, including Transfer events of ERC-20 which do not have the indexed token ID, are skipped.
+func DecodeNftTransferLogs(logs []ethtypes.Log) ([]types.NftTransfer, error) {
+	erc721Abi, err := abi.JSON(strings.NewReader(Erc721ABI))
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	erc1155Abi, err := abi.JSON(strings.NewReader(Erc1155ABI))
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+
+	var nftTransfers []types.NftTransfer
+	for _, log := range logs {
+		if eventLog := util.DecodeEvmLog(erc721Abi, log); eventLog.DecodeErr == "" && eventLog.Event == "Transfer" {
+			nftTransfers = append(nftTransfers, types.NftTransfer{
+				Standard:        Erc721Standard,
+				Event:           eventLog.Event,
+				ContractAddress: log.Address.Hex(),
+				From:            eventLog.Indexed["from"].(common.Address).Hex(),
+				To:              eventLog.Indexed["to"].(common.Address).Hex(),
+				TokenIds:        []string{eventLog.Indexed["tokenId"].(*big.Int).String()},
+				Amounts:         []string{"1"},
+				Log:             log,
+			})
+			continue
+		}
+
+		eventLog := util.DecodeEvmLog(erc1155Abi, log)
+		if eventLog.DecodeErr != "" {
+			continue
+		}
+
+		nftTransfer := types.NftTransfer{
+			Standard:        Erc1155Standard,
+			Event:           eventLog.Event,
+			ContractAddress: log.Address.Hex(),
+			Log:             log,
+		}
+		switch eventLog.Event {
+		case "TransferSingle":
+			nftTransfer.TokenIds = []string{eventLog.NonIndexed["id"].(*big.Int).String()}
+			nftTransfer.Amounts = []string{eventLog.NonIndexed["value"].(*big.Int).String()}
+		case "TransferBatch":
+			nftTransfer.TokenIds = bigIntsToStrings(eventLog.NonIndexed["ids"].([]*big.Int))
+			nftTransfer.Amounts = bigIntsToStrings(eventLog.NonIndexed["values"].([]*big.Int))
+		default:
+			continue
+		}
+		nftTransfer.Operator = eventLog.Indexed["operator"].(common.Address).Hex()
+		nftTransfer.From = eventLog.Indexed["from"].(common.Address).Hex()
+		nftTransfer.To = eventLog.Indexed["to"].(common.Address).Hex()
+
+		nftTransfers = append(nftTransfers, nftTransfer)
+	}
+	return nftTransfers, nil
+}
+
+func bigIntsToStrings(values []*big.Int) []string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = value.String()
+	}
+	return strs
+}
@@ -71,7 +71,7 @@ func (e FeegrantExternal) QueryFeeGrants(queryFeeGrantMsg types.QueryFeeGrantMsg
 		return e.ToExternal(FeegrantQueryGrantMsgType, msg)
 
 	case queryFeeGrantMsg.Grantee != "" && queryFeeGrantMsg.Granter == "":
-		msg, err := MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg)
+		msg, err := MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(FeegrantQueryGrantsByGranteeMsgType, err)
 		}
@@ -79,7 +79,7 @@ func (e FeegrantExternal) QueryFeeGrants(queryFeeGrantMsg types.QueryFeeGrantMsg
 		return e.ToExternal(FeegrantQueryGrantsByGranteeMsgType, msg)
 
 	case queryFeeGrantMsg.Grantee == "" && queryFeeGrantMsg.Granter != "":
-		msg, err := MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg)
+		msg, err := MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(FeegrantQueryGrantsByGranterMsgType, err)
 		}
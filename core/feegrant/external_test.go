@@ -12,7 +12,7 @@ func (s *IntegrationTestSuite) TestFeegrantTx() {
 	feeGrantMsg := types.FeeGrantMsg{
 		Granter:    s.accounts[0].Address.String(),
 		Grantee:    s.accounts[1].Address.String(),
-		SpendLimit: "1000",
+		SpendLimit: "1000axpla",
 		// Period:      "3600",
 		// PeriodLimit: "10",
 		Expiration: "2100-01-01T23:59:59+00:00",
@@ -76,7 +76,7 @@ func (s *IntegrationTestSuite) TestFeegrant() {
 	}
 	s.xplac.QueryFeeGrants(queryFeeGrantMsg)
 
-	makeQueryFeeGrantsByGranteeMsg, err := mfeegrant.MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg)
+	makeQueryFeeGrantsByGranteeMsg, err := mfeegrant.MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryFeeGrantsByGranteeMsg, s.xplac.GetMsg())
@@ -89,7 +89,7 @@ func (s *IntegrationTestSuite) TestFeegrant() {
 	}
 	s.xplac.QueryFeeGrants(queryFeeGrantMsg)
 
-	makeQueryFeeGrantsByGranterMsg, err := mfeegrant.MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg)
+	makeQueryFeeGrantsByGranterMsg, err := mfeegrant.MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryFeeGrantsByGranterMsg, s.xplac.GetMsg())
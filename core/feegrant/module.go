@@ -6,6 +6,7 @@ import (
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -44,3 +45,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryFeegrant(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryFeegrantProto(q)
+}
@@ -28,7 +28,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	feeGrantMsg := types.FeeGrantMsg{
 		Granter:    accounts[0].Address.String(),
 		Grantee:    accounts[1].Address.String(),
-		SpendLimit: "1000",
+		SpendLimit: "1000axpla",
 		// Period:      "3600",
 		// PeriodLimit: "10",
 		Expiration: "2100-01-01T23:59:59+00:00",
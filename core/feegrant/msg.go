@@ -4,6 +4,7 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -23,11 +24,11 @@ func MakeQueryFeeGrantMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.Que
 }
 
 // (Query) make msg - fee grants by grantee
-func MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesRequest, error) {
-	return parseQueryFeeGrantsByGranteeArgs(queryFeeGrantMsg)
+func MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg types.QueryFeeGrantMsg, pageRequest *query.PageRequest) (feegrant.QueryAllowancesRequest, error) {
+	return parseQueryFeeGrantsByGranteeArgs(queryFeeGrantMsg, pageRequest)
 }
 
 // (Query) make msg - fee grants by granter
-func MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesByGranterRequest, error) {
-	return parseQueryFeeGrantsByGranterArgs(queryFeeGrantMsg)
+func MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg types.QueryFeeGrantMsg, pageRequest *query.PageRequest) (feegrant.QueryAllowancesByGranterRequest, error) {
+	return parseQueryFeeGrantsByGranterArgs(queryFeeGrantMsg, pageRequest)
 }
@@ -3,11 +3,11 @@ package feegrant
 import (
 	"time"
 
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -22,11 +22,7 @@ func parseFeeGrantArgs(feeGrantMsg types.FeeGrantMsg, granter sdk.AccAddress) (f
 		return feegrant.MsgGrantAllowance{}, types.ErrWrap(types.ErrParse, err)
 	}
 
-	limit := util.DenomAdd(feeGrantMsg.SpendLimit)
-	if feeGrantMsg.SpendLimit == "" {
-		limit = ""
-	}
-	spendLimit, err := sdk.ParseCoinsNormalized(limit)
+	spendLimit, err := util.ParseCoins(feeGrantMsg.SpendLimit)
 	if err != nil {
 		return feegrant.MsgGrantAllowance{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -53,7 +49,7 @@ func parseFeeGrantArgs(feeGrantMsg types.FeeGrantMsg, granter sdk.AccAddress) (f
 	}
 
 	if periodClock > 0 || feeGrantMsg.PeriodLimit != "" {
-		periodLimit, err := sdk.ParseCoinsNormalized(util.DenomAdd(feeGrantMsg.PeriodLimit))
+		periodLimit, err := util.ParseCoins(feeGrantMsg.PeriodLimit)
 		if err != nil {
 			return feegrant.MsgGrantAllowance{}, types.ErrWrap(types.ErrParse, err)
 		}
@@ -130,7 +126,7 @@ func parseQueryFeeGrantArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.Quer
 }
 
 // Parsing - grants by grantee
-func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesRequest, error) {
+func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg, pageRequest *query.PageRequest) (feegrant.QueryAllowancesRequest, error) {
 	grantee, err := sdk.AccAddressFromBech32(queryGrantMsg.Grantee)
 	if err != nil {
 		return feegrant.QueryAllowancesRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -138,12 +134,12 @@ func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg) (fee
 
 	return feegrant.QueryAllowancesRequest{
 		Grantee:    grantee.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // Parsing - grants by granter
-func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesByGranterRequest, error) {
+func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg, pageRequest *query.PageRequest) (feegrant.QueryAllowancesByGranterRequest, error) {
 	granter, err := sdk.AccAddressFromBech32(queryGrantMsg.Granter)
 	if err != nil {
 		return feegrant.QueryAllowancesByGranterRequest{}, types.ErrWrap(types.ErrParse, err)
@@ -151,7 +147,7 @@ func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg) (fee
 
 	return feegrant.QueryAllowancesByGranterRequest{
 		Granter:    granter.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -10,21 +10,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for fee-grant module.
 func QueryFeegrant(i core.QueryClient) (string, error) {
+	res, err := QueryFeegrantProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for fee-grant module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryFeegrantProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcFeegrant(i)
 	} else {
 		return queryByLcdFeegrant(i)
 	}
-
 }
 
-func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
+func queryByGrpcFeegrant(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := feegrant.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +50,7 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Feegrant grants by grantee
@@ -47,7 +61,7 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Feegrant grants by granter
@@ -58,19 +72,14 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -78,38 +87,46 @@ const (
 	feegrantAllowancesLabel = "allowances"
 )
 
-func queryByLcdFeegrant(i core.QueryClient) (string, error) {
+func queryByLcdFeegrant(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(feegrantv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Feegrant state
 	case i.Ixplac.GetMsgType() == FeegrantQueryGrantMsgType:
+		res = &feegrant.QueryAllowanceResponse{}
 		convertMsg := i.Ixplac.GetMsg().(feegrant.QueryAllowanceRequest)
 
 		url = url + util.MakeQueryLabels(feegrantAllowanceLabel, convertMsg.Granter, convertMsg.Grantee)
 
 	// Feegrant grants by grantee
 	case i.Ixplac.GetMsgType() == FeegrantQueryGrantsByGranteeMsgType:
+		res = &feegrant.QueryAllowancesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(feegrant.QueryAllowancesRequest)
 
 		url = url + util.MakeQueryLabels(feegrantAllowancesLabel, convertMsg.Grantee)
 
 	// Feegrant grants by granter
 	case i.Ixplac.GetMsgType() == FeegrantQueryGrantsByGranterMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying feegrant state(grants by granter) by using LCD"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying feegrant state(grants by granter) by using LCD"))
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 
 }
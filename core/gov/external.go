@@ -91,7 +91,7 @@ func (e GovExternal) QueryProposal(queryProposal types.QueryProposalMsg) provide
 
 // Query proposals with optional filters.
 func (e GovExternal) QueryProposals(queryProposals types.QueryProposalsMsg) provider.XplaClient {
-	msg, err := MakeQueryProposalsMsg(queryProposals)
+	msg, err := MakeQueryProposalsMsg(queryProposals, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(GovQueryProposalsMsgType, err)
 	}
@@ -122,7 +122,7 @@ func (e GovExternal) QueryDeposit(queryDepositMsg types.QueryDepositMsg) provide
 		}
 
 	default:
-		msg, argsType, err := MakeQueryDepositsMsg(queryDepositMsg, e.Xplac.GetHttpMutex(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType)
+		msg, argsType, err := MakeQueryDepositsMsg(queryDepositMsg, e.Xplac.GetHttpMutex(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(GovQueryDepositsRequestMsgType, err)
 		}
@@ -154,7 +154,7 @@ func (e GovExternal) QueryVote(queryVoteMsg types.QueryVoteMsg) provider.XplaCli
 		return e.ToExternal(GovQueryVoteMsgType, msg)
 
 	default:
-		msg, status, err := MakeQueryVotesMsg(queryVoteMsg, e.Xplac.GetHttpMutex(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType)
+		msg, status, err := MakeQueryVotesMsg(queryVoteMsg, e.Xplac.GetHttpMutex(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(GovQueryVotesPassedMsgType, err)
 		}
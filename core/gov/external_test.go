@@ -19,7 +19,7 @@ func (s *IntegrationTestSuite) TestGovTx() {
 		Title:       "Test proposal",
 		Description: "Proposal description",
 		Type:        "text",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 	}
 	s.xplac.SubmitProposal(submitProposalMsg)
 
@@ -40,7 +40,7 @@ func (s *IntegrationTestSuite) TestGovTx() {
 	// deposit
 	govDepositMsg := types.GovDepositMsg{
 		ProposalID: "1",
-		Deposit:    "1000",
+		Deposit:    "1000axpla",
 	}
 	s.xplac.GovDeposit(govDepositMsg)
 
@@ -138,7 +138,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	}
 	s.xplac.QueryProposals(queryProposalsMsg)
 
-	makeQueryProposalsMsg, err := mgov.MakeQueryProposalsMsg(queryProposalsMsg)
+	makeQueryProposalsMsg, err := mgov.MakeQueryProposalsMsg(queryProposalsMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryProposalsMsg, s.xplac.GetMsg())
@@ -175,7 +175,7 @@ func (s *IntegrationTestSuite) TestGov() {
 		}
 		s.xplac.QueryDeposit(queryDepositMsg)
 
-		makeQueryDepositsMsg, _, err := mgov.MakeQueryDepositsMsg(queryDepositMsg, s.xplac.GetHttpMutex(), s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
+		makeQueryDepositsMsg, _, err := mgov.MakeQueryDepositsMsg(queryDepositMsg, s.xplac.GetHttpMutex(), s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType, s.xplac.GetPagination())
 		s.Require().NoError(err)
 
 		s.Require().Equal(makeQueryDepositsMsg, s.xplac.GetMsg())
@@ -202,7 +202,7 @@ func (s *IntegrationTestSuite) TestGov() {
 		}
 		s.xplac.QueryVote(queryVoteMsg)
 
-		makeQueryVotesMsg, _, err := mgov.MakeQueryVotesMsg(queryVoteMsg, s.xplac.GetHttpMutex(), s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
+		makeQueryVotesMsg, _, err := mgov.MakeQueryVotesMsg(queryVoteMsg, s.xplac.GetHttpMutex(), s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType, s.xplac.GetPagination())
 		s.Require().NoError(err)
 
 		s.Require().Equal(makeQueryVotesMsg, s.xplac.GetMsg())
@@ -6,6 +6,7 @@ import (
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -58,3 +59,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryGov(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryGovProto(q)
+}
@@ -29,7 +29,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Title:       "Test proposal",
 		Description: "Proposal description",
 		Type:        "text",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 	}
 
 	makeSubmitProposalMsg, err := gov.MakeSubmitProposalMsg(submitProposalMsg, s.xplac.GetFromAddress())
@@ -43,7 +43,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	// deposit
 	govDepositMsg := types.GovDepositMsg{
 		ProposalID: "1",
-		Deposit:    "1000",
+		Deposit:    "1000axpla",
 	}
 
 	makeGovDepositMsg, err := gov.MakeGovDepositMsg(govDepositMsg, s.xplac.GetFromAddress())
@@ -8,6 +8,7 @@ import (
 	"github.com/xpladev/xpla.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
 )
@@ -44,8 +45,8 @@ func MakeQueryProposalMsg(queryProposalMsg types.QueryProposalMsg) (govtypes.Que
 }
 
 // (Query) make msg - proposals
-func MakeQueryProposalsMsg(queryProposalsMsg types.QueryProposalsMsg) (govtypes.QueryProposalsRequest, error) {
-	return parseQueryProposalsArgs(queryProposalsMsg)
+func MakeQueryProposalsMsg(queryProposalsMsg types.QueryProposalsMsg, pageRequest *query.PageRequest) (govtypes.QueryProposalsRequest, error) {
+	return parseQueryProposalsArgs(queryProposalsMsg, pageRequest)
 }
 
 // (Query) make msg - query deposit
@@ -54,8 +55,8 @@ func MakeQueryDepositMsg(queryDepositMsg types.QueryDepositMsg, httpMutex *sync.
 }
 
 // (Query) make msg - query deposits
-func MakeQueryDepositsMsg(queryDepositMsg types.QueryDepositMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
-	return parseQueryDepositsArgs(queryDepositMsg, httpMutex, grpcConn, ctx, lcdUrl, queryType)
+func MakeQueryDepositsMsg(queryDepositMsg types.QueryDepositMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageRequest *query.PageRequest) (interface{}, string, error) {
+	return parseQueryDepositsArgs(queryDepositMsg, httpMutex, grpcConn, ctx, lcdUrl, queryType, pageRequest)
 }
 
 // (Query) make msg - tally
@@ -74,6 +75,6 @@ func MakeQueryVoteMsg(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex, gr
 }
 
 // (Query) make msg - query votes
-func MakeQueryVotesMsg(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
-	return parseQueryVotesArgs(queryVoteMsg, httpMutex, grpcConn, ctx, lcdUrl, queryType)
+func MakeQueryVotesMsg(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageRequest *query.PageRequest) (interface{}, string, error) {
+	return parseQueryVotesArgs(queryVoteMsg, httpMutex, grpcConn, ctx, lcdUrl, queryType, pageRequest)
 }
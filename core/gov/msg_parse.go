@@ -4,12 +4,12 @@ import (
 	"context"
 	"sync"
 
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	govv1beta1 "cosmossdk.io/api/cosmos/gov/v1beta1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
@@ -17,7 +17,7 @@ import (
 
 // Parsing - submit proposal
 func parseSubmitProposalArgs(submitProposalMsg types.SubmitProposalMsg, proposer sdk.AccAddress) (govtypes.MsgSubmitProposal, error) {
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(submitProposalMsg.Deposit))
+	amount, err := util.ParseCoins(submitProposalMsg.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -43,7 +43,7 @@ func parseGovDepositArgs(govDepositMsg types.GovDepositMsg, from sdk.AccAddress)
 		return govtypes.MsgDeposit{}, types.ErrWrap(types.ErrConvert, err)
 	}
 
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(govDepositMsg.Deposit))
+	amount, err := util.ParseCoins(govDepositMsg.Deposit)
 	if err != nil {
 		return govtypes.MsgDeposit{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -88,7 +88,7 @@ func parseWeightedVoteArgs(weightedVoteMsg types.WeightedVoteMsg, from sdk.AccAd
 }
 
 // Parsing - proposals
-func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg) (govtypes.QueryProposalsRequest, error) {
+func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg, pageRequest *query.PageRequest) (govtypes.QueryProposalsRequest, error) {
 	depositorAddr := queryProposalsMsg.Depositor
 	voterAddr := queryProposalsMsg.Voter
 	strProposalStatus := queryProposalsMsg.Status
@@ -121,7 +121,7 @@ func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg) (govtype
 		ProposalStatus: proposalStatus,
 		Voter:          voterAddr,
 		Depositor:      depositorAddr,
-		Pagination:     core.PageRequest,
+		Pagination:     pageRequest,
 	}, nil
 }
 
@@ -185,7 +185,7 @@ func parseQueryDepositArgs(queryDepositMsg types.QueryDepositMsg, httpMutex *syn
 }
 
 // Parsing - query deposits
-func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
+func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageRequest *query.PageRequest) (interface{}, string, error) {
 	var propStatus govtypes.ProposalStatus
 	proposalId, err := util.FromStringToUint64(queryDepositMsg.ProposalID)
 	if err != nil {
@@ -235,7 +235,7 @@ func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, httpMutex *sy
 
 	return govtypes.QueryDepositsRequest{
 		ProposalId: proposalId,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, "request", nil
 }
 
@@ -326,7 +326,7 @@ func parseQueryVoteArgs(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex,
 }
 
 // Parsing - query votes
-func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
+func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageRequest *query.PageRequest) (interface{}, string, error) {
 	var propStatus govtypes.ProposalStatus
 	proposalId, err := util.FromStringToUint64(queryVoteMsg.ProposalID)
 	if err != nil {
@@ -376,7 +376,7 @@ func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, httpMutex *sync.Mutex,
 
 	return govtypes.QueryVotesRequest{
 		ProposalId: proposalId,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, "passed", nil
 
 }
@@ -12,12 +12,30 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for gov module.
 func QueryGov(i core.QueryClient) (string, error) {
+	// Gov proposer
+	if i.Ixplac.GetMsgType() == GovQueryProposerMsgType {
+		return queryProposer(i)
+	}
+
+	res, err := QueryGovProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for gov module.
+// The response is returned as the protobuf message regardless of query type.
+// The proposer is not supported because it is not the protobuf message.
+func QueryGovProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcGov(i)
 	} else {
@@ -25,7 +43,10 @@ func QueryGov(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcGov(i core.QueryClient) (string, error) {
+func queryByGrpcGov(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := govtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -37,7 +58,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Gov proposals
@@ -48,7 +69,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Gov deposit parameter
@@ -59,15 +80,17 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 
 		resByTxQuery, err := govutils.QueryDepositByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 		clientCtx.Codec.MustUnmarshalJSON(resByTxQuery, &deposit)
-		res = &deposit
+		res = &govtypes.QueryDepositResponse{
+			Deposit: deposit,
+		}
 
 	// Gov deposit
 	case i.Ixplac.GetMsgType() == GovQueryDepositRequestMsgType:
@@ -77,7 +100,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Gov deposits parameter
@@ -87,20 +110,18 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 		var deposit govtypes.Deposits
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 
 		resByTxQuery, err := govutils.QueryDepositsByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &deposit)
-		out, err := core.PrintObjectLegacy(i, deposit)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+		res = &govtypes.QueryDepositsResponse{
+			Deposits: deposit,
 		}
-		return string(out), nil
 
 	// Gov deposits
 	case i.Ixplac.GetMsgType() == GovQueryDepositsRequestMsgType:
@@ -110,7 +131,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Gov tally
@@ -121,7 +142,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Gov params
@@ -131,7 +152,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "voting"},
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		tallyRes, err := queryClient.Params(
@@ -139,7 +160,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "tallying"},
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		depositRes, err := queryClient.Params(
@@ -147,95 +168,51 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "deposit"},
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
-		govAllParams := govtypes.NewParams(
-			votingRes.GetVotingParams(),
-			tallyRes.GetTallyParams(),
-			depositRes.GetDepositParams(),
-		)
-
-		bytes, err := util.JsonMarshalData(govAllParams)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+		res = &govtypes.QueryParamsResponse{
+			VotingParams:  votingRes.GetVotingParams(),
+			TallyParams:   tallyRes.GetTallyParams(),
+			DepositParams: depositRes.GetDepositParams(),
 		}
-		return string(bytes), nil
 
 	// Gov params of voting
 	case i.Ixplac.GetMsgType() == GovQueryGovParamVotingMsgType:
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
-		resParams, err := queryClient.Params(
+		res, err = queryClient.Params(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
-		bytes, err := util.JsonMarshalData(resParams.GetVotingParams())
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
-		}
-		return string(bytes), nil
-
 	// Gov params of tally
 	case i.Ixplac.GetMsgType() == GovQueryGovParamTallyingMsgType:
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
-		resParams, err := queryClient.Params(
+		res, err = queryClient.Params(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-		}
-
-		bytes, err := util.JsonMarshalData(resParams.GetTallyParams())
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
-		return string(bytes), nil
 
 	// Gov params of deposit
 	case i.Ixplac.GetMsgType() == GovQueryGovParamDepositMsgType:
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
-		resParams, err := queryClient.Params(
+		res, err = queryClient.Params(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-		}
-
-		bytes, err := util.JsonMarshalData(resParams.GetDepositParams())
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
-		return string(bytes), nil
 
 	// Gov proposer
 	case i.Ixplac.GetMsgType() == GovQueryProposerMsgType:
-		convertMsg := i.Ixplac.GetMsg().(string)
-		proposalId, err := util.FromStringToUint64(convertMsg)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
-		}
-
-		clientCtx, err := core.ClientForQuery(i)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
-		}
-
-		prop, err := govutils.QueryProposerByTxQuery(clientCtx, proposalId)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-		}
-
-		bytes, err := util.JsonMarshalData(prop)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
-		}
-		return string(bytes), nil
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "proposer is not returned as protobuf message"))
 
 	// Gov vote
 	case i.Ixplac.GetMsgType() == GovQueryVoteMsgType:
@@ -245,17 +222,17 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 
 		voterAddr, err := sdk.AccAddressFromBech32(convertMsg.Voter)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrParse, err))
 		}
 
 		vote := resVote.GetVote()
@@ -263,36 +240,35 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			params := govtypes.NewQueryVoteParams(convertMsg.ProposalId, voterAddr)
 			resByTxQuery, err := govutils.QueryVoteByTxQuery(clientCtx, params)
 			if err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+				return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 			}
 
 			if err := clientCtx.Codec.UnmarshalJSON(resByTxQuery, &vote); err != nil {
-				return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
+				return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
 			}
+			resVote.Vote = vote
 		}
 
-		res = &resVote.Vote
+		res = resVote
 
 	// Gov votes not passed
 	case i.Ixplac.GetMsgType() == GovQueryVotesNotPassedMsgType:
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryProposalVotesParams)
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+			return nil, i.Ixplac.GetLogger().Err(err)
 		}
 		resByTxQuery, err := govutils.QueryVotesByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		var votes govtypes.Votes
 
 		clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &votes)
-		out, err := core.PrintObjectLegacy(i, votes)
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
+		res = &govtypes.QueryVotesResponse{
+			Votes: votes,
 		}
-		return string(out), nil
 
 	// Gov votes passed
 	case i.Ixplac.GetMsgType() == GovQueryVotesPassedMsgType:
@@ -302,19 +278,14 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -325,106 +296,149 @@ const (
 	govVotesLabel     = "votes"
 )
 
-func queryByLcdGov(i core.QueryClient) (string, error) {
+func queryByLcdGov(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(govv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Gov proposal
 	case i.Ixplac.GetMsgType() == GovQueryProposalMsgType:
+		res = &govtypes.QueryProposalResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryProposalRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId))
 
 	// Gov proposals
 	case i.Ixplac.GetMsgType() == GovQueryProposalsMsgType:
+		res = &govtypes.QueryProposalsResponse{}
 		url = url + govProposalsLabel
 
 	// Gov deposit parameter
 	case i.Ixplac.GetMsgType() == GovQueryDepositParamsMsgType:
+		res = &govtypes.QueryDepositResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryDepositParams)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalID), govDeposistsLabel, convertMsg.Depositor.String())
 
 	// Gov deposit
 	case i.Ixplac.GetMsgType() == GovQueryDepositRequestMsgType:
+		res = &govtypes.QueryDepositResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryDepositRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govDeposistsLabel, convertMsg.Depositor)
 
 	// Gov deposits parameter
 	case i.Ixplac.GetMsgType() == GovQueryDepositsParamsMsgType:
+		res = &govtypes.QueryDepositsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryProposalParams)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalID), govDeposistsLabel)
 
 	// Gov deposits
 	case i.Ixplac.GetMsgType() == GovQueryDepositsRequestMsgType:
+		res = &govtypes.QueryDepositsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryDepositsRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govDeposistsLabel)
 
 	// Gov tally
 	case i.Ixplac.GetMsgType() == GovTallyMsgType:
+		res = &govtypes.QueryTallyResultResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryTallyResultRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govTallyLabel)
 
 	// Gov params
 	case i.Ixplac.GetMsgType() == GovQueryGovParamsMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying all gov params by using LCD. query each parameter(voting|tallying|deposit)"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying all gov params by using LCD. query each parameter(voting|tallying|deposit)"))
 
 	// Gov params of voting
 	case i.Ixplac.GetMsgType() == GovQueryGovParamVotingMsgType:
+		res = &govtypes.QueryParamsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
 
 		url = url + util.MakeQueryLabels(govParamsLabel, convertMsg.ParamsType)
 
 	// Gov params of tally
 	case i.Ixplac.GetMsgType() == GovQueryGovParamTallyingMsgType:
+		res = &govtypes.QueryParamsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
 
 		url = url + util.MakeQueryLabels(govParamsLabel, convertMsg.ParamsType)
 
 	// Gov params of deposit
 	case i.Ixplac.GetMsgType() == GovQueryGovParamDepositMsgType:
+		res = &govtypes.QueryParamsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryParamsRequest)
 
 		url = url + util.MakeQueryLabels(govParamsLabel, convertMsg.ParamsType)
 
 	// Gov proposer
 	case i.Ixplac.GetMsgType() == GovQueryProposerMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying proposer by using LCD"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "proposer is not returned as protobuf message"))
 
 	// Gov vote
 	case i.Ixplac.GetMsgType() == GovQueryVoteMsgType:
+		res = &govtypes.QueryVoteResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryVoteRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govVotesLabel, convertMsg.Voter)
 
 	// Gov votes not passed
 	case i.Ixplac.GetMsgType() == GovQueryVotesNotPassedMsgType:
+		res = &govtypes.QueryVotesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryProposalVotesParams)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalID), govVotesLabel)
 
 	// Gov votes passed
 	case i.Ixplac.GetMsgType() == GovQueryVotesPassedMsgType:
+		res = &govtypes.QueryVotesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryVotesRequest)
 
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govVotesLabel)
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
+}
+
+// Query proposer of the proposal by using RPC.
+func queryProposer(i core.QueryClient) (string, error) {
+	convertMsg := i.Ixplac.GetMsg().(string)
+	proposalId, err := util.FromStringToUint64(convertMsg)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, err))
+	}
+
+	clientCtx, err := core.ClientForQuery(i)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	prop, err := govutils.QueryProposerByTxQuery(clientCtx, proposalId)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+	}
 
+	bytes, err := util.JsonMarshalData(prop)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+	}
+	return string(bytes), nil
 }
@@ -188,19 +188,11 @@ func (s *IntegrationTestSuite) TestVote() {
 		res1, err := s.xplac.QueryVote(queryVoteMsg).Query()
 		s.Require().NoError(err)
 
-		if i == 0 {
-			var queryVoteResponse govtypes.QueryVoteResponse
-			jsonpb.Unmarshal(strings.NewReader(res1), &queryVoteResponse)
-
-			s.Require().Equal(val, queryVoteResponse.Vote.Voter)
-			s.Require().Equal(uint64(1), queryVoteResponse.Vote.ProposalId)
-		} else {
-			var vote govtypes.Vote
-			jsonpb.Unmarshal(strings.NewReader(res1), &vote)
+		var queryVoteResponse govtypes.QueryVoteResponse
+		jsonpb.Unmarshal(strings.NewReader(res1), &queryVoteResponse)
 
-			s.Require().Equal(val, vote.Voter)
-			s.Require().Equal(uint64(1), vote.ProposalId)
-		}
+		s.Require().Equal(val, queryVoteResponse.Vote.Voter)
+		s.Require().Equal(uint64(1), queryVoteResponse.Vote.ProposalId)
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
@@ -236,117 +228,84 @@ func (s *IntegrationTestSuite) TestGovParams() {
 	for i, api := range s.apis {
 		if i == 0 {
 			s.xplac.WithURL(api)
-
-			// only query tally params
-			govParamsMsg := types.GovParamsMsg{
-				ParamType: "tallying",
-			}
-
-			res1, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
-
-			var queryParamsResponse1 govtypes.QueryParamsResponse
-			jsonpb.Unmarshal(strings.NewReader(res1), &queryParamsResponse1)
-
-			// can check tally
-			s.Require().Equal("0.334000000000000000", queryParamsResponse1.TallyParams.Quorum.String())
-			s.Require().Equal("0.500000000000000000", queryParamsResponse1.TallyParams.Threshold.String())
-			s.Require().Equal("0.334000000000000000", queryParamsResponse1.TallyParams.VetoThreshold.String())
-			s.Require().Equal("0s", queryParamsResponse1.VotingParams.VotingPeriod.String())
-			s.Require().Equal(0, len(queryParamsResponse1.DepositParams.MinDeposit))
-			s.Require().Equal("0s", queryParamsResponse1.DepositParams.MaxDepositPeriod.String())
-
-			// only query voting params
-			govParamsMsg = types.GovParamsMsg{
-				ParamType: "voting",
-			}
-
-			res2, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
-
-			var queryParamsResponse2 govtypes.QueryParamsResponse
-			jsonpb.Unmarshal(strings.NewReader(res2), &queryParamsResponse2)
-
-			// can check voting
-			s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.Quorum.String())
-			s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.Threshold.String())
-			s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.VetoThreshold.String())
-			s.Require().Equal("48h0m0s", queryParamsResponse2.VotingParams.VotingPeriod.String())
-			s.Require().Equal(0, len(queryParamsResponse2.DepositParams.MinDeposit))
-			s.Require().Equal("0s", queryParamsResponse2.DepositParams.MaxDepositPeriod.String())
-
-			// only query deposit params
-			govParamsMsg = types.GovParamsMsg{
-				ParamType: "deposit",
-			}
-
-			res3, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
-
-			var queryParamsResponse3 govtypes.QueryParamsResponse
-			jsonpb.Unmarshal(strings.NewReader(res3), &queryParamsResponse3)
-
-			// can check deposit
-			s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.Quorum.String())
-			s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.Threshold.String())
-			s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.VetoThreshold.String())
-			s.Require().Equal("0s", queryParamsResponse3.VotingParams.VotingPeriod.String())
-			s.Require().Equal(types.XplaDenom, queryParamsResponse3.DepositParams.MinDeposit[0].Denom)
-			s.Require().Equal("10000000", queryParamsResponse3.DepositParams.MinDeposit[0].Amount.String())
-			s.Require().Equal("48h0m0s", queryParamsResponse3.DepositParams.MaxDepositPeriod.String())
-
 		} else {
 			s.xplac.WithGrpc(api)
+		}
 
-			// only query tally params
-			govParamsMsg := types.GovParamsMsg{
-				ParamType: "tallying",
-			}
+		// only query tally params
+		govParamsMsg := types.GovParamsMsg{
+			ParamType: "tallying",
+		}
 
-			res1, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
+		res1, err := s.xplac.GovParams(govParamsMsg).Query()
+		s.Require().NoError(err)
+
+		var queryParamsResponse1 govtypes.QueryParamsResponse
+		jsonpb.Unmarshal(strings.NewReader(res1), &queryParamsResponse1)
 
-			var tallyParams govtypes.TallyParams
-			jsonpb.Unmarshal(strings.NewReader(res1), &tallyParams)
+		// can check tally
+		s.Require().Equal("0.334000000000000000", queryParamsResponse1.TallyParams.Quorum.String())
+		s.Require().Equal("0.500000000000000000", queryParamsResponse1.TallyParams.Threshold.String())
+		s.Require().Equal("0.334000000000000000", queryParamsResponse1.TallyParams.VetoThreshold.String())
+		s.Require().Equal("0s", queryParamsResponse1.VotingParams.VotingPeriod.String())
+		s.Require().Equal(0, len(queryParamsResponse1.DepositParams.MinDeposit))
+		s.Require().Equal("0s", queryParamsResponse1.DepositParams.MaxDepositPeriod.String())
 
-			s.Require().Equal("0.334000000000000000", tallyParams.Quorum.String())
-			s.Require().Equal("0.500000000000000000", tallyParams.Threshold.String())
-			s.Require().Equal("0.334000000000000000", tallyParams.VetoThreshold.String())
+		// only query voting params
+		govParamsMsg = types.GovParamsMsg{
+			ParamType: "voting",
+		}
 
-			// only query voting params
-			govParamsMsg = types.GovParamsMsg{
-				ParamType: "voting",
-			}
+		res2, err := s.xplac.GovParams(govParamsMsg).Query()
+		s.Require().NoError(err)
 
-			res2, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
-			s.Require().True(strings.Contains(res2, "172800000000000"))
+		var queryParamsResponse2 govtypes.QueryParamsResponse
+		jsonpb.Unmarshal(strings.NewReader(res2), &queryParamsResponse2)
 
-			// only query deposit params
-			govParamsMsg = types.GovParamsMsg{
-				ParamType: "deposit",
-			}
+		// can check voting
+		s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.Quorum.String())
+		s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.Threshold.String())
+		s.Require().Equal("0.000000000000000000", queryParamsResponse2.TallyParams.VetoThreshold.String())
+		s.Require().Equal("48h0m0s", queryParamsResponse2.VotingParams.VotingPeriod.String())
+		s.Require().Equal(0, len(queryParamsResponse2.DepositParams.MinDeposit))
+		s.Require().Equal("0s", queryParamsResponse2.DepositParams.MaxDepositPeriod.String())
 
-			res3, err := s.xplac.GovParams(govParamsMsg).Query()
-			s.Require().NoError(err)
+		// only query deposit params
+		govParamsMsg = types.GovParamsMsg{
+			ParamType: "deposit",
+		}
+
+		res3, err := s.xplac.GovParams(govParamsMsg).Query()
+		s.Require().NoError(err)
 
-			var depositParams govtypes.DepositParams
-			jsonpb.Unmarshal(strings.NewReader(res3), &depositParams)
+		var queryParamsResponse3 govtypes.QueryParamsResponse
+		jsonpb.Unmarshal(strings.NewReader(res3), &queryParamsResponse3)
 
-			s.Require().Equal("10000000", depositParams.MinDeposit[0].Amount.String())
-			s.Require().True(strings.Contains(res3, "172800000000000"))
+		// can check deposit
+		s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.Quorum.String())
+		s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.Threshold.String())
+		s.Require().Equal("0.000000000000000000", queryParamsResponse3.TallyParams.VetoThreshold.String())
+		s.Require().Equal("0s", queryParamsResponse3.VotingParams.VotingPeriod.String())
+		s.Require().Equal(types.XplaDenom, queryParamsResponse3.DepositParams.MinDeposit[0].Denom)
+		s.Require().Equal("10000000", queryParamsResponse3.DepositParams.MinDeposit[0].Amount.String())
+		s.Require().Equal("48h0m0s", queryParamsResponse3.DepositParams.MaxDepositPeriod.String())
 
+		if i == 1 {
 			// query all gov params (not support LCD)
-			res4, err := s.xplac.GovParams().Query()
+			res, err := s.xplac.GovParams().Query()
 			s.Require().NoError(err)
 
-			var queryParamsResponse4 govtypes.QueryParamsResponse
-			jsonpb.Unmarshal(strings.NewReader(res4), &queryParamsResponse4)
+			var queryParamsResponse govtypes.QueryParamsResponse
+			jsonpb.Unmarshal(strings.NewReader(res), &queryParamsResponse)
 
-			expectedResult := `{"voting_params":{"voting_period":172800000000000},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"axpla","amount":"10000000"}],"max_deposit_period":172800000000000}}`
-			s.Require().Equal(res4, expectedResult)
+			s.Require().Equal("0.334000000000000000", queryParamsResponse.TallyParams.Quorum.String())
+			s.Require().Equal("0.500000000000000000", queryParamsResponse.TallyParams.Threshold.String())
+			s.Require().Equal("0.334000000000000000", queryParamsResponse.TallyParams.VetoThreshold.String())
+			s.Require().Equal("48h0m0s", queryParamsResponse.VotingParams.VotingPeriod.String())
+			s.Require().Equal(types.XplaDenom, queryParamsResponse.DepositParams.MinDeposit[0].Denom)
+			s.Require().Equal("10000000", queryParamsResponse.DepositParams.MinDeposit[0].Amount.String())
+			s.Require().Equal("48h0m0s", queryParamsResponse.DepositParams.MaxDepositPeriod.String())
 		}
-
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
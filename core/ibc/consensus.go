@@ -0,0 +1,84 @@
+package ibc
+
+import (
+	"github.com/xpladev/xpla.go/types"
+
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// The light client of the channel which tracks the counterparty chain,
+// and the height and the timestamp of its latest consensus state.
+type channelClient struct {
+	clientId           string
+	clientState        exported.ClientState
+	height             ibcclient.Height
+	consensusTimestamp uint64
+}
+
+// Query the height and the timestamp of the latest consensus state of the counterparty chain
+// which is tracked by the light client of the channel.
+func (e IbcExternal) queryLatestConsensusState(portId, channelId string) (ibcclient.Height, uint64, error) {
+	client, err := e.queryChannelClient(portId, channelId)
+	if err != nil {
+		return ibcclient.Height{}, 0, err
+	}
+	return client.height, client.consensusTimestamp, nil
+}
+
+// Query the light client of the channel and its latest consensus state.
+func (e IbcExternal) queryChannelClient(portId, channelId string) (channelClient, error) {
+	clientStateMsg, err := MakeIbcChannelClientStateMsg(types.IbcChannelClientStateMsg{
+		ChannelId: channelId,
+		PortId:    portId,
+	})
+	if err != nil {
+		return channelClient{}, err
+	}
+
+	res, err := e.ToExternal(IbcChannelClientStateMsgType, clientStateMsg).QueryProto()
+	if err != nil {
+		return channelClient{}, err
+	}
+
+	identifiedClientState := res.(*ibcchannel.QueryChannelClientStateResponse).IdentifiedClientState
+	if identifiedClientState == nil {
+		return channelClient{}, types.ErrWrap(types.ErrNotFound, "client state of the channel", portId, channelId)
+	}
+
+	var clientState exported.ClientState
+	if err := e.Xplac.GetEncoding().InterfaceRegistry.UnpackAny(identifiedClientState.ClientState, &clientState); err != nil {
+		return channelClient{}, types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+
+	height, ok := clientState.GetLatestHeight().(ibcclient.Height)
+	if !ok {
+		return channelClient{}, types.ErrWrap(types.ErrConvert, "invalid height type of the client state")
+	}
+
+	consensusStateMsg, err := MakeIbcClientConsensusStateMsg(types.IbcClientConsensusStateMsg{
+		ClientId: identifiedClientState.ClientId,
+		Height:   height.String(),
+	})
+	if err != nil {
+		return channelClient{}, err
+	}
+
+	res, err = e.ToExternal(IbcClientConsensusStateMsgType, consensusStateMsg).QueryProto()
+	if err != nil {
+		return channelClient{}, err
+	}
+
+	var consensusState exported.ConsensusState
+	if err := e.Xplac.GetEncoding().InterfaceRegistry.UnpackAny(res.(*ibcclient.QueryConsensusStateResponse).ConsensusState, &consensusState); err != nil {
+		return channelClient{}, types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+
+	return channelClient{
+		clientId:           identifiedClientState.ClientId,
+		clientState:        clientState,
+		height:             height,
+		consensusTimestamp: consensusState.GetTimestamp(),
+	}, nil
+}
@@ -0,0 +1,214 @@
+package ibc
+
+import (
+	"math"
+	"strings"
+
+	mbank "github.com/xpladev/xpla.go/core/bank"
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+// Get the IBC denomination of the voucher by the path and the base denomination of the origin.
+// It is the same as the result of IbcDenomHash which is computed locally, e.g. "transfer/channel-0" and "uatom"
+// is ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2.
+// If the path is empty, the base denomination is the native one and it is returned as it is.
+func IbcDenom(path, baseDenom string) string {
+	return ibctransfer.DenomTrace{
+		Path:      path,
+		BaseDenom: baseDenom,
+	}.IBCDenom()
+}
+
+// Query balances of the address and resolve IBC vouchers to the path and the base denomination of the origin.
+// The display unit of the bank metadata is added to each balance if the metadata is registered.
+// Traces are kept in the denom trace cache of the xpla client, and the trace table is loaded at the first time.
+// If the cache is not set, the trace table is loaded for each query.
+func (e IbcExternal) ResolvedBalances(bankBalancesMsg types.BankBalancesMsg) (types.ResolvedBalancesResponse, error) {
+	var response types.ResolvedBalancesResponse
+
+	res, err := e.Xplac.BankBalances(bankBalancesMsg).QueryProto()
+	if err != nil {
+		return response, err
+	}
+
+	var coins sdk.Coins
+	switch res := res.(type) {
+	case *banktypes.QueryAllBalancesResponse:
+		coins = res.Balances
+		response.Pagination = res.Pagination
+	case *banktypes.QueryBalanceResponse:
+		if res.Balance != nil {
+			coins = sdk.Coins{*res.Balance}
+		}
+	}
+
+	metadatas, err := e.loadDenomsMetadata()
+	if err != nil {
+		return response, err
+	}
+
+	cache := e.Xplac.GetDenomTraceCache()
+	if cache == nil {
+		cache = util.NewDenomTraceCache()
+	}
+
+	response.Balances = []types.ResolvedBalance{}
+	for _, coin := range coins {
+		balance := types.ResolvedBalance{
+			Denom:     coin.Denom,
+			Amount:    coin.Amount.String(),
+			BaseDenom: coin.Denom,
+		}
+
+		if strings.HasPrefix(coin.Denom, ibctransfer.DenomPrefix+"/") {
+			trace, err := e.resolveDenomTrace(cache, coin.Denom)
+			if err != nil {
+				return response, err
+			}
+			balance.Path = trace.Path
+			balance.BaseDenom = trace.BaseDenom
+		}
+
+		if metadata, ok := metadatas[coin.Denom]; ok {
+			for _, unit := range metadata.DenomUnits {
+				if !isDisplayUnit(unit, metadata.Display) {
+					continue
+				}
+				if unit.Exponent > math.MaxUint8 {
+					return response, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidRequest, "exponent", unit.Exponent, "of the display unit", metadata.Display, "exceeds", math.MaxUint8))
+				}
+				balance.Display = metadata.Display
+				balance.Exponent = unit.Exponent
+				balance.DisplayAmount = util.FormatTokenAmount(coin.Amount.BigInt(), uint8(unit.Exponent))
+				break
+			}
+		}
+
+		response.Balances = append(response.Balances, balance)
+	}
+
+	return response, nil
+}
+
+// Load all pages of denomination metadata by the page request of its own,
+// so the pagination option of the xpla client only applies to balances.
+func (e IbcExternal) loadDenomsMetadata() (map[string]banktypes.Metadata, error) {
+	metadatas := make(map[string]banktypes.Metadata)
+
+	var nextKey []byte
+	for {
+		msg, err := mbank.MakeDenomsMetaDataMsg(&query.PageRequest{Key: nextKey})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := mbank.NewExternal(e.Xplac).ToExternal(mbank.BankDenomsMetadataMsgType, msg).QueryProto()
+		if err != nil {
+			return nil, err
+		}
+
+		metadataRes := res.(*banktypes.QueryDenomsMetadataResponse)
+		for _, metadata := range metadataRes.Metadatas {
+			metadatas[metadata.Base] = metadata
+		}
+
+		if metadataRes.Pagination == nil || len(metadataRes.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = metadataRes.Pagination.NextKey
+	}
+
+	return metadatas, nil
+}
+
+// The display of the metadata is the denomination or one of aliases of the unit.
+func isDisplayUnit(unit *banktypes.DenomUnit, display string) bool {
+	if unit.Denom == display {
+		return true
+	}
+	for _, alias := range unit.Aliases {
+		if alias == display {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the trace of the IBC denomination from the cache.
+// The voucher which is received after loading the trace table is queried and added to the cache.
+func (e IbcExternal) resolveDenomTrace(cache *util.DenomTraceCache, ibcDenom string) (util.DenomTrace, error) {
+	if !cache.Loaded() {
+		if err := e.loadDenomTraces(cache); err != nil {
+			return util.DenomTrace{}, err
+		}
+	}
+
+	if trace, ok := cache.DenomTrace(ibcDenom); ok {
+		return trace, nil
+	}
+
+	msg, err := MakeIbcTransferDenomTraceMsg(types.IbcDenomTraceMsg{
+		HashDenom: strings.TrimPrefix(ibcDenom, ibctransfer.DenomPrefix+"/"),
+	})
+	if err != nil {
+		return util.DenomTrace{}, err
+	}
+
+	res, err := e.ToExternal(IbcTransferDenomTraceMsgType, msg).QueryProto()
+	if err != nil {
+		return util.DenomTrace{}, err
+	}
+
+	denomTrace := res.(*ibctransfer.QueryDenomTraceResponse).DenomTrace
+	if denomTrace == nil || denomTrace.IBCDenom() != ibcDenom {
+		return util.DenomTrace{}, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "denom trace of", ibcDenom))
+	}
+
+	trace := util.DenomTrace{
+		Path:      denomTrace.Path,
+		BaseDenom: denomTrace.BaseDenom,
+	}
+	cache.AddDenomTrace(ibcDenom, trace)
+
+	return trace, nil
+}
+
+// Load all pages of the trace table of the chain to the cache.
+func (e IbcExternal) loadDenomTraces(cache *util.DenomTraceCache) error {
+	traces := make(map[string]util.DenomTrace)
+
+	var nextKey []byte
+	for {
+		msg, err := MakeIbcTransferDenomTracesMsg(&query.PageRequest{Key: nextKey})
+		if err != nil {
+			return err
+		}
+
+		res, err := e.ToExternal(IbcTransferDenomTracesMsgType, msg).QueryProto()
+		if err != nil {
+			return err
+		}
+
+		denomTracesRes := res.(*ibctransfer.QueryDenomTracesResponse)
+		for _, denomTrace := range denomTracesRes.DenomTraces {
+			traces[denomTrace.IBCDenom()] = util.DenomTrace{
+				Path:      denomTrace.Path,
+				BaseDenom: denomTrace.BaseDenom,
+			}
+		}
+
+		if denomTracesRes.Pagination == nil || len(denomTracesRes.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = denomTracesRes.Pagination.NextKey
+	}
+
+	cache.LoadDenomTraces(traces)
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/provider"
 	"github.com/xpladev/xpla.go/types"
+
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 )
 
 var _ core.External = &IbcExternal{}
@@ -35,11 +37,34 @@ func (e IbcExternal) Err(msgType string, err error) provider.XplaClient {
 		)
 }
 
+// Tx
+
+// Transfer a fungible token through IBC.
+// If timeouts are relative, the latest consensus state of the counterparty chain is queried by the source channel.
+func (e IbcExternal) IbcTransfer(ibcTransferMsg types.IbcTransferMsg) provider.XplaClient {
+	var consensusHeight ibcclient.Height
+	var consensusTimestamp uint64
+	if !ibcTransferMsg.AbsoluteTimeouts {
+		var err error
+		consensusHeight, consensusTimestamp, err = e.queryLatestConsensusState(ibcTransferMsg.SourcePort, ibcTransferMsg.SourceChannel)
+		if err != nil {
+			return e.Err(IbcTransferMsgType, err)
+		}
+	}
+
+	msg, err := MakeIbcTransferMsg(ibcTransferMsg, e.Xplac.GetFromAddress(), consensusHeight, consensusTimestamp)
+	if err != nil {
+		return e.Err(IbcTransferMsgType, err)
+	}
+
+	return e.ToExternal(IbcTransferMsgType, msg)
+}
+
 // Query
 
 // Query IBC light client states
 func (e IbcExternal) IbcClientStates() provider.XplaClient {
-	msg, err := MakeIbcClientStatesMsg()
+	msg, err := MakeIbcClientStatesMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(IbcClientStatesMsgType, err)
 	}
@@ -69,7 +94,7 @@ func (e IbcExternal) IbcClientStatus(ibcClientStatusMsg types.IbcClientStatusMsg
 
 // Query IBC client consensus states
 func (e IbcExternal) IbcClientConsensusStates(ibcClientConsensusStatesMsg types.IbcClientConsensusStatesMsg) provider.XplaClient {
-	msg, err := MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg)
+	msg, err := MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(IbcClientConsensusStatesMsgType, err)
 	}
@@ -79,7 +104,7 @@ func (e IbcExternal) IbcClientConsensusStates(ibcClientConsensusStatesMsg types.
 
 // Query IBC client consensus state heights
 func (e IbcExternal) IbcClientConsensusStateHeights(ibcClientConsensusStateHeightsMsg types.IbcClientConsensusStateHeightsMsg) provider.XplaClient {
-	msg, err := MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg)
+	msg, err := MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(IbcClientConsensusStateHeightsMsgType, err)
 	}
@@ -131,7 +156,7 @@ func (e IbcExternal) IbcClientParams() provider.XplaClient {
 func (e IbcExternal) IbcConnections(ibcConnectionMsg ...types.IbcConnectionMsg) provider.XplaClient {
 	switch {
 	case len(ibcConnectionMsg) == 0:
-		msg, err := MakeIbcConnectionConnectionsMsg()
+		msg, err := MakeIbcConnectionConnectionsMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(IbcConnectionConnectionsMsgType, err)
 		}
@@ -165,7 +190,7 @@ func (e IbcExternal) IbcClientConnections(ibcClientConnectionsMsg types.IbcClien
 func (e IbcExternal) IbcChannels(ibcChannelMsg ...types.IbcChannelMsg) provider.XplaClient {
 	switch {
 	case len(ibcChannelMsg) == 0:
-		msg, err := MakeIbcChannelChannelsMsg()
+		msg, err := MakeIbcChannelChannelsMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(IbcChannelChannelsMsgType, err)
 		}
@@ -187,7 +212,7 @@ func (e IbcExternal) IbcChannels(ibcChannelMsg ...types.IbcChannelMsg) provider.
 
 // Query IBC channel connections
 func (e IbcExternal) IbcChannelConnections(ibcChannelConnectionsMsg types.IbcChannelConnectionsMsg) provider.XplaClient {
-	msg, err := MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg)
+	msg, err := MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(IbcChannelConnectionsMsgType, err)
 	}
@@ -209,7 +234,7 @@ func (e IbcExternal) IbcChannelClientState(ibcChannelClientStateMsg types.IbcCha
 func (e IbcExternal) IbcChannelPacketCommitments(ibcChannelPacketCommitmentsMsg types.IbcChannelPacketCommitmentsMsg) provider.XplaClient {
 	switch {
 	case ibcChannelPacketCommitmentsMsg.Sequence == "":
-		msg, err := MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg)
+		msg, err := MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(IbcChannelPacketCommitmentsMsgType, err)
 		}
@@ -280,7 +305,7 @@ func (e IbcExternal) IbcChannelNextSequence(ibcChannelNextSequenceMsg types.IbcC
 func (e IbcExternal) IbcDenomTraces(ibcDenomTraceMsg ...types.IbcDenomTraceMsg) provider.XplaClient {
 	switch {
 	case len(ibcDenomTraceMsg) == 0:
-		msg, err := MakeIbcTransferDenomTracesMsg()
+		msg, err := MakeIbcTransferDenomTracesMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(IbcTransferDenomTracesMsgType, err)
 		}
@@ -339,3 +364,33 @@ func (e IbcExternal) IbcTransferParams() provider.XplaClient {
 
 	return e.ToExternal(IbcTransferParamsMsgType, msg)
 }
+
+// Query the interchain account of the owner on the host chain of the connection
+func (e IbcExternal) IbcInterchainAccount(ibcInterchainAccountMsg types.IbcInterchainAccountMsg) provider.XplaClient {
+	msg, err := MakeIbcIcaControllerAccountMsg(ibcInterchainAccountMsg)
+	if err != nil {
+		return e.Err(IbcIcaControllerAccountMsgType, err)
+	}
+
+	return e.ToExternal(IbcIcaControllerAccountMsgType, msg)
+}
+
+// Query IBC interchain accounts controller params
+func (e IbcExternal) IbcIcaControllerParams() provider.XplaClient {
+	msg, err := MakeIbcIcaControllerParamsMsg()
+	if err != nil {
+		return e.Err(IbcIcaControllerParamsMsgType, err)
+	}
+
+	return e.ToExternal(IbcIcaControllerParamsMsgType, msg)
+}
+
+// Query IBC interchain accounts host params
+func (e IbcExternal) IbcIcaHostParams() provider.XplaClient {
+	msg, err := MakeIbcIcaHostParamsMsg()
+	if err != nil {
+		return e.Err(IbcIcaHostParamsMsgType, err)
+	}
+
+	return e.ToExternal(IbcIcaHostParamsMsgType, msg)
+}
@@ -1,8 +1,24 @@
 package ibc_test
 
 import (
+	"testing"
+	"time"
+
+	"github.com/xpladev/xpla.go/client"
 	mibc "github.com/xpladev/xpla.go/core/ibc"
 	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+	"github.com/xpladev/xpla.go/util/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icacontroller "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -12,11 +28,334 @@ var (
 	testIbcChannelPortId = "transfer"
 )
 
+func (s *IntegrationTestSuite) TestIbcTx() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(account0.PrivKey).
+		WithAccountNumber("0").
+		WithSequence("0")
+
+	// transfer with absolute timeouts
+	ibcTransferMsg := types.IbcTransferMsg{
+		SourcePort:       testIbcChannelPortId,
+		SourceChannel:    testIbcChannelID,
+		Receiver:         s.network.Validators[1].Address.String(),
+		Amount:           "1000axpla",
+		TimeoutHeight:    "1-1000",
+		TimeoutTimestamp: "0",
+		AbsoluteTimeouts: true,
+		Memo:             "test memo",
+	}
+	xplac.IbcTransfer(ibcTransferMsg)
+
+	makeIbcTransferMsg, err := mibc.MakeIbcTransferMsg(ibcTransferMsg, account0.Address, ibcclient.Height{}, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(ibcclient.NewHeight(1, 1000), makeIbcTransferMsg.TimeoutHeight)
+	s.Require().Equal("test memo", makeIbcTransferMsg.Memo)
+
+	s.Require().Equal(makeIbcTransferMsg, xplac.GetMsg())
+	s.Require().Equal(mibc.IbcModule, xplac.GetModule())
+	s.Require().Equal(mibc.IbcTransferMsgType, xplac.GetMsgType())
+
+	_, err = xplac.IbcTransfer(ibcTransferMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	// the denom trace is converted to the IBC denom
+	ibcTransferMsg.Amount = "1000transfer/channel-0/uatom"
+	makeIbcTransferMsg, err = mibc.MakeIbcTransferMsg(ibcTransferMsg, account0.Address, ibcclient.Height{}, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(ibctransfer.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(), makeIbcTransferMsg.Token.Denom)
+
+	// relative timeouts are added to the latest consensus state of the counterparty chain
+	ibcTransferMsg.AbsoluteTimeouts = false
+	ibcTransferMsg.TimeoutTimestamp = ""
+	consensusTimestamp := uint64(time.Now().Add(time.Hour).UnixNano())
+	makeIbcTransferMsg, err = mibc.MakeIbcTransferMsg(ibcTransferMsg, account0.Address, ibcclient.NewHeight(1, 100), consensusTimestamp)
+	s.Require().NoError(err)
+	s.Require().Equal(ibcclient.NewHeight(2, 1100), makeIbcTransferMsg.TimeoutHeight)
+	s.Require().Equal(consensusTimestamp+ibctransfer.DefaultRelativePacketTimeoutTimestamp, makeIbcTransferMsg.TimeoutTimestamp)
+
+	// the channel which does not exist has not the consensus state
+	xplac = xplac.WithURL(s.apis[0]).IbcTransfer(ibcTransferMsg)
+	s.Require().Error(xplac.GetErr())
+
+	_, err = mibc.MakeIbcTransferMsg(types.IbcTransferMsg{SourcePort: testIbcChannelPortId}, account0.Address, ibcclient.Height{}, 0)
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestIbcPacket() {
+	txResponse := &sdk.TxResponse{
+		TxHash: "B6BBBB649F19E8970EF274C0083FE945FD38AD8C524D68BB3FE3A20D72DF03C4",
+		Logs: sdk.ABCIMessageLogs{
+			sdk.NewABCIMessageLog(0, "", sdk.Events{
+				sdk.NewEvent(
+					ibcchannel.EventTypeSendPacket,
+					sdk.NewAttribute(ibcchannel.AttributeKeyTimeoutHeight, "1-1000"),
+					sdk.NewAttribute(ibcchannel.AttributeKeyTimeoutTimestamp, "1700000000000000000"),
+					sdk.NewAttribute(ibcchannel.AttributeKeySequence, "7"),
+					sdk.NewAttribute(ibcchannel.AttributeKeySrcPort, testIbcChannelPortId),
+					sdk.NewAttribute(ibcchannel.AttributeKeySrcChannel, testIbcChannelID),
+					sdk.NewAttribute(ibcchannel.AttributeKeyDstPort, testIbcChannelPortId),
+					sdk.NewAttribute(ibcchannel.AttributeKeyDstChannel, "channel-5"),
+				),
+			}),
+		},
+	}
+
+	packets, err := mibc.IbcPacketsFromTx(txResponse)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.IbcPacket{{
+		Sequence:           7,
+		SourcePort:         testIbcChannelPortId,
+		SourceChannel:      testIbcChannelID,
+		DestinationPort:    testIbcChannelPortId,
+		DestinationChannel: "channel-5",
+		TimeoutHeight:      "1-1000",
+		TimeoutTimestamp:   1700000000000000000,
+	}}, packets)
+
+	_, err = mibc.IbcPacketsFromTx(&sdk.TxResponse{TxHash: txResponse.TxHash})
+	s.Require().Error(err)
+
+	// the channel does not exist on the counterparty chain
+	xplac := client.NewXplaClient(testutil.TestChainId).WithURL(s.apis[0])
+	counterparty := client.NewXplaClient(testutil.TestChainId).WithGrpc(s.apis[1])
+
+	_, err = xplac.IbcPacketStatus(packets[0], counterparty)
+	s.Require().Error(err)
+
+	var states []types.IbcPacketState
+	_, err = xplac.TrackIbcPacket(packets[0], counterparty, func(status types.IbcPacketStatus) {
+		states = append(states, status.State)
+	})
+	s.Require().Error(err)
+	s.Require().Empty(states)
+}
+
+func TestResolveIbcPacketState(t *testing.T) {
+	testCases := []struct {
+		name      string
+		committed bool
+		received  bool
+		expected  types.IbcPacketState
+	}{
+		{"sent but not received", true, false, types.IbcPacketPending},
+		{"received but not acknowledged", true, true, types.IbcPacketReceived},
+		{"acknowledged", false, true, types.IbcPacketAcknowledged},
+		{"not received until the timeout", false, false, types.IbcPacketTimedOut},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, mibc.ResolveIbcPacketState(tc.committed, tc.received))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestResolvedBalances() {
+	s.Require().Equal(
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		mibc.IbcDenom("transfer/channel-0", "uatom"),
+	)
+	s.Require().Equal("uatom", mibc.IbcDenom("", "uatom"))
+
+	denomTraceCache := util.NewDenomTraceCache()
+	addr := s.network.Validators[0].Address.String()
+
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId).WithDenomTraceCache(denomTraceCache)
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		res, err := xplac.ResolvedBalances(types.BankBalancesMsg{
+			Address: addr,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ResolvedBalance{
+			{
+				Denom:         "axpla",
+				Amount:        "400000000000000000000",
+				BaseDenom:     "axpla",
+				Display:       "XPLA",
+				Exponent:      18,
+				DisplayAmount: "400",
+			},
+			// the display of the metadata does not match any unit
+			{
+				Denom:     "node0token",
+				Amount:    "1000000000000000000000",
+				BaseDenom: "node0token",
+			},
+		}, res.Balances)
+	}
+
+	// metadata of the node1 token is after the first page of the default limit
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId)
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		res, err := xplac.ResolvedBalances(types.BankBalancesMsg{
+			Address: s.network.Validators[1].Address.String(),
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Balances, 2)
+		s.Require().Equal("node1token", res.Balances[1].Denom)
+		s.Require().Equal("NODE1", res.Balances[1].Display)
+		s.Require().Equal(uint32(18), res.Balances[1].Exponent)
+		s.Require().Equal("1000", res.Balances[1].DisplayAmount)
+
+		// the exponent which exceeds uint8 is rejected instead of truncated
+		_, err = xplac.ResolvedBalances(types.BankBalancesMsg{
+			Address: testLargeExponentAddress,
+		})
+		s.Require().ErrorContains(err, "exponent 256")
+	}
+
+	// native denominations do not load the trace table
+	s.Require().False(denomTraceCache.Loaded())
+}
+
+func (s *IntegrationTestSuite) TestIbcChannelHealth() {
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId)
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		// the loopback channels connect the test network to itself
+		counterparty := client.NewXplaClient(testutil.TestChainId).WithGrpc(s.apis[1])
+		reports, err := xplac.IbcChannelHealthReport(counterparty)
+		s.Require().NoError(err)
+		s.Require().Len(reports, 4)
+
+		loopback := reports[0]
+		s.Require().Equal(testLoopbackChannelId, loopback.ChannelId)
+		s.Require().Equal(testLoopbackPeerId, loopback.CounterpartyChannelId)
+		s.Require().Equal("connection-0", loopback.ConnectionId)
+		s.Require().Equal("07-tendermint-0", loopback.ClientId)
+		s.Require().Equal(testutil.TestChainId, loopback.CounterpartyChainId)
+		s.Require().Equal("Active", loopback.ClientStatus)
+		s.Require().Equal(2*time.Hour, loopback.TrustingPeriod)
+		s.Require().True(loopback.ExpiresIn > 0 && loopback.ExpiresIn < time.Hour)
+		s.Require().True(loopback.CounterpartyQueried)
+		s.Require().Equal(uint64(1), loopback.NextSequenceReceive)
+		s.Require().Equal(uint64(1), loopback.CounterpartyNextSequenceReceive)
+		s.Require().Equal(types.IbcPacketCounts{
+			Pending:        uint64(testSentPackets),
+			Unreceived:     uint64(testSentPackets - testReceivedPackets),
+			UnreceivedAcks: uint64(testReceivedPackets),
+		}, loopback.Sent)
+		s.Require().Equal(types.IbcPacketCounts{Pending: 1, Unreceived: 1}, loopback.Received)
+		s.Require().Empty(loopback.Error)
+
+		peer := reports[1]
+		s.Require().Equal(testLoopbackPeerId, peer.ChannelId)
+		s.Require().Equal(loopback.Received, peer.Sent)
+		s.Require().Equal(loopback.Sent, peer.Received)
+		s.Require().Empty(peer.Error)
+
+		// the client of the stale channel is expired, and its counterparty is not given
+		stale := reports[2]
+		s.Require().Equal(testStaleChannelId, stale.ChannelId)
+		s.Require().Equal("07-tendermint-1", stale.ClientId)
+		s.Require().Equal(testStaleChainId, stale.CounterpartyChainId)
+		s.Require().Equal("Expired", stale.ClientStatus)
+		s.Require().True(stale.ExpiresIn < 0)
+		s.Require().False(stale.CounterpartyQueried)
+		s.Require().Empty(stale.Error)
+
+		// the broken channel is reported with the error
+		broken := reports[3]
+		s.Require().Equal(testBrokenChannelId, broken.ChannelId)
+		s.Require().Equal("connection-9", broken.ConnectionId)
+		s.Require().NotEmpty(broken.Error)
+
+		health, err := xplac.IbcChannelHealth(types.IbcChannelMsg{
+			PortId:    testIbcChannelPortId,
+			ChannelId: testLoopbackChannelId,
+		}, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(testSentPackets), health.Sent.Pending)
+		s.Require().False(health.CounterpartyQueried)
+
+		_, err = xplac.IbcChannelHealth(types.IbcChannelMsg{
+			PortId:    testIbcChannelPortId,
+			ChannelId: testBrokenChannelId,
+		}, nil)
+		s.Require().Error(err)
+
+		_, err = xplac.IbcChannelHealth(types.IbcChannelMsg{
+			PortId:    testIbcChannelPortId,
+			ChannelId: testIbcChannelID,
+		}, nil)
+		s.Require().Error(err)
+	}
+}
+
+func (s *IntegrationTestSuite) TestIbcIca() {
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId)
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		res, err := xplac.IbcIcaControllerParams().QueryProto()
+		s.Require().NoError(err)
+		s.Require().NotNil(res.(*icacontroller.QueryParamsResponse).Params)
+
+		res, err = xplac.IbcIcaHostParams().QueryProto()
+		s.Require().NoError(err)
+		s.Require().NotNil(res.(*icahost.QueryParamsResponse).Params)
+
+		// no interchain account is registered
+		_, err = xplac.IbcInterchainAccount(types.IbcInterchainAccountMsg{
+			Owner:        s.network.Validators[0].Address.String(),
+			ConnectionId: testIbcConnectionID,
+		}).QueryProto()
+		s.Require().Error(err)
+	}
+
+	_, err := client.NewXplaClient(testutil.TestChainId).IbcInterchainAccount(types.IbcInterchainAccountMsg{
+		ConnectionId: testIbcConnectionID,
+	}).QueryProto()
+	s.Require().Error(err)
+
+	// packet data
+	cdc := s.xplac.GetEncoding().Codec
+	msg := banktypes.NewMsgSend(
+		s.network.Validators[0].Address,
+		s.network.Validators[0].Address,
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)),
+	)
+	packetData, err := mibc.IcaPacketData(cdc, []sdk.Msg{msg}, "memo")
+	s.Require().NoError(err)
+	s.Require().Equal(icatypes.EXECUTE_TX, packetData.Type)
+	s.Require().Equal("memo", packetData.Memo)
+
+	msgs, err := icatypes.DeserializeCosmosTx(cdc, packetData.Data)
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Msg{msg}, msgs)
+
+	_, err = mibc.IcaPacketData(cdc, nil, "")
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestIBC() {
 	// client states
 	s.xplac.IbcClientStates()
 
-	makeIbcClientStatesMsg, err := mibc.MakeIbcClientStatesMsg()
+	makeIbcClientStatesMsg, err := mibc.MakeIbcClientStatesMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcClientStatesMsg, s.xplac.GetMsg())
@@ -55,7 +394,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	}
 	s.xplac.IbcClientConsensusStates(ibcClientConsensusStatesMsg)
 
-	makeIbcClientConsensusStatesMsg, err := mibc.MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg)
+	makeIbcClientConsensusStatesMsg, err := mibc.MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcClientConsensusStatesMsg, s.xplac.GetMsg())
@@ -68,7 +407,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	}
 	s.xplac.IbcClientConsensusStateHeights(ibcClientConsensusStateHeightsMsg)
 
-	makeIbcClientConsensusStateHeightsMsg, err := mibc.MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg)
+	makeIbcClientConsensusStateHeightsMsg, err := mibc.MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcClientConsensusStateHeightsMsg, s.xplac.GetMsg())
@@ -103,7 +442,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	// connections
 	s.xplac.IbcConnections()
 
-	makeIbcConnectionConnectionsMsg, err := mibc.MakeIbcConnectionConnectionsMsg()
+	makeIbcConnectionConnectionsMsg, err := mibc.MakeIbcConnectionConnectionsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcConnectionConnectionsMsg, s.xplac.GetMsg())
@@ -139,7 +478,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	// channels
 	s.xplac.IbcChannels()
 
-	makeIbcChannelChannelsMsg, err := mibc.MakeIbcChannelChannelsMsg()
+	makeIbcChannelChannelsMsg, err := mibc.MakeIbcChannelChannelsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcChannelChannelsMsg, s.xplac.GetMsg())
@@ -166,7 +505,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	}
 	s.xplac.IbcChannelConnections(ibcChannelConnectionsMsg)
 
-	makeIbcChannelConnectionsMsg, err := mibc.MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg)
+	makeIbcChannelConnectionsMsg, err := mibc.MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcChannelConnectionsMsg, s.xplac.GetMsg())
@@ -194,7 +533,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	}
 	s.xplac.IbcChannelPacketCommitments(ibcChannelPacketCommitmentsMsg)
 
-	makeIbcChannelPacketCommitmentsMsg, err := mibc.MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg)
+	makeIbcChannelPacketCommitmentsMsg, err := mibc.MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcChannelPacketCommitmentsMsg, s.xplac.GetMsg())
@@ -258,6 +597,16 @@ func (s *IntegrationTestSuite) TestIBC() {
 	s.Require().Equal(mibc.IbcModule, s.xplac.GetModule())
 	s.Require().Equal(mibc.IbcChannelUnreceivedPacketsMsgType, s.xplac.GetMsgType())
 
+	// unreceived packets of several sequences
+	ibcChannelUnreceivedPacketsMsg.Sequence = "1, 2,3"
+	makeIbcChannelPacketUnreceivedPacketsMsg, err = mibc.MakeIbcChannelPacketUnreceivedPacketsMsg(ibcChannelUnreceivedPacketsMsg)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2, 3}, makeIbcChannelPacketUnreceivedPacketsMsg.PacketCommitmentSequences)
+
+	ibcChannelUnreceivedPacketsMsg.Sequence = "1,a"
+	_, err = mibc.MakeIbcChannelPacketUnreceivedPacketsMsg(ibcChannelUnreceivedPacketsMsg)
+	s.Require().Error(err)
+
 	// unreceived acks
 	ibcChannelUnreceivedAcksMsg := types.IbcChannelUnreceivedAcksMsg{
 		ChannelId: testIbcChannelID,
@@ -289,7 +638,7 @@ func (s *IntegrationTestSuite) TestIBC() {
 	// denom traces
 	s.xplac.IbcDenomTraces()
 
-	makeIbcTransferDenomTracesMsg, err := mibc.MakeIbcTransferDenomTracesMsg()
+	makeIbcTransferDenomTracesMsg, err := mibc.MakeIbcTransferDenomTracesMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeIbcTransferDenomTracesMsg, s.xplac.GetMsg())
@@ -358,4 +707,38 @@ func (s *IntegrationTestSuite) TestIBC() {
 	s.Require().Equal(makeIbcTransferParamsMsg, s.xplac.GetMsg())
 	s.Require().Equal(mibc.IbcModule, s.xplac.GetModule())
 	s.Require().Equal(mibc.IbcTransferParamsMsgType, s.xplac.GetMsgType())
+
+	// interchain account
+	ibcInterchainAccountMsg := types.IbcInterchainAccountMsg{
+		Owner:        s.network.Validators[0].Address.String(),
+		ConnectionId: testIbcConnectionID,
+	}
+	s.xplac.IbcInterchainAccount(ibcInterchainAccountMsg)
+
+	makeIbcIcaControllerAccountMsg, err := mibc.MakeIbcIcaControllerAccountMsg(ibcInterchainAccountMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeIbcIcaControllerAccountMsg, s.xplac.GetMsg())
+	s.Require().Equal(mibc.IbcModule, s.xplac.GetModule())
+	s.Require().Equal(mibc.IbcIcaControllerAccountMsgType, s.xplac.GetMsgType())
+
+	// interchain accounts controller params
+	s.xplac.IbcIcaControllerParams()
+
+	makeIbcIcaControllerParamsMsg, err := mibc.MakeIbcIcaControllerParamsMsg()
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeIbcIcaControllerParamsMsg, s.xplac.GetMsg())
+	s.Require().Equal(mibc.IbcModule, s.xplac.GetModule())
+	s.Require().Equal(mibc.IbcIcaControllerParamsMsgType, s.xplac.GetMsgType())
+
+	// interchain accounts host params
+	s.xplac.IbcIcaHostParams()
+
+	makeIbcIcaHostParamsMsg, err := mibc.MakeIbcIcaHostParamsMsg()
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeIbcIcaHostParamsMsg, s.xplac.GetMsg())
+	s.Require().Equal(mibc.IbcModule, s.xplac.GetModule())
+	s.Require().Equal(mibc.IbcIcaHostParamsMsgType, s.xplac.GetMsgType())
 }
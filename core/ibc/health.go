@@ -0,0 +1,277 @@
+package ibc
+
+import (
+	"time"
+
+	"github.com/xpladev/xpla.go/provider"
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcconnection "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+)
+
+// The maximum number of sequences in a query of unreceived packets, which keeps the request path short.
+const unreceivedPacketsBatchSize = 100
+
+// Report the health of all open channels of the chain.
+// Counterparties are xpla clients of counterparty chains, and they are matched with channels
+// by the chain ID of the light client. Channels whose counterparty is not given are reported
+// without packets of the counterparty chain.
+// A channel which fails to be queried is reported with the error, and the other channels are still reported.
+func (e IbcExternal) IbcChannelHealthReport(counterparties ...provider.XplaClient) ([]types.IbcChannelHealth, error) {
+	channels, err := e.queryAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyByChainId := make(map[string]provider.XplaClient)
+	for _, counterparty := range counterparties {
+		counterpartyByChainId[counterparty.GetChainId()] = counterparty
+	}
+
+	reports := []types.IbcChannelHealth{}
+	for _, channel := range channels {
+		if channel.State != ibcchannel.OPEN {
+			continue
+		}
+
+		health, err := e.ibcChannelHealth(*channel, func(chainId string) provider.XplaClient {
+			return counterpartyByChainId[chainId]
+		})
+		if err != nil {
+			health.Error = err.Error()
+		}
+		reports = append(reports, health)
+	}
+
+	return reports, nil
+}
+
+// Report the health of the channel.
+// The counterparty is the xpla client of the counterparty chain, and it can be nil.
+func (e IbcExternal) IbcChannelHealth(ibcChannelMsg types.IbcChannelMsg, counterparty provider.XplaClient) (types.IbcChannelHealth, error) {
+	msg, err := MakeIbcChannelChannelMsg(ibcChannelMsg)
+	if err != nil {
+		return types.IbcChannelHealth{}, e.Xplac.GetLogger().Err(err)
+	}
+
+	res, err := e.ToExternal(IbcChannelChannelMsgType, msg).QueryProto()
+	if err != nil {
+		return types.IbcChannelHealth{}, err
+	}
+
+	channel := res.(*ibcchannel.QueryChannelResponse).Channel
+	if channel == nil {
+		return types.IbcChannelHealth{}, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "channel", ibcChannelMsg.PortId, ibcChannelMsg.ChannelId))
+	}
+
+	return e.ibcChannelHealth(ibcchannel.NewIdentifiedChannel(ibcChannelMsg.PortId, ibcChannelMsg.ChannelId, *channel), func(string) provider.XplaClient {
+		return counterparty
+	})
+}
+
+func (e IbcExternal) ibcChannelHealth(channel ibcchannel.IdentifiedChannel, counterpartyOf func(string) provider.XplaClient) (types.IbcChannelHealth, error) {
+	health := types.IbcChannelHealth{
+		PortId:                channel.PortId,
+		ChannelId:             channel.ChannelId,
+		State:                 channel.State.String(),
+		Ordering:              channel.Ordering.String(),
+		Version:               channel.Version,
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+	}
+
+	if len(channel.ConnectionHops) == 0 {
+		return health, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "connection of the channel", channel.PortId, channel.ChannelId))
+	}
+	health.ConnectionId = channel.ConnectionHops[0]
+
+	connectionMsg, err := MakeIbcConnectionConnectionMsg(types.IbcConnectionMsg{
+		ConnectionId: health.ConnectionId,
+	})
+	if err != nil {
+		return health, e.Xplac.GetLogger().Err(err)
+	}
+	res, err := e.ToExternal(IbcConnectionConnectionMsgType, connectionMsg).QueryProto()
+	if err != nil {
+		return health, err
+	}
+	if connection := res.(*ibcconnection.QueryConnectionResponse).Connection; connection != nil {
+		health.CounterpartyConnectionId = connection.Counterparty.ConnectionId
+		health.CounterpartyClientId = connection.Counterparty.ClientId
+	}
+
+	client, err := e.queryChannelClient(channel.PortId, channel.ChannelId)
+	if err != nil {
+		return health, err
+	}
+	health.ClientId = client.clientId
+	health.LatestHeight = client.height.String()
+	health.LastUpdateTime = time.Unix(0, int64(client.consensusTimestamp)).UTC()
+	health.SinceLastUpdate = time.Since(health.LastUpdateTime)
+	if clientState, ok := client.clientState.(*ibctm.ClientState); ok {
+		health.CounterpartyChainId = clientState.ChainId
+		health.TrustingPeriod = clientState.TrustingPeriod
+		health.ExpiresIn = clientState.TrustingPeriod - health.SinceLastUpdate
+	}
+
+	statusMsg, err := MakeIbcClientStatusMsg(types.IbcClientStatusMsg{
+		ClientId: client.clientId,
+	})
+	if err != nil {
+		return health, e.Xplac.GetLogger().Err(err)
+	}
+	res, err = e.ToExternal(IbcClientStatusMsgType, statusMsg).QueryProto()
+	if err != nil {
+		return health, err
+	}
+	health.ClientStatus = res.(*ibcclient.QueryClientStatusResponse).Status
+
+	health.NextSequenceReceive, err = e.queryNextSequenceReceive(channel.PortId, channel.ChannelId)
+	if err != nil {
+		return health, err
+	}
+
+	sent, err := e.queryPacketCommitmentSequences(channel.PortId, channel.ChannelId)
+	if err != nil {
+		return health, err
+	}
+	health.Sent.Pending = uint64(len(sent))
+
+	counterparty := counterpartyOf(health.CounterpartyChainId)
+	if counterparty == nil {
+		return health, nil
+	}
+	c := NewExternal(counterparty)
+
+	health.CounterpartyNextSequenceReceive, err = c.queryNextSequenceReceive(health.CounterpartyPortId, health.CounterpartyChannelId)
+	if err != nil {
+		return health, err
+	}
+
+	unreceived, err := c.queryUnreceivedPackets(health.CounterpartyPortId, health.CounterpartyChannelId, sent)
+	if err != nil {
+		return health, err
+	}
+	health.Sent.Unreceived = uint64(len(unreceived))
+	health.Sent.UnreceivedAcks = health.Sent.Pending - health.Sent.Unreceived
+
+	received, err := c.queryPacketCommitmentSequences(health.CounterpartyPortId, health.CounterpartyChannelId)
+	if err != nil {
+		return health, err
+	}
+	unreceived, err = e.queryUnreceivedPackets(channel.PortId, channel.ChannelId, received)
+	if err != nil {
+		return health, err
+	}
+	health.Received.Pending = uint64(len(received))
+	health.Received.Unreceived = uint64(len(unreceived))
+	health.Received.UnreceivedAcks = health.Received.Pending - health.Received.Unreceived
+
+	health.CounterpartyQueried = true
+	return health, nil
+}
+
+// Query all pages of channels of the chain.
+func (e IbcExternal) queryAllChannels() ([]*ibcchannel.IdentifiedChannel, error) {
+	var channels []*ibcchannel.IdentifiedChannel
+
+	var nextKey []byte
+	for {
+		msg, err := MakeIbcChannelChannelsMsg(&query.PageRequest{Key: nextKey})
+		if err != nil {
+			return nil, e.Xplac.GetLogger().Err(err)
+		}
+
+		res, err := e.ToExternal(IbcChannelChannelsMsgType, msg).QueryProto()
+		if err != nil {
+			return nil, err
+		}
+
+		channelsRes := res.(*ibcchannel.QueryChannelsResponse)
+		channels = append(channels, channelsRes.Channels...)
+
+		if channelsRes.Pagination == nil || len(channelsRes.Pagination.NextKey) == 0 {
+			return channels, nil
+		}
+		nextKey = channelsRes.Pagination.NextKey
+	}
+}
+
+// Query sequences of all packets which are committed on the chain, i.e. sent and not acknowledged or timed out yet.
+func (e IbcExternal) queryPacketCommitmentSequences(portId, channelId string) ([]uint64, error) {
+	var sequences []uint64
+
+	var nextKey []byte
+	for {
+		msg, err := MakeIbcChannelPacketCommitmentsMsg(types.IbcChannelPacketCommitmentsMsg{
+			ChannelId: channelId,
+			PortId:    portId,
+		}, &query.PageRequest{Key: nextKey})
+		if err != nil {
+			return nil, e.Xplac.GetLogger().Err(err)
+		}
+
+		res, err := e.ToExternal(IbcChannelPacketCommitmentsMsgType, msg).QueryProto()
+		if err != nil {
+			return nil, err
+		}
+
+		commitmentsRes := res.(*ibcchannel.QueryPacketCommitmentsResponse)
+		for _, commitment := range commitmentsRes.Commitments {
+			sequences = append(sequences, commitment.Sequence)
+		}
+
+		if commitmentsRes.Pagination == nil || len(commitmentsRes.Pagination.NextKey) == 0 {
+			return sequences, nil
+		}
+		nextKey = commitmentsRes.Pagination.NextKey
+	}
+}
+
+// Query sequences of packets which are not received by the chain among given sequences.
+// Sequences are queried in batches because they are a part of the request path.
+func (e IbcExternal) queryUnreceivedPackets(portId, channelId string, sequences []uint64) ([]uint64, error) {
+	var unreceived []uint64
+	for start := 0; start < len(sequences); start += unreceivedPacketsBatchSize {
+		end := start + unreceivedPacketsBatchSize
+		if end > len(sequences) {
+			end = len(sequences)
+		}
+
+		msg, err := MakeIbcChannelPacketUnreceivedPacketsMsg(types.IbcChannelUnreceivedPacketsMsg{
+			ChannelId: channelId,
+			PortId:    portId,
+			Sequence:  joinSequences(sequences[start:end]),
+		})
+		if err != nil {
+			return nil, e.Xplac.GetLogger().Err(err)
+		}
+
+		res, err := e.ToExternal(IbcChannelUnreceivedPacketsMsgType, msg).QueryProto()
+		if err != nil {
+			return nil, err
+		}
+		unreceived = append(unreceived, res.(*ibcchannel.QueryUnreceivedPacketsResponse).Sequences...)
+	}
+	return unreceived, nil
+}
+
+func (e IbcExternal) queryNextSequenceReceive(portId, channelId string) (uint64, error) {
+	msg, err := MakeIbcChannelNextSequenceReceiveMsg(types.IbcChannelNextSequenceMsg{
+		ChannelId: channelId,
+		PortId:    portId,
+	})
+	if err != nil {
+		return 0, e.Xplac.GetLogger().Err(err)
+	}
+
+	res, err := e.ToExternal(IbcChannelNextSequenceMsgType, msg).QueryProto()
+	if err != nil {
+		return 0, err
+	}
+	return res.(*ibcchannel.QueryNextSequenceReceiveResponse).NextSequenceReceive, nil
+}
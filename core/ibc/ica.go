@@ -0,0 +1,36 @@
+package ibc
+
+import (
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+// Make the packet data of interchain accounts which executes messages by the interchain account on the host chain.
+// Signers of messages must be the interchain account, and the codec must be the proto codec, e.g. the codec of the encoding of the xpla client.
+// ibc-go v4 has no messages to register the interchain account or to send the packet by the owner,
+// so registering the account and sending the packet are not supported by xpla.go, and the packet data
+// is sent by the authentication module of the controller chain.
+func IcaPacketData(cdc codec.BinaryCodec, msgs []sdk.Msg, memo string) (icatypes.InterchainAccountPacketData, error) {
+	if len(msgs) == 0 {
+		return icatypes.InterchainAccountPacketData{}, types.ErrWrap(types.ErrInsufficientParams, "no messages to execute by the interchain account")
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, types.ErrWrap(types.ErrFailedToMarshal, err)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return icatypes.InterchainAccountPacketData{}, types.ErrWrap(types.ErrInvalidRequest, err)
+	}
+
+	return packetData, nil
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -17,10 +19,26 @@ func (c *coreModule) Name() string {
 	return IbcModule
 }
 
-func (c *coreModule) NewTxRouter(logger types.Logger, _ cmclient.TxBuilder, _ string, _ interface{}) (cmclient.TxBuilder, error) {
-	return nil, logger.Err(types.ErrWrap(types.ErrInvalidRequest, c.Name(), "module has not tx"))
+func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder, msgType string, msg interface{}) (cmclient.TxBuilder, error) {
+	switch {
+	case msgType == IbcTransferMsgType:
+		convertMsg := msg.(ibctransfer.MsgTransfer)
+		err := builder.SetMsgs(&convertMsg)
+		if err != nil {
+			return nil, logger.Err(err)
+		}
+
+	default:
+		return nil, logger.Err(types.ErrWrap(types.ErrInvalidMsgType, msgType))
+	}
+
+	return builder, nil
 }
 
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryIbc(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryIbcProto(q)
+}
@@ -1,7 +1,10 @@
 package ibc_test
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/xpladev/xpla.go/client"
@@ -9,8 +12,141 @@ import (
 	"github.com/xpladev/xpla.go/provider"
 	"github.com/xpladev/xpla.go/util/testutil"
 	"github.com/xpladev/xpla.go/util/testutil/network"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcconnection "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctypes "github.com/cosmos/ibc-go/v4/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+)
+
+var (
+	testLargeExponentDenom   = "largetoken"
+	testLargeExponentAddress string
 )
 
+func testDenomMetadata(base string, display string, exponent uint32) banktypes.Metadata {
+	units := []*banktypes.DenomUnit{{Denom: base}}
+	if display != base {
+		units = append(units, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	}
+	return banktypes.Metadata{
+		DenomUnits: units,
+		Base:       base,
+		Display:    display,
+	}
+}
+
+var (
+	testLoopbackChannelId = "channel-1"
+	testLoopbackPeerId    = "channel-2"
+	testStaleChannelId    = "channel-3"
+	testBrokenChannelId   = "channel-4"
+	testStaleChainId      = "stale-1"
+	testSentPackets       = 150
+	testReceivedPackets   = 120
+)
+
+// Make IBC states of channels for health reports.
+// The client of the loopback channels which connect this chain to itself expires soon,
+// the client of the stale channel is expired, and the connection of the broken channel does not exist.
+func testIbcGenesisState(now time.Time) ibctypes.GenesisState {
+	genState := *ibctypes.DefaultGenesisState()
+
+	clients := []struct {
+		clientId       string
+		chainId        string
+		trustingPeriod time.Duration
+		lastUpdate     time.Time
+	}{
+		{"07-tendermint-0", testutil.TestChainId, 2 * time.Hour, now.Add(-time.Hour)},
+		{"07-tendermint-1", testStaleChainId, 14 * 24 * time.Hour, now.Add(-30 * 24 * time.Hour)},
+	}
+	for i, c := range clients {
+		height := ibcclient.NewHeight(ibcclient.ParseChainID(c.chainId), 100)
+		clientState := ibctm.NewClientState(
+			c.chainId, ibctm.DefaultTrustLevel, c.trustingPeriod, 21*24*time.Hour, 10*time.Second,
+			height, commitmenttypes.GetSDKSpecs(), nil, false, false,
+		)
+		consensusState := ibctm.NewConsensusState(
+			c.lastUpdate, commitmenttypes.NewMerkleRoot([]byte("root")), bytes.Repeat([]byte{1}, 32),
+		)
+		genState.ClientGenesis.Clients = append(genState.ClientGenesis.Clients,
+			ibcclient.NewIdentifiedClientState(c.clientId, clientState),
+		)
+		genState.ClientGenesis.ClientsConsensus = append(genState.ClientGenesis.ClientsConsensus,
+			ibcclient.NewClientConsensusStates(c.clientId, []ibcclient.ConsensusStateWithHeight{
+				ibcclient.NewConsensusStateWithHeight(height, consensusState),
+			}),
+		)
+
+		connectionId := ibcconnection.FormatConnectionIdentifier(uint64(i))
+		genState.ConnectionGenesis.Connections = append(genState.ConnectionGenesis.Connections,
+			ibcconnection.NewIdentifiedConnection(connectionId, ibcconnection.NewConnectionEnd(
+				ibcconnection.OPEN, c.clientId,
+				ibcconnection.NewCounterparty(c.clientId, connectionId, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+				ibcconnection.ExportedVersionsToProto(ibcconnection.GetCompatibleVersions()), 0,
+			)),
+		)
+	}
+	genState.ClientGenesis.NextClientSequence = uint64(len(clients))
+	genState.ConnectionGenesis.NextConnectionSequence = uint64(len(clients))
+
+	channels := []struct {
+		channelId             string
+		counterpartyChannelId string
+		connectionId          string
+	}{
+		{testLoopbackChannelId, testLoopbackPeerId, "connection-0"},
+		{testLoopbackPeerId, testLoopbackChannelId, "connection-0"},
+		{testStaleChannelId, "channel-0", "connection-1"},
+		{testBrokenChannelId, "channel-0", "connection-9"},
+	}
+	for _, c := range channels {
+		genState.ChannelGenesis.Channels = append(genState.ChannelGenesis.Channels,
+			ibcchannel.NewIdentifiedChannel(testIbcChannelPortId, c.channelId, ibcchannel.NewChannel(
+				ibcchannel.OPEN, ibcchannel.UNORDERED,
+				ibcchannel.NewCounterparty(testIbcChannelPortId, c.counterpartyChannelId),
+				[]string{c.connectionId}, ibctransfer.Version,
+			)),
+		)
+		genState.ChannelGenesis.SendSequences = append(genState.ChannelGenesis.SendSequences,
+			ibcchannel.NewPacketSequence(testIbcChannelPortId, c.channelId, 1),
+		)
+		genState.ChannelGenesis.RecvSequences = append(genState.ChannelGenesis.RecvSequences,
+			ibcchannel.NewPacketSequence(testIbcChannelPortId, c.channelId, 1),
+		)
+		genState.ChannelGenesis.AckSequences = append(genState.ChannelGenesis.AckSequences,
+			ibcchannel.NewPacketSequence(testIbcChannelPortId, c.channelId, 1),
+		)
+	}
+	genState.ChannelGenesis.NextChannelSequence = uint64(len(channels) + 1)
+
+	// packets sent through the loopback channel are more than a batch of unreceived packets queries,
+	// and a part of them are received by the peer channel.
+	for sequence := uint64(1); sequence <= uint64(testSentPackets); sequence++ {
+		genState.ChannelGenesis.Commitments = append(genState.ChannelGenesis.Commitments,
+			ibcchannel.NewPacketState(testIbcChannelPortId, testLoopbackChannelId, sequence, []byte("commitment")),
+		)
+		if sequence <= uint64(testReceivedPackets) {
+			genState.ChannelGenesis.Receipts = append(genState.ChannelGenesis.Receipts,
+				ibcchannel.NewPacketState(testIbcChannelPortId, testLoopbackPeerId, sequence, []byte{1}),
+			)
+		}
+	}
+	// a packet of the peer channel is not received yet
+	genState.ChannelGenesis.Commitments = append(genState.ChannelGenesis.Commitments,
+		ibcchannel.NewPacketState(testIbcChannelPortId, testLoopbackPeerId, 1, []byte("commitment")),
+	)
+
+	return genState
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -57,5 +193,28 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 2
+
+	// metadata of the node1 token is on the second page of denominations metadata,
+	// and the display unit of the large exponent token cannot format amounts.
+	var bankGenState banktypes.GenesisState
+	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[banktypes.ModuleName], &bankGenState)
+	for i := 0; i < 100; i++ {
+		denom := fmt.Sprintf("filler%03d", i)
+		bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, testDenomMetadata(denom, denom, 0))
+	}
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata,
+		testDenomMetadata("node1token", "NODE1", 18),
+		testDenomMetadata(testLargeExponentDenom, "LARGE", 256),
+	)
+	testLargeExponentAddress = sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+		Address: testLargeExponentAddress,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(testLargeExponentDenom, 1000)),
+	})
+	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
+
+	ibcGenState := testIbcGenesisState(time.Now())
+	cfg.GenesisState[ibchost.ModuleName] = cfg.Codec.MustMarshalJSON(&ibcGenState)
+
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 }
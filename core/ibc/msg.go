@@ -1,21 +1,30 @@
 package ibc
 
 import (
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	icacontroller "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ibcconnection "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
+// (Tx) make msg - IBC transfer
+// If timeouts are relative, they are added to the height and the timestamp of the latest consensus state of the counterparty chain.
+func MakeIbcTransferMsg(ibcTransferMsg types.IbcTransferMsg, sender sdk.AccAddress, consensusHeight ibcclient.Height, consensusTimestamp uint64) (ibctransfer.MsgTransfer, error) {
+	return parseIbcTransferArgs(ibcTransferMsg, sender, consensusHeight, consensusTimestamp)
+}
+
 // (Query) make msg - IBC client states
-func MakeIbcClientStatesMsg() (ibcclient.QueryClientStatesRequest, error) {
+func MakeIbcClientStatesMsg(pageRequest *query.PageRequest) (ibcclient.QueryClientStatesRequest, error) {
 	return ibcclient.QueryClientStatesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -34,18 +43,18 @@ func MakeIbcClientStatusMsg(ibcClientStatusMsg types.IbcClientStatusMsg) (ibccli
 }
 
 // (Query) make msg - IBC client consensus states
-func MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg types.IbcClientConsensusStatesMsg) (ibcclient.QueryConsensusStatesRequest, error) {
+func MakeIbcClientConsensusStatesMsg(ibcClientConsensusStatesMsg types.IbcClientConsensusStatesMsg, pageRequest *query.PageRequest) (ibcclient.QueryConsensusStatesRequest, error) {
 	return ibcclient.QueryConsensusStatesRequest{
 		ClientId:   ibcClientConsensusStatesMsg.ClientId,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // (Query) make msg - IBC client consensus state heights
-func MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg types.IbcClientConsensusStateHeightsMsg) (ibcclient.QueryConsensusStateHeightsRequest, error) {
+func MakeIbcClientConsensusStateHeightsMsg(ibcClientConsensusStateHeightsMsg types.IbcClientConsensusStateHeightsMsg, pageRequest *query.PageRequest) (ibcclient.QueryConsensusStateHeightsRequest, error) {
 	return ibcclient.QueryConsensusStateHeightsRequest{
 		ClientId:   ibcClientConsensusStateHeightsMsg.ClientId,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -70,9 +79,9 @@ func MakeIbcClientParamsMsg() (ibcclient.QueryClientParamsRequest, error) {
 }
 
 // (Query) make msg - IBC connection connetions
-func MakeIbcConnectionConnectionsMsg() (ibcconnection.QueryConnectionsRequest, error) {
+func MakeIbcConnectionConnectionsMsg(pageRequest *query.PageRequest) (ibcconnection.QueryConnectionsRequest, error) {
 	return ibcconnection.QueryConnectionsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -91,9 +100,9 @@ func MakeIbcConnectionClientConnectionsMsg(ibcClientConnectionsMsg types.IbcClie
 }
 
 // (Query) make msg - IBC channels
-func MakeIbcChannelChannelsMsg() (ibcchannel.QueryChannelsRequest, error) {
+func MakeIbcChannelChannelsMsg(pageRequest *query.PageRequest) (ibcchannel.QueryChannelsRequest, error) {
 	return ibcchannel.QueryChannelsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -106,10 +115,10 @@ func MakeIbcChannelChannelMsg(ibcChannelMsg types.IbcChannelMsg) (ibcchannel.Que
 }
 
 // (Query) make msg - IBC channel connections
-func MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg types.IbcChannelConnectionsMsg) (ibcchannel.QueryConnectionChannelsRequest, error) {
+func MakeIbcChannelConnectionsMsg(ibcChannelConnectionsMsg types.IbcChannelConnectionsMsg, pageRequest *query.PageRequest) (ibcchannel.QueryConnectionChannelsRequest, error) {
 	return ibcchannel.QueryConnectionChannelsRequest{
 		Connection: ibcChannelConnectionsMsg.ConnectionId,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -122,9 +131,9 @@ func MakeIbcChannelClientStateMsg(ibcChannelClientStateMsg types.IbcChannelClien
 }
 
 // (Query) make msg - IBC channel packet commitments
-func MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg types.IbcChannelPacketCommitmentsMsg) (ibcchannel.QueryPacketCommitmentsRequest, error) {
+func MakeIbcChannelPacketCommitmentsMsg(ibcChannelPacketCommitmentsMsg types.IbcChannelPacketCommitmentsMsg, pageRequest *query.PageRequest) (ibcchannel.QueryPacketCommitmentsRequest, error) {
 	return ibcchannel.QueryPacketCommitmentsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 		ChannelId:  ibcChannelPacketCommitmentsMsg.ChannelId,
 		PortId:     ibcChannelPacketCommitmentsMsg.PortId,
 	}, nil
@@ -171,27 +180,27 @@ func MakeIbcChannelPacketAckMsg(ibcChannelPacketAckMsg types.IbcChannelPacketAck
 
 // (Query) make msg - IBC channel unreceived packets
 func MakeIbcChannelPacketUnreceivedPacketsMsg(ibcChannelUnreceivedPacketsMsg types.IbcChannelUnreceivedPacketsMsg) (ibcchannel.QueryUnreceivedPacketsRequest, error) {
-	seq, err := util.FromStringToUint64(ibcChannelUnreceivedPacketsMsg.Sequence)
+	seqs, err := parseIbcSequences(ibcChannelUnreceivedPacketsMsg.Sequence)
 	if err != nil {
-		return ibcchannel.QueryUnreceivedPacketsRequest{}, types.ErrWrap(types.ErrConvert, err)
+		return ibcchannel.QueryUnreceivedPacketsRequest{}, err
 	}
 	return ibcchannel.QueryUnreceivedPacketsRequest{
 		ChannelId:                 ibcChannelUnreceivedPacketsMsg.ChannelId,
 		PortId:                    ibcChannelUnreceivedPacketsMsg.PortId,
-		PacketCommitmentSequences: []uint64{seq},
+		PacketCommitmentSequences: seqs,
 	}, nil
 }
 
 // (Query) make msg - IBC channel unreceived acks
 func MakeIbcChannelPacketUnreceivedAcksMsg(ibcChannelUnreceivedAcksMsg types.IbcChannelUnreceivedAcksMsg) (ibcchannel.QueryUnreceivedAcksRequest, error) {
-	seq, err := util.FromStringToUint64(ibcChannelUnreceivedAcksMsg.Sequence)
+	seqs, err := parseIbcSequences(ibcChannelUnreceivedAcksMsg.Sequence)
 	if err != nil {
-		return ibcchannel.QueryUnreceivedAcksRequest{}, types.ErrWrap(types.ErrConvert, err)
+		return ibcchannel.QueryUnreceivedAcksRequest{}, err
 	}
 	return ibcchannel.QueryUnreceivedAcksRequest{
 		ChannelId:          ibcChannelUnreceivedAcksMsg.ChannelId,
 		PortId:             ibcChannelUnreceivedAcksMsg.PortId,
-		PacketAckSequences: []uint64{seq},
+		PacketAckSequences: seqs,
 	}, nil
 }
 
@@ -204,9 +213,9 @@ func MakeIbcChannelNextSequenceReceiveMsg(ibcChannelNextSequenceMsg types.IbcCha
 }
 
 // (Query) make msg - IBC transfer denom traces
-func MakeIbcTransferDenomTracesMsg() (ibctransfer.QueryDenomTracesRequest, error) {
+func MakeIbcTransferDenomTracesMsg(pageRequest *query.PageRequest) (ibctransfer.QueryDenomTracesRequest, error) {
 	return ibctransfer.QueryDenomTracesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -233,3 +242,25 @@ func MakeIbcTransferEscrowAddressMsg(ibcEscrowAddressMsg types.IbcEscrowAddressM
 func MakeIbcTransferParamsMsg() (ibctransfer.QueryParamsRequest, error) {
 	return ibctransfer.QueryParamsRequest{}, nil
 }
+
+// (Query) make msg - IBC interchain account of the owner
+func MakeIbcIcaControllerAccountMsg(ibcInterchainAccountMsg types.IbcInterchainAccountMsg) (icacontroller.QueryInterchainAccountRequest, error) {
+	if ibcInterchainAccountMsg.Owner == "" || ibcInterchainAccountMsg.ConnectionId == "" {
+		return icacontroller.QueryInterchainAccountRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty mandatory parameters")
+	}
+
+	return icacontroller.QueryInterchainAccountRequest{
+		Owner:        ibcInterchainAccountMsg.Owner,
+		ConnectionId: ibcInterchainAccountMsg.ConnectionId,
+	}, nil
+}
+
+// (Query) make msg - IBC interchain accounts controller params
+func MakeIbcIcaControllerParamsMsg() (icacontroller.QueryParamsRequest, error) {
+	return icacontroller.QueryParamsRequest{}, nil
+}
+
+// (Query) make msg - IBC interchain accounts host params
+func MakeIbcIcaHostParamsMsg() (icahost.QueryParamsRequest, error) {
+	return icahost.QueryParamsRequest{}, nil
+}
@@ -1,12 +1,90 @@
 package ibc
 
 import (
+	"strings"
+	"time"
+
 	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 )
 
+// Parsing - IBC transfer
+func parseIbcTransferArgs(ibcTransferMsg types.IbcTransferMsg, sender sdk.AccAddress, consensusHeight ibcclient.Height, consensusTimestamp uint64) (ibctransfer.MsgTransfer, error) {
+	if ibcTransferMsg.SourcePort == "" ||
+		ibcTransferMsg.SourceChannel == "" ||
+		ibcTransferMsg.Receiver == "" ||
+		ibcTransferMsg.Amount == "" {
+		return ibctransfer.MsgTransfer{}, types.ErrWrap(types.ErrInsufficientParams, "Empty mandatory parameters")
+	}
+
+	coin, err := sdk.ParseCoinNormalized(ibcTransferMsg.Amount)
+	if err != nil {
+		return ibctransfer.MsgTransfer{}, types.ErrWrap(types.ErrParse, err)
+	}
+
+	// the denom trace is converted to the IBC denom
+	if !strings.HasPrefix(coin.Denom, "ibc/") {
+		coin.Denom = ibctransfer.ParseDenomTrace(coin.Denom).IBCDenom()
+	}
+
+	timeoutHeightStr := ibcTransferMsg.TimeoutHeight
+	if timeoutHeightStr == "" {
+		timeoutHeightStr = ibctransfer.DefaultRelativePacketTimeoutHeight
+	}
+	timeoutHeight, err := ibcclient.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return ibctransfer.MsgTransfer{}, types.ErrWrap(types.ErrParse, err)
+	}
+
+	timeoutTimestamp := ibctransfer.DefaultRelativePacketTimeoutTimestamp
+	if ibcTransferMsg.TimeoutTimestamp != "" {
+		timeoutTimestamp, err = util.FromStringToUint64(ibcTransferMsg.TimeoutTimestamp)
+		if err != nil {
+			return ibctransfer.MsgTransfer{}, types.ErrWrap(types.ErrConvert, err)
+		}
+	}
+
+	if !ibcTransferMsg.AbsoluteTimeouts {
+		if !timeoutHeight.IsZero() {
+			absoluteHeight := consensusHeight
+			absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
+			absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
+			timeoutHeight = absoluteHeight
+		}
+
+		// the local clock time is used as the reference time if it is later than the timestamp of the consensus state
+		if timeoutTimestamp != 0 {
+			now := uint64(time.Now().UnixNano())
+			if now > consensusTimestamp {
+				timeoutTimestamp = now + timeoutTimestamp
+			} else {
+				timeoutTimestamp = consensusTimestamp + timeoutTimestamp
+			}
+		}
+	}
+
+	msg := ibctransfer.NewMsgTransfer(
+		ibcTransferMsg.SourcePort,
+		ibcTransferMsg.SourceChannel,
+		coin,
+		sender.String(),
+		ibcTransferMsg.Receiver,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+	msg.Memo = ibcTransferMsg.Memo
+
+	if err := msg.ValidateBasic(); err != nil {
+		return ibctransfer.MsgTransfer{}, types.ErrWrap(types.ErrInvalidRequest, err)
+	}
+	return *msg, nil
+}
+
 // Parsing - IBC client consensus state
 func parseIbcClientConsensusStateArgs(ibcClientConsensusStateMsg types.IbcClientConsensusStateMsg) (ibcclient.QueryConsensusStateRequest, error) {
 	var height ibcclient.Height
@@ -47,3 +125,16 @@ func parseCmclientForIbcClientArgs(rpcUrl string) (cmclient.Context, error) {
 
 	return clientCtx, nil
 }
+
+// Parsing - IBC packet sequences which are separated by commas
+func parseIbcSequences(sequences string) ([]uint64, error) {
+	var seqs []uint64
+	for _, sequence := range strings.Split(sequences, ",") {
+		seq, err := util.FromStringToUint64(strings.TrimSpace(sequence))
+		if err != nil {
+			return nil, types.ErrWrap(types.ErrConvert, err)
+		}
+		seqs = append(seqs, seq)
+	}
+	return seqs, nil
+}
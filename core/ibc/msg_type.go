@@ -2,6 +2,7 @@ package ibc
 
 const (
 	IbcModule                             = "ibc"
+	IbcTransferMsgType                    = "ibc-transfer"
 	IbcClientStatesMsgType                = "ibc-client-states"
 	IbcClientStateMsgType                 = "ibc-client-state"
 	IbcClientStatusMsgType                = "ibc-client-status"
@@ -30,4 +31,7 @@ const (
 	IbcTransferDenomHashMsgType           = "ibc-transfer-denom-hash"
 	IbcTransferEscrowAddressMsgType       = "ibc-transfer-escrow-address"
 	IbcTransferParamsMsgType              = "ibc-transfer-params"
+	IbcIcaControllerAccountMsgType        = "ibc-ica-controller-account"
+	IbcIcaControllerParamsMsgType         = "ibc-ica-controller-params"
+	IbcIcaHostParamsMsgType               = "ibc-ica-host-params"
 )
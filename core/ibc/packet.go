@@ -0,0 +1,206 @@
+package ibc
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/xpladev/xpla.go/provider"
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// Extract packets which are sent by the transaction from send_packet events.
+func IbcPacketsFromTx(txResponse *sdk.TxResponse) ([]types.IbcPacket, error) {
+	if txResponse == nil {
+		return nil, types.ErrWrap(types.ErrInsufficientParams, "empty tx response")
+	}
+
+	var packets []types.IbcPacket
+	for _, log := range txResponse.Logs {
+		for _, event := range log.Events {
+			if event.Type != ibcchannel.EventTypeSendPacket {
+				continue
+			}
+
+			attributes := make(map[string]string)
+			for _, attribute := range event.Attributes {
+				attributes[attribute.Key] = attribute.Value
+			}
+
+			sequence, err := util.FromStringToUint64(attributes[ibcchannel.AttributeKeySequence])
+			if err != nil {
+				return nil, types.ErrWrap(types.ErrConvert, err)
+			}
+			timeoutTimestamp, err := util.FromStringToUint64(attributes[ibcchannel.AttributeKeyTimeoutTimestamp])
+			if err != nil {
+				return nil, types.ErrWrap(types.ErrConvert, err)
+			}
+
+			packets = append(packets, types.IbcPacket{
+				Sequence:           sequence,
+				SourcePort:         attributes[ibcchannel.AttributeKeySrcPort],
+				SourceChannel:      attributes[ibcchannel.AttributeKeySrcChannel],
+				DestinationPort:    attributes[ibcchannel.AttributeKeyDstPort],
+				DestinationChannel: attributes[ibcchannel.AttributeKeyDstChannel],
+				TimeoutHeight:      attributes[ibcchannel.AttributeKeyTimeoutHeight],
+				TimeoutTimestamp:   timeoutTimestamp,
+			})
+		}
+	}
+
+	if len(packets) == 0 {
+		return nil, types.ErrWrap(types.ErrNotFound, "send_packet event in the transaction", txResponse.TxHash)
+	}
+	return packets, nil
+}
+
+// Query the status of the packet which is sent from the chain of the xpla client.
+// The counterparty is the xpla client which is configured for the counterparty chain,
+// and it should be able to query transactions by events to read the acknowledgement.
+func (e IbcExternal) IbcPacketStatus(packet types.IbcPacket, counterparty provider.XplaClient) (types.IbcPacketStatus, error) {
+	status := types.IbcPacketStatus{Packet: packet}
+	sequence := util.FromUint64ToString(packet.Sequence)
+
+	// The commitment of the packet is deleted when the packet is acknowledged or timed out.
+	commitmentMsg, err := MakeIbcChannelPacketUnreceivedAcksMsg(types.IbcChannelUnreceivedAcksMsg{
+		ChannelId: packet.SourceChannel,
+		PortId:    packet.SourcePort,
+		Sequence:  sequence,
+	})
+	if err != nil {
+		return status, e.Xplac.GetLogger().Err(err)
+	}
+	res, err := e.ToExternal(IbcChannelUnreceivedAcksMsgType, commitmentMsg).QueryProto()
+	if err != nil {
+		return status, err
+	}
+	committed := len(res.(*ibcchannel.QueryUnreceivedAcksResponse).Sequences) != 0
+
+	receivedRes, err := counterparty.IbcChannelUnreceivedPackets(types.IbcChannelUnreceivedPacketsMsg{
+		ChannelId: packet.DestinationChannel,
+		PortId:    packet.DestinationPort,
+		Sequence:  sequence,
+	}).QueryProto()
+	if err != nil {
+		return status, err
+	}
+	received := len(receivedRes.(*ibcchannel.QueryUnreceivedPacketsResponse).Sequences) == 0
+
+	status.State = ResolveIbcPacketState(committed, received)
+	if status.State == types.IbcPacketAcknowledged {
+		ack, err := queryIbcPacketAck(packet, counterparty)
+		if err != nil {
+			return status, err
+		}
+		status.AckSuccess = ack.Success()
+		status.AckError = ack.GetError()
+	}
+
+	return status, nil
+}
+
+// Decide the state of the packet by the packet commitment on the source chain and the receipt on the destination chain.
+// The commitment is deleted when the packet is acknowledged or timed out, and only the acknowledged packet is received.
+func ResolveIbcPacketState(committed bool, received bool) types.IbcPacketState {
+	switch {
+	case committed && !received:
+		return types.IbcPacketPending
+	case committed && received:
+		return types.IbcPacketReceived
+	case !committed && received:
+		return types.IbcPacketAcknowledged
+	default:
+		return types.IbcPacketTimedOut
+	}
+}
+
+// Track the packet until it is acknowledged or timed out.
+// The callback is called whenever the state of the packet is changed, and it can be nil.
+// The xpla client context bounds the tracking, or the default wait timeout is used if the context has no deadline.
+func (e IbcExternal) TrackIbcPacket(packet types.IbcPacket, counterparty provider.XplaClient, onStatus func(types.IbcPacketStatus)) (types.IbcPacketStatus, error) {
+	ctx := e.Xplac.GetContext()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, util.DefaultIbcPacketWaitTimeout)
+		defer cancel()
+	}
+
+	var last types.IbcPacketState
+	for {
+		status, err := e.IbcPacketStatus(packet, counterparty)
+		if err != nil {
+			return status, err
+		}
+
+		if status.State != last {
+			last = status.State
+			if onStatus != nil {
+				onStatus(status)
+			}
+		}
+		if status.State == types.IbcPacketAcknowledged || status.State == types.IbcPacketTimedOut {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrTimeout, "packet", packet.Sequence, "is", status.State, ":", ctx.Err()))
+		case <-time.After(util.DefaultIbcPacketWaitInterval):
+		}
+	}
+}
+
+// Read the acknowledgement of the packet from the write_acknowledgement event on the counterparty chain.
+func queryIbcPacketAck(packet types.IbcPacket, counterparty provider.XplaClient) (ibcchannel.Acknowledgement, error) {
+	// The sequence is unique only in the channel, so the destination port and channel are also queried.
+	events := []string{
+		ibcchannel.EventTypeWriteAck + "." + ibcchannel.AttributeKeySequence + "=" + util.FromUint64ToString(packet.Sequence),
+		ibcchannel.EventTypeWriteAck + "." + ibcchannel.AttributeKeyDstPort + "=" + packet.DestinationPort,
+		ibcchannel.EventTypeWriteAck + "." + ibcchannel.AttributeKeyDstChannel + "=" + packet.DestinationChannel,
+	}
+	res, err := counterparty.TxsByEvents(types.QueryTxsByEventsMsg{
+		Events: strings.Join(events, "&"),
+		Limit:  "100",
+	}).QueryProto()
+	if err != nil {
+		return ibcchannel.Acknowledgement{}, err
+	}
+
+	for _, txResponse := range res.(*sdk.SearchTxsResult).Txs {
+		for _, log := range txResponse.Logs {
+			for _, event := range log.Events {
+				if event.Type != ibcchannel.EventTypeWriteAck {
+					continue
+				}
+
+				attributes := make(map[string]string)
+				for _, attribute := range event.Attributes {
+					attributes[attribute.Key] = attribute.Value
+				}
+				if attributes[ibcchannel.AttributeKeySequence] != util.FromUint64ToString(packet.Sequence) ||
+					attributes[ibcchannel.AttributeKeyDstPort] != packet.DestinationPort ||
+					attributes[ibcchannel.AttributeKeyDstChannel] != packet.DestinationChannel {
+					continue
+				}
+
+				ackBytes, err := hex.DecodeString(attributes[ibcchannel.AttributeKeyAckHex])
+				if err != nil {
+					return ibcchannel.Acknowledgement{}, types.ErrWrap(types.ErrParse, err)
+				}
+				var ack ibcchannel.Acknowledgement
+				if err := ibctransfer.ModuleCdc.UnmarshalJSON(ackBytes, &ack); err != nil {
+					return ibcchannel.Acknowledgement{}, types.ErrWrap(types.ErrFailedToUnmarshal, err)
+				}
+				return ack, nil
+			}
+		}
+	}
+
+	return ibcchannel.Acknowledgement{}, types.ErrWrap(types.ErrNotFound, "acknowledgement of the packet", packet.Sequence, "on the counterparty chain")
+}
@@ -1,12 +1,16 @@
 package ibc
 
 import (
+	"strings"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	icacontroller "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	ibctransfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclientutils "github.com/cosmos/ibc-go/v4/modules/core/02-client/client/utils"
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -14,12 +18,24 @@ import (
 	ibcchannel "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for gov module.
 func QueryIbc(i core.QueryClient) (string, error) {
+	res, err := QueryIbcProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for gov module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryIbcProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcIbc(i)
 	} else {
@@ -27,11 +43,16 @@ func QueryIbc(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcIbc(i core.QueryClient) (string, error) {
+func queryByGrpcIbc(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	ibcclientQueryClient := ibcclient.NewQueryClient(i.Ixplac.GetGrpcClient())
 	ibcconnectionQueryClient := ibcconnection.NewQueryClient(i.Ixplac.GetGrpcClient())
 	ibccchannelQueryClient := ibcchannel.NewQueryClient(i.Ixplac.GetGrpcClient())
 	ibctransferQueryClient := ibctransfer.NewQueryClient(i.Ixplac.GetGrpcClient())
+	icacontrollerQueryClient := icacontroller.NewQueryClient(i.Ixplac.GetGrpcClient())
+	icahostQueryClient := icahost.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
 	// IBC client states
@@ -43,7 +64,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client state
@@ -55,7 +76,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client status
@@ -67,7 +88,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client consensus states
@@ -79,7 +100,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client consensus state heights
@@ -91,7 +112,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client consensus state
@@ -103,7 +124,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC client tendermint header
@@ -112,7 +133,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(cmclient.Context)
 		header, _, err := ibcclientutils.QueryTendermintHeader(convertMsg)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		res = &header
@@ -123,7 +144,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(cmclient.Context)
 		state, _, err := ibcclientutils.QuerySelfConsensusState(convertMsg)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 		res = state
@@ -137,7 +158,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC connection connections
@@ -149,7 +170,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC connection connection
@@ -161,7 +182,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC connection a client connections
@@ -173,7 +194,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channels
@@ -185,7 +206,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC a channel
@@ -197,7 +218,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel connections
@@ -209,7 +230,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel client state
@@ -221,7 +242,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel packet commitments
@@ -233,7 +254,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel packet commitment by sequece
@@ -245,7 +266,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel packet receipt
@@ -257,7 +278,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel packet ack
@@ -269,7 +290,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel unreceived packets
@@ -281,7 +302,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel unreceived acks
@@ -293,7 +314,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC channel next sequence receive
@@ -305,7 +326,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC transfer denom traces
@@ -317,7 +338,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC transfer denom trace
@@ -329,7 +350,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC transfer denom hash
@@ -340,7 +361,7 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// IBC transfer escrow address
@@ -348,7 +369,9 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(types.IbcEscrowAddressMsg)
 
 		addr := ibctransfer.GetEscrowAddress(convertMsg.PortId, convertMsg.ChannelId)
-		return addr.String(), nil
+		res = &ibctransfer.QueryEscrowAddressResponse{
+			EscrowAddress: addr.String(),
+		}
 
 	// IBC transfer params
 	case i.Ixplac.GetMsgType() == IbcTransferParamsMsgType:
@@ -358,19 +381,47 @@ func queryByGrpcIbc(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
-	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
+	// IBC interchain account of the owner
+	case i.Ixplac.GetMsgType() == IbcIcaControllerAccountMsgType:
+		convertMsg := i.Ixplac.GetMsg().(icacontroller.QueryInterchainAccountRequest)
+		res, err = icacontrollerQueryClient.InterchainAccount(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+	// IBC interchain accounts controller params
+	case i.Ixplac.GetMsgType() == IbcIcaControllerParamsMsgType:
+		convertMsg := i.Ixplac.GetMsg().(icacontroller.QueryParamsRequest)
+		res, err = icacontrollerQueryClient.Params(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
+
+	// IBC interchain accounts host params
+	case i.Ixplac.GetMsgType() == IbcIcaHostParamsMsgType:
+		convertMsg := i.Ixplac.GetMsg().(icahost.QueryParamsRequest)
+		res, err = icahostQueryClient.Params(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
+
+	default:
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -397,46 +448,59 @@ const (
 	ibctransferDenomTracesLabel   = "denom_traces"
 	ibctransferDenomHashesLabel   = "denom_hashes"
 	ibctransferEscrowAddressLabel = "escrow_address"
+
+	icacontrollerOwnersLabel      = "owners"
+	icacontrollerConnectionsLabel = "connections"
 )
 
-func queryByLcdIbc(i core.QueryClient) (string, error) {
+func queryByLcdIbc(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	var url string
 	ibcclientUrl := "/ibc/core/client/v1/"
 	ibcconnectionUrl := "/ibc/core/connection/v1/"
 	ibcchannelUrl := "/ibc/core/channel/v1/"
 	ibctransferUrl := "/ibc/apps/transfer/v1/"
+	icacontrollerUrl := "/ibc/apps/interchain_accounts/controller/v1/"
+	icahostUrl := "/ibc/apps/interchain_accounts/host/v1/"
 
 	switch {
 	// IBC client states
 	case i.Ixplac.GetMsgType() == IbcClientStatesMsgType:
+		res = &ibcclient.QueryClientStatesResponse{}
 		url = ibcclientUrl + ibcclientClientStatesLabel
 
 	// IBC client state
 	case i.Ixplac.GetMsgType() == IbcClientStateMsgType:
+		res = &ibcclient.QueryClientStateResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcclient.QueryClientStateRequest)
 
 		url = ibcclientUrl + util.MakeQueryLabels(ibcclientClientStatesLabel, convertMsg.ClientId)
 
 	// IBC client status
 	case i.Ixplac.GetMsgType() == IbcClientStatusMsgType:
+		res = &ibcclient.QueryClientStatusResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcclient.QueryClientStatusRequest)
 
 		url = ibcclientUrl + util.MakeQueryLabels(ibcclientClientStatusLabel, convertMsg.ClientId)
 
 	// IBC client consensus states
 	case i.Ixplac.GetMsgType() == IbcClientConsensusStatesMsgType:
+		res = &ibcclient.QueryConsensusStatesResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcclient.QueryConsensusStatesRequest)
 
 		url = ibcclientUrl + util.MakeQueryLabels(ibcclientClientConsensusStatesLabel, convertMsg.ClientId)
 
 	// IBC client consensus state heights
 	case i.Ixplac.GetMsgType() == IbcClientConsensusStateHeightsMsgType:
+		res = &ibcclient.QueryConsensusStateHeightsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcclient.QueryConsensusStateHeightsRequest)
 
 		url = ibcclientUrl + util.MakeQueryLabels(ibcclientClientConsensusStatesLabel, convertMsg.ClientId, ibcclientHeightsLabel)
 
 	// IBC client consensus state height
 	case i.Ixplac.GetMsgType() == IbcClientConsensusStateMsgType:
+		res = &ibcclient.QueryConsensusStateResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcclient.QueryConsensusStateRequest)
 
 		url = ibcclientUrl + util.MakeQueryLabels(
@@ -450,80 +514,96 @@ func queryByLcdIbc(i core.QueryClient) (string, error) {
 
 	// IBC client tendermint header
 	case i.Ixplac.GetMsgType() == IbcClientHeaderMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying IBC client tendermint header by using LCD"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying IBC client tendermint header by using LCD"))
 
 	// IBC client self consensus state
 	case i.Ixplac.GetMsgType() == IbcClientSelfConsensusStateMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying IBC client self consensus state by using LCD"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying IBC client self consensus state by using LCD"))
 
 	// IBC client params
 	case i.Ixplac.GetMsgType() == IbcClientParamsMsgType:
+		res = &ibcclient.QueryClientParamsResponse{}
 		url = "/ibc/client/v1/params"
 
 	// IBC connection connections
 	case i.Ixplac.GetMsgType() == IbcConnectionConnectionsMsgType:
+		res = &ibcconnection.QueryConnectionsResponse{}
 		url = ibcconnectionUrl + ibcconnectionConnectionsLabel
 
 	// IBC connection connection
 	case i.Ixplac.GetMsgType() == IbcConnectionConnectionMsgType:
+		res = &ibcconnection.QueryConnectionResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcconnection.QueryConnectionRequest)
 
 		url = ibcconnectionUrl + util.MakeQueryLabels(ibcconnectionConnectionsLabel, convertMsg.ConnectionId)
 
 	// IBC connection a client connections
 	case i.Ixplac.GetMsgType() == IbcConnectionClientConnectionsMsgType:
+		res = &ibcconnection.QueryClientConnectionsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcconnection.QueryClientConnectionsRequest)
 
 		url = ibcconnectionUrl + util.MakeQueryLabels(ibcconnectionClientConnectionsLabel, convertMsg.ClientId)
 
 	// IBC channels
 	case i.Ixplac.GetMsgType() == IbcChannelChannelsMsgType:
-		url = ibcchannelUrl + ibcchannelChannelsLabel
+		res = &ibcchannel.QueryChannelsResponse{}
+		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryChannelsRequest)
+
+		url = ibcchannelUrl + ibcchannelChannelsLabel + core.LcdPaginationQuery(convertMsg.Pagination)
 
 	// IBC a channel
 	case i.Ixplac.GetMsgType() == IbcChannelChannelMsgType:
+		res = &ibcchannel.QueryChannelResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryChannelRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId)
 
 	// IBC channel connections
 	case i.Ixplac.GetMsgType() == IbcChannelConnectionsMsgType:
+		res = &ibcchannel.QueryConnectionChannelsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryConnectionChannelsRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcconnectionConnectionsLabel, convertMsg.Connection, ibcchannelChannelsLabel)
 
 	// IBC channel client state
 	case i.Ixplac.GetMsgType() == IbcChannelClientStateMsgType:
+		res = &ibcchannel.QueryChannelClientStateResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryChannelClientStateRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelClientStateLabel)
 
 	// IBC channel packet commitments
 	case i.Ixplac.GetMsgType() == IbcChannelPacketCommitmentsMsgType:
+		res = &ibcchannel.QueryPacketCommitmentsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryPacketCommitmentsRequest)
 
-		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelPacketCommitmentsLabel)
+		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelPacketCommitmentsLabel) +
+			core.LcdPaginationQuery(convertMsg.Pagination)
 
 	// IBC channel packet commitment by sequece
 	case i.Ixplac.GetMsgType() == IbcChannelPacketCommitmentMsgType:
+		res = &ibcchannel.QueryPacketCommitmentResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryPacketCommitmentRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelPacketCommitmentsLabel, util.FromUint64ToString(convertMsg.Sequence))
 
 	// IBC channel packet receipt
 	case i.Ixplac.GetMsgType() == IbcChannelPacketReceiptMsgType:
+		res = &ibcchannel.QueryPacketReceiptResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryPacketReceiptRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelPacketReceiptLabel, util.FromUint64ToString(convertMsg.Sequence))
 
 	// IBC channel packet ack
 	case i.Ixplac.GetMsgType() == IbcChannelPacketAckMsgType:
+		res = &ibcchannel.QueryPacketAcknowledgementResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryPacketAcknowledgementRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelPacketAckLabel, util.FromUint64ToString(convertMsg.Sequence))
 
 	// IBC channel unreceived packets
 	case i.Ixplac.GetMsgType() == IbcChannelUnreceivedPacketsMsgType:
+		res = &ibcchannel.QueryUnreceivedPacketsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryUnreceivedPacketsRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(
@@ -532,12 +612,13 @@ func queryByLcdIbc(i core.QueryClient) (string, error) {
 			ibcchannelPortsLabel,
 			convertMsg.PortId,
 			ibcchannelPacketCommitmentsLabel,
-			util.FromUint64ToString(convertMsg.PacketCommitmentSequences[0]),
+			joinSequences(convertMsg.PacketCommitmentSequences),
 			ibcchannelUnreceivedPacketsLabel,
 		)
 
 	// IBC channel unreceived acks
 	case i.Ixplac.GetMsgType() == IbcChannelUnreceivedAcksMsgType:
+		res = &ibcchannel.QueryUnreceivedAcksResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryUnreceivedAcksRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(
@@ -546,52 +627,89 @@ func queryByLcdIbc(i core.QueryClient) (string, error) {
 			ibcchannelPortsLabel,
 			convertMsg.PortId,
 			ibcchannelPacketCommitmentsLabel,
-			util.FromUint64ToString(convertMsg.PacketAckSequences[0]),
+			joinSequences(convertMsg.PacketAckSequences),
 			ibcchannelUnreceivedAcksLabel,
 		)
 
 	// IBC channel next sequence receive
 	case i.Ixplac.GetMsgType() == IbcChannelNextSequenceMsgType:
+		res = &ibcchannel.QueryNextSequenceReceiveResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibcchannel.QueryNextSequenceReceiveRequest)
 
 		url = ibcchannelUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibcchannelNextSequenceLabel)
 
 	// IBC transfer denom traces
 	case i.Ixplac.GetMsgType() == IbcTransferDenomTracesMsgType:
-		url = ibctransferUrl + ibctransferDenomTracesLabel
+		res = &ibctransfer.QueryDenomTracesResponse{}
+		convertMsg := i.Ixplac.GetMsg().(ibctransfer.QueryDenomTracesRequest)
+
+		url = ibctransferUrl + ibctransferDenomTracesLabel + core.LcdPaginationQuery(convertMsg.Pagination)
 
 	// IBC transfer denom trace
 	case i.Ixplac.GetMsgType() == IbcTransferDenomTraceMsgType:
+		res = &ibctransfer.QueryDenomTraceResponse{}
 		convertMsg := i.Ixplac.GetMsg().(ibctransfer.QueryDenomTraceRequest)
 
 		url = ibctransferUrl + util.MakeQueryLabels(ibctransferDenomTracesLabel, convertMsg.Hash)
 
 	// IBC transfer denom hash
 	case i.Ixplac.GetMsgType() == IbcTransferDenomHashMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying denom hash by using LCD"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying denom hash by using LCD"))
 
 	// IBC transfer escrow address
 	case i.Ixplac.GetMsgType() == IbcTransferEscrowAddressMsgType:
+		res = &ibctransfer.QueryEscrowAddressResponse{}
 		convertMsg := i.Ixplac.GetMsg().(types.IbcEscrowAddressMsg)
 
 		url = ibctransferUrl + util.MakeQueryLabels(ibcchannelChannelsLabel, convertMsg.ChannelId, ibcchannelPortsLabel, convertMsg.PortId, ibctransferEscrowAddressLabel)
 
 	// IBC transfer params
 	case i.Ixplac.GetMsgType() == IbcTransferParamsMsgType:
+		res = &ibctransfer.QueryParamsResponse{}
 		url = ibctransferUrl + "/params"
 
+	// IBC interchain account of the owner
+	case i.Ixplac.GetMsgType() == IbcIcaControllerAccountMsgType:
+		res = &icacontroller.QueryInterchainAccountResponse{}
+		convertMsg := i.Ixplac.GetMsg().(icacontroller.QueryInterchainAccountRequest)
+
+		url = icacontrollerUrl + util.MakeQueryLabels(icacontrollerOwnersLabel, convertMsg.Owner, icacontrollerConnectionsLabel, convertMsg.ConnectionId)
+
+	// IBC interchain accounts controller params
+	case i.Ixplac.GetMsgType() == IbcIcaControllerParamsMsgType:
+		res = &icacontroller.QueryParamsResponse{}
+		url = icacontrollerUrl + "params"
+
+	// IBC interchain accounts host params
+	case i.Ixplac.GetMsgType() == IbcIcaHostParamsMsgType:
+		res = &icahost.QueryParamsResponse{}
+		url = icahostUrl + "params"
+
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 
 }
+
+// Sequences of the path parameter are separated by commas.
+func joinSequences(sequences []uint64) string {
+	var strs []string
+	for _, sequence := range sequences {
+		strs = append(strs, util.FromUint64ToString(sequence))
+	}
+	return strings.Join(strs, ",")
+}
@@ -10,12 +10,24 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for mint module.
 func QueryMint(i core.QueryClient) (string, error) {
+	res, err := QueryMintProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for mint module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryMintProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcMint(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryMint(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcMint(i core.QueryClient) (string, error) {
+func queryByGrpcMint(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := minttypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Mint inflation
@@ -46,7 +61,7 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Mint annual provisions
@@ -57,19 +72,14 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -78,33 +88,42 @@ const (
 	mintAnnualProvisionsLabel = "annual_provisions"
 )
 
-func queryByLcdMint(i core.QueryClient) (string, error) {
+func queryByLcdMint(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(mintv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Mint parameters
 	case i.Ixplac.GetMsgType() == MintQueryMintParamsMsgType:
+		res = &minttypes.QueryParamsResponse{}
 		url = url + mintParamsLabel
 
 	// Mint inflation
 	case i.Ixplac.GetMsgType() == MintQueryInflationMsgType:
+		res = &minttypes.QueryInflationResponse{}
 		url = url + mintInflationLabel
 
 	// Mint annual provisions
 	case i.Ixplac.GetMsgType() == MintQueryAnnualProvisionsMsgType:
+		res = &minttypes.QueryAnnualProvisionsResponse{}
 		url = url + mintAnnualProvisionsLabel
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
@@ -1,12 +1,14 @@
 package core
 
 import (
+	"encoding/base64"
+	neturl "net/url"
+
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
 )
 
-var PageRequest *query.PageRequest
-
 // Set default pagination.
 func DefaultPagination() *query.PageRequest {
 	return &query.PageRequest{
@@ -43,3 +45,33 @@ func ReadPageRequest(pagination types.Pagination) (*query.PageRequest, error) {
 		Reverse:    reverse,
 	}, nil
 }
+
+// Make the query string of the page request for the LCD, e.g. "?pagination.key=...&pagination.limit=10".
+// Fields which are not set are omitted, so the LCD uses its default values.
+func LcdPaginationQuery(pageRequest *query.PageRequest) string {
+	if pageRequest == nil {
+		return ""
+	}
+
+	params := neturl.Values{}
+	if len(pageRequest.Key) != 0 {
+		params.Set("pagination.key", base64.StdEncoding.EncodeToString(pageRequest.Key))
+	}
+	if pageRequest.Offset != 0 {
+		params.Set("pagination.offset", util.FromUint64ToString(pageRequest.Offset))
+	}
+	if pageRequest.Limit != 0 {
+		params.Set("pagination.limit", util.FromUint64ToString(pageRequest.Limit))
+	}
+	if pageRequest.CountTotal {
+		params.Set("pagination.count_total", "true")
+	}
+	if pageRequest.Reverse {
+		params.Set("pagination.reverse", "true")
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
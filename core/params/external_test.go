@@ -25,7 +25,7 @@ func (s *IntegrationTestSuite) TestParamsTx() {
 				"value": 105
 			}`,
 		},
-		Deposit: "1000",
+		Deposit: "1000axpla",
 	}
 	s.xplac.ParamChange(paramChangeMsg)
 
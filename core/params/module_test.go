@@ -35,7 +35,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 				"value": 105
 			}`,
 		},
-		Deposit: "1000",
+		Deposit: "1000axpla",
 	}
 
 	makeProposalParamChangeMsg, err := params.MakeProposalParamChangeMsg(paramChangeMsg, s.xplac.GetFromAddress(), s.xplac.GetEncoding())
@@ -38,7 +38,7 @@ func parseProposalParamChangeArgs(paramChangeMsg types.ParamChangeMsg, from sdk.
 		proposal.Changes = paramChangeJsons
 	}
 
-	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(proposal.Deposit))
+	deposit, err := util.ParseCoins(proposal.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -10,21 +10,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for params module.
 func QueryParams(i core.QueryClient) (string, error) {
+	res, err := QueryParamsProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for params module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryParamsProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcParams(i)
 	} else {
 		return queryByLcdParams(i)
 	}
-
 }
 
-func queryByGrpcParams(i core.QueryClient) (string, error) {
+func queryByGrpcParams(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := proposal.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,31 +50,29 @@ func queryByGrpcParams(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
 	paramsParamsLabel = "params"
 )
 
-func queryByLcdParams(i core.QueryClient) (string, error) {
+func queryByLcdParams(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(paramsv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Params subspace
 	case i.Ixplac.GetMsgType() == ParamsQuerySubpsaceMsgType:
+		res = &proposal.QueryParamsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(proposal.QueryParamsRequest)
 
 		parsedSubspace := convertMsg.Subspace
@@ -72,16 +84,20 @@ func queryByLcdParams(i core.QueryClient) (string, error) {
 		url = url + paramsParamsLabel + subspace + key
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
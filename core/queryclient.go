@@ -1,11 +1,15 @@
 package core
 
 import (
+	"bytes"
+
 	"github.com/xpladev/xpla.go/provider"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -28,6 +32,27 @@ func PrintProto(i QueryClient, toPrint proto.Message) ([]byte, error) {
 	return out, nil
 }
 
+// Unmarshal JSON response of the LCD to the protobuf message.
+// The response of the LCD is converted to the same type of the gRPC response,
+// thus the query result is not different regardless of communication protocol.
+func UnmarshalLcdResponse(i QueryClient, out []byte, res proto.Message) error {
+	interfaceRegistry := i.Ixplac.GetEncoding().InterfaceRegistry
+	unmarshaler := jsonpb.Unmarshaler{
+		AnyResolver:        interfaceRegistry,
+		AllowUnknownFields: true,
+	}
+
+	if err := unmarshaler.Unmarshal(bytes.NewReader(out), res); err != nil {
+		return types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+
+	if err := codectypes.UnpackInterfaces(res, interfaceRegistry); err != nil {
+		return types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+
+	return nil
+}
+
 // Print object by using cosmos sdk legacy amino.
 func PrintObjectLegacy(i QueryClient, toPrint interface{}) ([]byte, error) {
 	out, err := i.Ixplac.GetEncoding().Amino.MarshalJSON(toPrint)
@@ -17,7 +17,7 @@ func (s *IntegrationTestSuite) TestRewardTx() {
 	// fund fee collector
 	fundFeeCollectorMsg := types.FundFeeCollectorMsg{
 		DepositorAddr: accounts[0].Address.String(),
-		Amount:        "1000",
+		Amount:        "1000axpla",
 	}
 	s.xplac.FundFeeCollector(fundFeeCollectorMsg)
 
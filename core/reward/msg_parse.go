@@ -14,7 +14,7 @@ func parseFundFeeCollectorArgs(fundFeeCollectorMsg types.FundFeeCollectorMsg, fr
 		return rewardtypes.MsgFundFeeCollector{}, types.ErrWrap(types.ErrAccountNotMatch, "wrong depositor address, not match private key")
 	}
 
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(fundFeeCollectorMsg.Amount))
+	amount, err := util.ParseCoins(fundFeeCollectorMsg.Amount)
 	if err != nil {
 		return rewardtypes.MsgFundFeeCollector{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -9,12 +9,24 @@ import (
 	rewardtypes "github.com/xpladev/xpla/x/reward/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for reward module.
 func QueryReward(i core.QueryClient) (string, error) {
+	res, err := QueryRewardProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for reward module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryRewardProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcReward(i)
 	} else {
@@ -22,7 +34,10 @@ func QueryReward(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcReward(i core.QueryClient) (string, error) {
+func queryByGrpcReward(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := rewardtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -34,7 +49,7 @@ func queryByGrpcReward(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Reward pool
@@ -45,19 +60,14 @@ func queryByGrpcReward(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -65,30 +75,38 @@ const (
 	rewardPoolLabel   = "pool"
 )
 
-func queryByLcdReward(i core.QueryClient) (string, error) {
+func queryByLcdReward(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := "/xpla/reward/v1beta1/"
 
 	switch {
 	// Reward params
 	case i.Ixplac.GetMsgType() == RewardQueryRewardParamsMsgType:
+		res = &rewardtypes.QueryParamsResponse{}
 		url = url + rewardParamsLabel
 
 	// Reward pool
 	case i.Ixplac.GetMsgType() == RewardQueryRewardPoolMsgType:
+		res = &rewardtypes.QueryPoolResponse{}
 		url = url + rewardPoolLabel
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 
 }
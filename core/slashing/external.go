@@ -63,7 +63,7 @@ func (e SlashingExternal) SlashingParams() provider.XplaClient {
 func (e SlashingExternal) SigningInfos(signingInfoMsg ...types.SigningInfoMsg) provider.XplaClient {
 	switch {
 	case len(signingInfoMsg) == 0:
-		msg, err := MakeQuerySigningInfosMsg()
+		msg, err := MakeQuerySigningInfosMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(SlashingQuerySigningInfosMsgType, err)
 		}
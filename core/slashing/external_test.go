@@ -46,7 +46,7 @@ func (s *IntegrationTestSuite) TestSlashing() {
 	// signing infos
 	s.xplac.SigningInfos()
 
-	makeQuerySigningInfosMsg, err := mslashing.MakeQuerySigningInfosMsg()
+	makeQuerySigningInfosMsg, err := mslashing.MakeQuerySigningInfosMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQuerySigningInfosMsg, s.xplac.GetMsg())
@@ -1,10 +1,10 @@
 package slashing
 
 import (
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/xpladev/xpla/app/params"
 )
@@ -20,9 +20,9 @@ func MakeQuerySlashingParamsMsg() (slashingtypes.QueryParamsRequest, error) {
 }
 
 // (Query) make msg - signing infos
-func MakeQuerySigningInfosMsg() (slashingtypes.QuerySigningInfosRequest, error) {
+func MakeQuerySigningInfosMsg(pageRequest *query.PageRequest) (slashingtypes.QuerySigningInfosRequest, error) {
 	return slashingtypes.QuerySigningInfosRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
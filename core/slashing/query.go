@@ -10,12 +10,24 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for slashing module.
 func QuerySlashing(i core.QueryClient) (string, error) {
+	res, err := QuerySlashingProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for slashing module.
+// The response is returned as the protobuf message regardless of query type.
+func QuerySlashingProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcSlashing(i)
 	} else {
@@ -23,7 +35,10 @@ func QuerySlashing(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcSlashing(i core.QueryClient) (string, error) {
+func queryByGrpcSlashing(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := slashingtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Slashing signing information
@@ -46,7 +61,7 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Slashing signing information
@@ -57,19 +72,14 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -77,34 +87,43 @@ const (
 	slashingSigningInfosLabel = "signing_infos"
 )
 
-func queryByLcdSlashing(i core.QueryClient) (string, error) {
+func queryByLcdSlashing(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(slashingv1beta1.Query_ServiceDesc.Metadata.(string))
 	switch {
 	// Slashing parameters
 	case i.Ixplac.GetMsgType() == SlashingQuerySlashingParamsMsgType:
+		res = &slashingtypes.QueryParamsResponse{}
 		url = url + slashingParamsLabel
 
 	// Slashing signing information
 	case i.Ixplac.GetMsgType() == SlashingQuerySigningInfosMsgType:
+		res = &slashingtypes.QuerySigningInfosResponse{}
 		url = url + slashingSigningInfosLabel
 
 	// Slashing signing information
 	case i.Ixplac.GetMsgType() == SlashingQuerySigningInfoMsgType:
+		res = &slashingtypes.QuerySigningInfoResponse{}
 		convertMsg := i.Ixplac.GetMsg().(slashingtypes.QuerySigningInfoRequest)
 
 		url = url + util.MakeQueryLabels(slashingSigningInfosLabel, convertMsg.ConsAddress)
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
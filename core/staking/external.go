@@ -93,7 +93,7 @@ func (e StakingExternal) Redelegate(redelegateMsg types.RedelegateMsg) provider.
 func (e StakingExternal) QueryValidators(queryValidatorMsg ...types.QueryValidatorMsg) provider.XplaClient {
 	switch {
 	case len(queryValidatorMsg) == 0:
-		msg, err := MakeQueryValidatorsMsg()
+		msg, err := MakeQueryValidatorsMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryValidatorsMsgType, err)
 		}
@@ -125,7 +125,7 @@ func (e StakingExternal) QueryDelegation(queryDelegationMsg types.QueryDelegatio
 		return e.ToExternal(StakingQueryDelegationMsgType, msg)
 
 	case queryDelegationMsg.DelegatorAddr != "":
-		msg, err := MakeQueryDelegationsMsg(queryDelegationMsg)
+		msg, err := MakeQueryDelegationsMsg(queryDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryDelegationsMsgType, err)
 		}
@@ -133,7 +133,7 @@ func (e StakingExternal) QueryDelegation(queryDelegationMsg types.QueryDelegatio
 		return e.ToExternal(StakingQueryDelegationsMsgType, msg)
 
 	case queryDelegationMsg.ValidatorAddr != "":
-		msg, err := MakeQueryDelegationsToMsg(queryDelegationMsg)
+		msg, err := MakeQueryDelegationsToMsg(queryDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryDelegationsToMsgType, err)
 		}
@@ -157,7 +157,7 @@ func (e StakingExternal) QueryUnbondingDelegation(queryUnbondingDelegationMsg ty
 		return e.ToExternal(StakingQueryUnbondingDelegationMsgType, msg)
 
 	case queryUnbondingDelegationMsg.DelegatorAddr != "":
-		msg, err := MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg)
+		msg, err := MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryUnbondingDelegationsMsgType, err)
 		}
@@ -165,7 +165,7 @@ func (e StakingExternal) QueryUnbondingDelegation(queryUnbondingDelegationMsg ty
 		return e.ToExternal(StakingQueryUnbondingDelegationsMsgType, msg)
 
 	case queryUnbondingDelegationMsg.ValidatorAddr != "":
-		msg, err := MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg)
+		msg, err := MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryUnbondingDelegationsFromMsgType, err)
 		}
@@ -193,7 +193,7 @@ func (e StakingExternal) QueryRedelegation(queryRedelegationMsg types.QueryRedel
 		return e.ToExternal(StakingQueryRedelegationMsgType, msg)
 
 	case queryRedelegationMsg.DelegatorAddr != "":
-		msg, err := MakeQueryRedelegationsMsg(queryRedelegationMsg)
+		msg, err := MakeQueryRedelegationsMsg(queryRedelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryRedelegationsMsgType, err)
 		}
@@ -201,7 +201,7 @@ func (e StakingExternal) QueryRedelegation(queryRedelegationMsg types.QueryRedel
 		return e.ToExternal(StakingQueryRedelegationsMsgType, msg)
 
 	case queryRedelegationMsg.SrcValidatorAddr != "":
-		msg, err := MakeQueryRedelegationsFromMsg(queryRedelegationMsg)
+		msg, err := MakeQueryRedelegationsFromMsg(queryRedelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return e.Err(StakingQueryRedelegationsFromMsgType, err)
 		}
@@ -70,7 +70,7 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 
 	// delegate
 	delegateMsg := types.DelegateMsg{
-		Amount:  "1000",
+		Amount:  "1000axpla",
 		ValAddr: sdk.ValAddress(account0.Address).String(),
 	}
 	s.xplac.Delegate(delegateMsg)
@@ -89,9 +89,16 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 	s.Require().NoError(err)
 	s.Require().Equal(testutil.StakingDelegateTxTemplates, string(stakingDelegateJsonTxbytes))
 
+	// the amount must have the denom
+	_, err = mstaking.MakeDelegateMsg(types.DelegateMsg{
+		Amount:  "1000",
+		ValAddr: delegateMsg.ValAddr,
+	}, s.xplac.GetFromAddress())
+	s.Require().Error(err)
+
 	// unbonding
 	unbondMsg := types.UnbondMsg{
-		Amount:  "1000",
+		Amount:  "1000axpla",
 		ValAddr: sdk.ValAddress(account0.Address).String(),
 	}
 	s.xplac.Unbond(unbondMsg)
@@ -112,7 +119,7 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 
 	// redelegation
 	redelegateMsg := types.RedelegateMsg{
-		Amount:     "1000",
+		Amount:     "1000axpla",
 		ValSrcAddr: sdk.ValAddress(account0.Address).String(),
 		ValDstAddr: sdk.ValAddress(account1.Address).String(),
 	}
@@ -141,7 +148,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 	// query validators
 	s.xplac.QueryValidators()
 
-	makeQueryValidatorsMsg, err := mstaking.MakeQueryValidatorsMsg()
+	makeQueryValidatorsMsg, err := mstaking.MakeQueryValidatorsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryValidatorsMsg, s.xplac.GetMsg())
@@ -184,7 +191,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryDelegation(queryDelegationMsg)
 
-	makeQueryDelegationsMsg, err := mstaking.MakeQueryDelegationsMsg(queryDelegationMsg)
+	makeQueryDelegationsMsg, err := mstaking.MakeQueryDelegationsMsg(queryDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDelegationsMsg, s.xplac.GetMsg())
@@ -198,7 +205,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryDelegation(queryDelegationMsg)
 
-	makeQueryDelegationsToMsg, err := mstaking.MakeQueryDelegationsToMsg(queryDelegationMsg)
+	makeQueryDelegationsToMsg, err := mstaking.MakeQueryDelegationsToMsg(queryDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDelegationsToMsg, s.xplac.GetMsg())
@@ -227,7 +234,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
 
-	makeQueryUnbondingDelegationsMsg, err := mstaking.MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg)
+	makeQueryUnbondingDelegationsMsg, err := mstaking.MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryUnbondingDelegationsMsg, s.xplac.GetMsg())
@@ -241,7 +248,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
 
-	makeQueryUnbondingDelegationsFromMsg, err := mstaking.MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg)
+	makeQueryUnbondingDelegationsFromMsg, err := mstaking.MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryUnbondingDelegationsFromMsg, s.xplac.GetMsg())
@@ -271,7 +278,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryRedelegation(queryRedelegationMsg)
 
-	makeQueryRedelegationsMsg, err := mstaking.MakeQueryRedelegationsMsg(queryRedelegationMsg)
+	makeQueryRedelegationsMsg, err := mstaking.MakeQueryRedelegationsMsg(queryRedelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryRedelegationsMsg, s.xplac.GetMsg())
@@ -285,7 +292,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 
 	s.xplac.QueryRedelegation(queryRedelegationMsg)
 
-	makeQueryRedelegationsFromMsg, err := mstaking.MakeQueryRedelegationsFromMsg(queryRedelegationMsg)
+	makeQueryRedelegationsFromMsg, err := mstaking.MakeQueryRedelegationsFromMsg(queryRedelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryRedelegationsFromMsg, s.xplac.GetMsg())
@@ -5,8 +5,8 @@ import (
 	"github.com/xpladev/xpla.go/types"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 type coreModule struct{}
@@ -22,8 +22,12 @@ func (c *coreModule) Name() string {
 func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder, msgType string, msg interface{}) (cmclient.TxBuilder, error) {
 	switch {
 	case msgType == StakingCreateValidatorMsgType:
-		convertMsg := msg.(sdk.Msg)
-		builder.SetMsgs(convertMsg)
+		convertMsg := msg.(CreateValidatorParseMsg)
+		err := builder.SetMsgs(convertMsg.Msg)
+		if err != nil {
+			return nil, logger.Err(err)
+		}
+		builder.SetMemo(convertMsg.Memo)
 
 	case msgType == StakingEditValidatorMsgType:
 		convertMsg := msg.(stakingtypes.MsgEditValidator)
@@ -63,3 +67,7 @@ func (c *coreModule) NewTxRouter(logger types.Logger, builder cmclient.TxBuilder
 func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
 	return QueryStaking(q)
 }
+
+func (c *coreModule) NewQueryProtoRouter(q core.QueryClient) (proto.Message, error) {
+	return QueryStakingProto(q)
+}
@@ -71,7 +71,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 
 	// delegate
 	delegateMsg := types.DelegateMsg{
-		Amount:  "1000",
+		Amount:  "1000axpla",
 		ValAddr: sdk.ValAddress(accounts[0].Address).String(),
 	}
 
@@ -85,7 +85,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 
 	// unbonding
 	unbondMsg := types.UnbondMsg{
-		Amount:  "1000",
+		Amount:  "1000axpla",
 		ValAddr: sdk.ValAddress(accounts[0].Address).String(),
 	}
 
@@ -99,7 +99,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 
 	// redelegation
 	redelegateMsg := types.RedelegateMsg{
-		Amount:     "1000",
+		Amount:     "1000axpla",
 		ValSrcAddr: sdk.ValAddress(accounts[0].Address).String(),
 		ValDstAddr: sdk.ValAddress(accounts[1].Address).String(),
 	}
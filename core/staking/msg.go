@@ -2,13 +2,13 @@ package staking
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-	"github.com/xpladev/xpla.go/core"
 	"github.com/xpladev/xpla.go/types"
 )
 
 // (Tx) make msg - create validator
-func MakeCreateValidatorMsg(createValidatorMsg types.CreateValidatorMsg, from sdk.AccAddress, output string) (sdk.Msg, error) {
+func MakeCreateValidatorMsg(createValidatorMsg types.CreateValidatorMsg, from sdk.AccAddress, output string) (CreateValidatorParseMsg, error) {
 	return parseCreateValidatorArgs(createValidatorMsg, from, output)
 }
 
@@ -40,9 +40,9 @@ func MakeQueryValidatorMsg(queryValidatorMsg types.QueryValidatorMsg) (stakingty
 }
 
 // (Query) make msg - validators
-func MakeQueryValidatorsMsg() (stakingtypes.QueryValidatorsRequest, error) {
+func MakeQueryValidatorsMsg(pageRequest *query.PageRequest) (stakingtypes.QueryValidatorsRequest, error) {
 	return stakingtypes.QueryValidatorsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -55,18 +55,18 @@ func MakeQueryDelegationMsg(queryDelegationMsg types.QueryDelegationMsg) (stakin
 }
 
 // (Query) make msg - query delegations
-func MakeQueryDelegationsMsg(queryDelegationMsg types.QueryDelegationMsg) (stakingtypes.QueryDelegatorDelegationsRequest, error) {
+func MakeQueryDelegationsMsg(queryDelegationMsg types.QueryDelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryDelegatorDelegationsRequest, error) {
 	return stakingtypes.QueryDelegatorDelegationsRequest{
 		DelegatorAddr: queryDelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageRequest,
 	}, nil
 }
 
 // (Query) make msg - query delegations to
-func MakeQueryDelegationsToMsg(queryDelegationMsg types.QueryDelegationMsg) (stakingtypes.QueryValidatorDelegationsRequest, error) {
+func MakeQueryDelegationsToMsg(queryDelegationMsg types.QueryDelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryValidatorDelegationsRequest, error) {
 	return stakingtypes.QueryValidatorDelegationsRequest{
 		ValidatorAddr: queryDelegationMsg.ValidatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageRequest,
 	}, nil
 }
 
@@ -79,18 +79,18 @@ func MakeQueryUnbondingDelegationMsg(queryUnbondingDelegationMsg types.QueryUnbo
 }
 
 // (Query) make msg - query unbonding delegations
-func MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg) (stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+func MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
 	return stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
 		DelegatorAddr: queryUnbondingDelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageRequest,
 	}, nil
 }
 
 // (Query) make msg - query unbonding delegations from
-func MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg) (stakingtypes.QueryValidatorUnbondingDelegationsRequest, error) {
+func MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryValidatorUnbondingDelegationsRequest, error) {
 	return stakingtypes.QueryValidatorUnbondingDelegationsRequest{
 		ValidatorAddr: queryUnbondingDelegationMsg.ValidatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageRequest,
 	}, nil
 }
 
@@ -108,18 +108,18 @@ func MakeQueryRedelegationMsg(queryRedelegationMsg types.QueryRedelegationMsg) (
 }
 
 // (Query) make msg - query redelegations
-func MakeQueryRedelegationsMsg(queryRedelegationMsg types.QueryRedelegationMsg) (stakingtypes.QueryRedelegationsRequest, error) {
+func MakeQueryRedelegationsMsg(queryRedelegationMsg types.QueryRedelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryRedelegationsRequest, error) {
 	return stakingtypes.QueryRedelegationsRequest{
 		DelegatorAddr: queryRedelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageRequest,
 	}, nil
 }
 
 // (Query) make msg - query redelegations from
-func MakeQueryRedelegationsFromMsg(queryRedelegationMsg types.QueryRedelegationMsg) (stakingtypes.QueryRedelegationsRequest, error) {
+func MakeQueryRedelegationsFromMsg(queryRedelegationMsg types.QueryRedelegationMsg, pageRequest *query.PageRequest) (stakingtypes.QueryRedelegationsRequest, error) {
 	return stakingtypes.QueryRedelegationsRequest{
 		SrcValidatorAddr: queryRedelegationMsg.SrcValidatorAddr,
-		Pagination:       core.PageRequest,
+		Pagination:       pageRequest,
 	}, nil
 }
 
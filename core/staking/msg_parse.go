@@ -24,7 +24,7 @@ func parseCreateValidatorArgs(
 	createValidatorMsg types.CreateValidatorMsg,
 	from sdk.AccAddress,
 	output string,
-) (sdk.Msg, error) {
+) (CreateValidatorParseMsg, error) {
 
 	var nodeId string
 	var valPubKey cryptotypes.PubKey
@@ -35,17 +35,17 @@ func parseCreateValidatorArgs(
 	addrStr := createValidatorMsg.ValidatorAddress
 	addr, err := sdk.ValAddressFromBech32(addrStr)
 	if err != nil {
-		return nil, types.ErrWrap(types.ErrParse, err)
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrParse, err)
 	}
 
 	if privKeyValAddr.String() != addr.String() {
-		return nil, types.ErrWrap(types.ErrAccountNotMatch, "CreateValidatorMsg.ValidatorAddress and validator address generated by using private key are not same")
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrAccountNotMatch, "CreateValidatorMsg.ValidatorAddress and validator address generated by using private key are not same")
 	}
 
 	if createValidatorMsg.NodeKey != "" && createValidatorMsg.PrivValidatorKey != "" {
 		nodeId, valPubKey, err = initializedNodeValidatorString(createValidatorMsg.NodeKey, createValidatorMsg.PrivValidatorKey)
 		if err != nil {
-			return nil, err
+			return CreateValidatorParseMsg{}, err
 		}
 	} else {
 		serverCtx := server.NewDefaultContext()
@@ -54,16 +54,16 @@ func parseCreateValidatorArgs(
 
 		nodeId, valPubKey, err = genutil.InitializeNodeValidatorFiles(serverCtx.Config)
 		if err != nil {
-			return nil, types.ErrWrap(types.ErrParse, err)
+			return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrParse, err)
 		}
 	}
 
 	ip, err := getIP(createValidatorMsg.ServerIp)
 	if err != nil {
-		return nil, types.ErrWrap(types.ErrParse, err)
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrParse, err)
 	}
 
-	types.Memo = fmt.Sprintf("%s@%s:26656", nodeId, ip)
+	memo := fmt.Sprintf("%s@%s:26656", nodeId, ip)
 
 	website := createValidatorMsg.Website
 	securityContact := createValidatorMsg.SecurityContact
@@ -109,26 +109,29 @@ func parseCreateValidatorArgs(
 	description := stakingtypes.NewDescription(
 		moniker, identity, website, securityContact, details)
 
-	amountCoins, err := sdk.ParseCoinNormalized(amount)
+	amountCoins, err := util.ParseCoin(amount)
 	if err != nil {
-		return nil, types.ErrWrap(types.ErrParse, err)
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrParse, err)
 	}
 
 	buildCRates, err := buildCommissionRates(commissionRate, commissionMaxRate, commissionMaxChangeRate)
 	if err != nil {
-		return nil, err
+		return CreateValidatorParseMsg{}, err
 	}
 
 	intMinSelfDelegation, ok := sdk.NewIntFromString(minSelfDelegation)
 	if !ok {
-		return nil, types.ErrWrap(types.ErrConvert, "wrong minSelfDelegation")
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrConvert, "wrong minSelfDelegation")
 	}
 
 	msg, err := stakingtypes.NewMsgCreateValidator(addr, valPubKey, amountCoins, description, buildCRates, intMinSelfDelegation)
 	if err != nil {
-		return nil, types.ErrWrap(types.ErrParse, err)
+		return CreateValidatorParseMsg{}, types.ErrWrap(types.ErrParse, err)
 	}
-	return msg, nil
+	return CreateValidatorParseMsg{
+		Msg:  msg,
+		Memo: memo,
+	}, nil
 }
 
 // Parsing - edit validator
@@ -169,7 +172,7 @@ func parseEditValidatorArgs(editValidatorMsg types.EditValidatorMsg, addr sdk.Ac
 
 // Parsing - delegate
 func parseDelegateArgs(delegateMsg types.DelegateMsg, delAddr sdk.AccAddress) (stakingtypes.MsgDelegate, error) {
-	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(delegateMsg.Amount))
+	amount, err := util.ParseCoin(delegateMsg.Amount)
 	if err != nil {
 		return stakingtypes.MsgDelegate{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -186,7 +189,7 @@ func parseDelegateArgs(delegateMsg types.DelegateMsg, delAddr sdk.AccAddress) (s
 
 // Parsing - unbond
 func parseUnbondArgs(unbondMsg types.UnbondMsg, delAddr sdk.AccAddress) (stakingtypes.MsgUndelegate, error) {
-	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(unbondMsg.Amount))
+	amount, err := util.ParseCoin(unbondMsg.Amount)
 	if err != nil {
 		return stakingtypes.MsgUndelegate{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -203,7 +206,7 @@ func parseUnbondArgs(unbondMsg types.UnbondMsg, delAddr sdk.AccAddress) (staking
 
 // Parsing - redelegate
 func parseRedelegateArgs(redelegateMsg types.RedelegateMsg, delAddr sdk.AccAddress) (stakingtypes.MsgBeginRedelegate, error) {
-	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(redelegateMsg.Amount))
+	amount, err := util.ParseCoin(redelegateMsg.Amount)
 	if err != nil {
 		return stakingtypes.MsgBeginRedelegate{}, types.ErrWrap(types.ErrParse, err)
 	}
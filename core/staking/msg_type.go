@@ -1,5 +1,9 @@
 package staking
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	StakingModule                               = "staking"
 	StakingCreateValidatorMsgType               = "create-validator"
@@ -22,3 +26,9 @@ const (
 	StakingQueryStakingPoolMsgType              = "query-staking-pool"
 	StakingQueryStakingParamsMsgType            = "query-staking-params"
 )
+
+// The memo of the create validator transaction is the peer address of the validator node.
+type CreateValidatorParseMsg struct {
+	Msg  sdk.Msg
+	Memo string
+}
@@ -10,12 +10,24 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for staking module.
 func QueryStaking(i core.QueryClient) (string, error) {
+	res, err := QueryStakingProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for staking module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryStakingProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcStaking(i)
 	} else {
@@ -23,7 +35,10 @@ func QueryStaking(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcStaking(i core.QueryClient) (string, error) {
+func queryByGrpcStaking(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := stakingtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +50,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking validators
@@ -46,7 +61,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking delegation
@@ -57,7 +72,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking delegations
@@ -68,7 +83,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking delegations to
@@ -79,7 +94,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking unbonding delegation
@@ -90,7 +105,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking unbonding delegations
@@ -101,7 +116,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking unbonding delegations from
@@ -112,7 +127,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking redelegations
@@ -125,7 +140,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking historical information
@@ -136,7 +151,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking pool
@@ -147,7 +162,7 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Staking params
@@ -158,19 +173,14 @@ func queryByGrpcStaking(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -185,52 +195,62 @@ const (
 	stakingParamsLabel               = "params"
 )
 
-func queryByLcdStaking(i core.QueryClient) (string, error) {
+func queryByLcdStaking(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(stakingv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Skating validator
 	case i.Ixplac.GetMsgType() == StakingQueryValidatorMsgType:
+		res = &stakingtypes.QueryValidatorResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryValidatorRequest)
 
 		url = url + util.MakeQueryLabels(stakingValidatorsLabel, convertMsg.ValidatorAddr)
 
 	// Staking validators
 	case i.Ixplac.GetMsgType() == StakingQueryValidatorsMsgType:
+		res = &stakingtypes.QueryValidatorsResponse{}
 		url = url + stakingValidatorsLabel
 
 	// Staking delegation
 	case i.Ixplac.GetMsgType() == StakingQueryDelegationMsgType:
+		res = &stakingtypes.QueryDelegationResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryDelegationRequest)
 
-		url = url + util.MakeQueryLabels(stakingDelegatorsLabel, convertMsg.DelegatorAddr, stakingValidatorsLabel, convertMsg.ValidatorAddr)
+		url = url + util.MakeQueryLabels(stakingValidatorsLabel, convertMsg.ValidatorAddr, stakingDelegationsLabel, convertMsg.DelegatorAddr)
 
 	// Staking delegations
 	case i.Ixplac.GetMsgType() == StakingQueryDelegationsMsgType:
+		res = &stakingtypes.QueryDelegatorDelegationsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryDelegatorDelegationsRequest)
 
-		url = url + util.MakeQueryLabels(stakingDelegatorsLabel, convertMsg.DelegatorAddr, stakingValidatorsLabel)
+		url = url + util.MakeQueryLabels(stakingDelegationsLabel, convertMsg.DelegatorAddr)
 
 	// Staking delegations to
 	case i.Ixplac.GetMsgType() == StakingQueryDelegationsToMsgType:
+		res = &stakingtypes.QueryValidatorDelegationsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryValidatorDelegationsRequest)
 
 		url = url + util.MakeQueryLabels(stakingValidatorsLabel, convertMsg.ValidatorAddr, stakingDelegationsLabel)
 
 	// Staking unbonding delegation
 	case i.Ixplac.GetMsgType() == StakingQueryUnbondingDelegationMsgType:
+		res = &stakingtypes.QueryUnbondingDelegationResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryUnbondingDelegationRequest)
 
 		url = url + util.MakeQueryLabels(stakingValidatorsLabel, convertMsg.ValidatorAddr, stakingDelegationsLabel, convertMsg.DelegatorAddr, stakingUnbondingDelegationLabel)
 
 	// Staking unbonding delegations
 	case i.Ixplac.GetMsgType() == StakingQueryUnbondingDelegationsMsgType:
+		res = &stakingtypes.QueryDelegatorUnbondingDelegationsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryDelegatorUnbondingDelegationsRequest)
 
 		url = url + util.MakeQueryLabels(stakingDelegatorsLabel, convertMsg.DelegatorAddr, stakingUnbondingDelegationsLabel)
 
 	// Staking unbonding delegations from
 	case i.Ixplac.GetMsgType() == StakingQueryUnbondingDelegationsFromMsgType:
+		res = &stakingtypes.QueryValidatorUnbondingDelegationsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryValidatorUnbondingDelegationsRequest)
 
 		url = url + util.MakeQueryLabels(stakingValidatorsLabel, convertMsg.ValidatorAddr, stakingUnbondingDelegationsLabel)
@@ -239,39 +259,47 @@ func queryByLcdStaking(i core.QueryClient) (string, error) {
 	case i.Ixplac.GetMsgType() == StakingQueryRedelegationMsgType ||
 		i.Ixplac.GetMsgType() == StakingQueryRedelegationsFromMsgType:
 
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying delegations by using LCD. query delegations of a delegator"))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported querying delegations by using LCD. query delegations of a delegator"))
 
 	// Staking redelegations
 	case i.Ixplac.GetMsgType() == StakingQueryRedelegationsMsgType:
+		res = &stakingtypes.QueryRedelegationsResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryRedelegationsRequest)
 
 		url = url + util.MakeQueryLabels(stakingDelegatorsLabel, convertMsg.DelegatorAddr, stakingRedelegationsLabel)
 
 	// Staking historical information
 	case i.Ixplac.GetMsgType() == StakingHistoricalInfoMsgType:
+		res = &stakingtypes.QueryHistoricalInfoResponse{}
 		convertMsg := i.Ixplac.GetMsg().(stakingtypes.QueryHistoricalInfoRequest)
 
 		url = url + util.MakeQueryLabels(stakingHistoricalInfoLabel, util.FromInt64ToString(convertMsg.Height))
 
 	// Staking pool
 	case i.Ixplac.GetMsgType() == StakingQueryStakingPoolMsgType:
+		res = &stakingtypes.QueryPoolResponse{}
 		url = url + stakingPoolLabel
 
 	// Staking params
 	case i.Ixplac.GetMsgType() == StakingQueryStakingParamsMsgType:
+		res = &stakingtypes.QueryParamsResponse{}
 		url = url + stakingParamsLabel
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
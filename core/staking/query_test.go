@@ -144,28 +144,19 @@ func (s *IntegrationTestSuite) TestDelegation() {
 	for i, api := range s.apis {
 		if i == 0 {
 			s.xplac.WithURL(api)
-
-			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
-			s.Require().NoError(err)
-
-			var queryValidatorResponse stakingtypes.QueryValidatorResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryValidatorResponse)
-
-			s.Require().Equal(val2.ValAddress.String(), queryValidatorResponse.Validator.OperatorAddress)
-
 		} else {
 			s.xplac.WithGrpc(api)
+		}
 
-			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
-			s.Require().NoError(err)
+		res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
+		s.Require().NoError(err)
 
-			var queryDelegationResponse stakingtypes.QueryDelegationResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryDelegationResponse)
+		var queryDelegationResponse stakingtypes.QueryDelegationResponse
+		jsonpb.Unmarshal(strings.NewReader(res), &queryDelegationResponse)
 
-			s.Require().Equal(val1.Address.String(), queryDelegationResponse.DelegationResponse.Delegation.DelegatorAddress)
-			s.Require().Equal("1010.000000000000000000", queryDelegationResponse.DelegationResponse.Delegation.Shares.String())
-			s.Require().Equal("1010", queryDelegationResponse.DelegationResponse.Balance.Amount.String())
-		}
+		s.Require().Equal(val1.Address.String(), queryDelegationResponse.DelegationResponse.Delegation.DelegatorAddress)
+		s.Require().Equal("1010.000000000000000000", queryDelegationResponse.DelegationResponse.Delegation.Shares.String())
+		s.Require().Equal("1010", queryDelegationResponse.DelegationResponse.Balance.Amount.String())
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
@@ -182,26 +173,17 @@ func (s *IntegrationTestSuite) TestDelegationDelegator() {
 	for i, api := range s.apis {
 		if i == 0 {
 			s.xplac.WithURL(api)
-
-			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
-			s.Require().NoError(err)
-
-			var queryValidatorsResponse stakingtypes.QueryValidatorsResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryValidatorsResponse)
-
-			s.Require().Equal(2, len(queryValidatorsResponse.Validators))
-
 		} else {
 			s.xplac.WithGrpc(api)
+		}
 
-			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
-			s.Require().NoError(err)
+		res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
+		s.Require().NoError(err)
 
-			var queryDelegatorDelegationsResponse stakingtypes.QueryDelegatorDelegationsResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryDelegatorDelegationsResponse)
+		var queryDelegatorDelegationsResponse stakingtypes.QueryDelegatorDelegationsResponse
+		jsonpb.Unmarshal(strings.NewReader(res), &queryDelegatorDelegationsResponse)
 
-			s.Require().Equal(2, len(queryDelegatorDelegationsResponse.DelegationResponses))
-		}
+		s.Require().Equal(2, len(queryDelegatorDelegationsResponse.DelegationResponses))
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
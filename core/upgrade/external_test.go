@@ -21,7 +21,7 @@ func (s *IntegrationTestSuite) TestUpgradeTx() {
 		Description:   "Upgrade Description",
 		UpgradeHeight: "6000",
 		UpgradeInfo:   `{"upgrade_info":"INFO"}`,
-		Deposit:       "1000",
+		Deposit:       "1000axpla",
 	}
 	s.xplac.SoftwareUpgrade(softwareUpgradeMsg)
 
@@ -43,7 +43,7 @@ func (s *IntegrationTestSuite) TestUpgradeTx() {
 	cancelSoftwareUpgradeMsg := types.CancelSoftwareUpgradeMsg{
 		Title:       "Cancel software upgrade",
 		Description: "Cancel software upgrade description",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 	}
 	s.xplac.CancelSoftwareUpgrade(cancelSoftwareUpgradeMsg)
 
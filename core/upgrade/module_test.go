@@ -31,7 +31,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Description:   "Upgrade Description",
 		UpgradeHeight: "6000",
 		UpgradeInfo:   `{"upgrade_info":"INFO"}`,
-		Deposit:       "1000",
+		Deposit:       "1000axpla",
 	}
 
 	makeProposalSoftwareUpgradeMsg, err := upgrade.MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, s.xplac.GetFromAddress())
@@ -46,7 +46,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	cancelSoftwareUpgradeMsg := types.CancelSoftwareUpgradeMsg{
 		Title:       "Cancel software upgrade",
 		Description: "Cancel software upgrade description",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 	}
 
 	makeCancelSoftwareUpgradeMsg, err := upgrade.MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, s.xplac.GetFromAddress())
@@ -26,7 +26,7 @@ func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMs
 		plan,
 	)
 
-	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(softwareUpgradeMsg.Deposit))
+	deposit, err := util.ParseCoins(softwareUpgradeMsg.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -41,12 +41,12 @@ func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMs
 
 // Parsing - cancel software upgrade
 func parseCancelSoftwareUpgradeArgs(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg, from sdk.AccAddress) (govtypes.MsgSubmitProposal, error) {
-	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(cancelSoftwareUpgradeMsg.Deposit))
+	deposit, err := util.ParseCoins(cancelSoftwareUpgradeMsg.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
 	content := upgradetypes.NewCancelSoftwareUpgradeProposal(
-		cancelSoftwareUpgradeMsg.Deposit,
+		cancelSoftwareUpgradeMsg.Title,
 		cancelSoftwareUpgradeMsg.Description,
 	)
 
@@ -13,12 +13,38 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for upgrade module.
 func QueryUpgrade(i core.QueryClient) (string, error) {
+	res, err := QueryUpgradeProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	// The applied plan is returned with the block header of the upgrade height.
+	if i.Ixplac.GetMsgType() == UpgradeAppliedMsgType {
+		appliedPlanRes := res.(*upgradetypes.QueryAppliedPlanResponse)
+		if appliedPlanRes.Height == 0 {
+			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "applied plan height is 0"))
+		}
+
+		headerData, err := appliedReturnBlockheader(appliedPlanRes, i.Ixplac.GetRpc(), i.Ixplac.GetContext())
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(err)
+		}
+		return string(headerData), nil
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for upgrade module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryUpgradeProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcUpgrade(i)
 	} else {
@@ -26,29 +52,23 @@ func QueryUpgrade(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
+func queryByGrpcUpgrade(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := upgradetypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
 	// Upgrade applied
 	case i.Ixplac.GetMsgType() == UpgradeAppliedMsgType:
 		convertMsg := i.Ixplac.GetMsg().(upgradetypes.QueryAppliedPlanRequest)
-		appliedPlanRes, err := queryClient.AppliedPlan(
+		res, err = queryClient.AppliedPlan(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
-		}
-
-		if appliedPlanRes.Height == 0 {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "applied plan height is 0"))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
-		headerData, err := appliedReturnBlockheader(appliedPlanRes, i.Ixplac.GetRpc(), i.Ixplac.GetContext())
-		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(err)
-		}
-		return string(headerData), nil
 
 	// Upgrade all module versions
 	case i.Ixplac.GetMsgType() == UpgradeQueryAllModuleVersionsMsgType ||
@@ -59,7 +79,7 @@ func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Upgrade plan
@@ -70,19 +90,14 @@ func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
-	}
-
-	return string(out), nil
+	return res, nil
 }
 
 const (
@@ -91,12 +106,15 @@ const (
 	upgradeCurrentPlanLabel    = "current_plan"
 )
 
-func queryByLcdUpgrade(i core.QueryClient) (string, error) {
+func queryByLcdUpgrade(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := util.MakeQueryLcdUrl(upgradev1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
 	// Upgrade applied
 	case i.Ixplac.GetMsgType() == UpgradeAppliedMsgType:
+		res = &upgradetypes.QueryAppliedPlanResponse{}
 		convertMsg := i.Ixplac.GetMsg().(upgradetypes.QueryAppliedPlanRequest)
 
 		url = url + util.MakeQueryLabels(upgradeAppliedPlanLabel, convertMsg.Name)
@@ -104,26 +122,31 @@ func queryByLcdUpgrade(i core.QueryClient) (string, error) {
 	// Upgrade all module versions
 	case i.Ixplac.GetMsgType() == UpgradeQueryAllModuleVersionsMsgType ||
 		i.Ixplac.GetMsgType() == UpgradeQueryModuleVersionsMsgType:
-
+		res = &upgradetypes.QueryModuleVersionsResponse{}
 		url = url + upgradeModuleVersionsLabel
 
 	// Upgrade plan
 	case i.Ixplac.GetMsgType() == UpgradePlanMsgType:
+		res = &upgradetypes.QueryCurrentPlanResponse{}
 		url = url + upgradeCurrentPlanLabel
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
 
 func appliedReturnBlockheader(res *upgradetypes.QueryAppliedPlanResponse, rpcUrl string, ctx context.Context) ([]byte, error) {
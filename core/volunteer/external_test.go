@@ -16,8 +16,8 @@ func (s *IntegrationTestSuite) TestVolunteerTx() {
 	registerVolunteerValidatorMsg := types.RegisterVolunteerValidatorMsg{
 		Title:       "register volunteer validator",
 		Description: "register description",
-		Deposit:     "1000",
-		Amount:      "10000",
+		Deposit:     "1000axpla",
+		Amount:      "10000axpla",
 		ValPubKey:   `{"@type": "/cosmos.crypto.ed25519.PubKey", "key": "2z2yttKfEsLQyQnHYdgKEuky9zB3gscxapn9IyexxWk="}`,
 		Moniker:     "volun moniker",
 		Identity:    "volun identity",
@@ -41,7 +41,7 @@ func (s *IntegrationTestSuite) TestVolunteerTx() {
 	unregisterVolunteerValidatorMsg := types.UnregisterVolunteerValidatorMsg{
 		Title:       "register volunteer validator",
 		Description: "register description",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 		ValAddress:  tmpVal.String(),
 	}
 	s.xplac.UnregisterVolunteerValidator(unregisterVolunteerValidatorMsg)
@@ -29,8 +29,8 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	registerVolunteerValidatorMsg := types.RegisterVolunteerValidatorMsg{
 		Title:       "register volunteer validator",
 		Description: "register description",
-		Deposit:     "1000",
-		Amount:      "10000",
+		Deposit:     "1000axpla",
+		Amount:      "10000axpla",
 		ValPubKey:   `{"@type": "/cosmos.crypto.ed25519.PubKey", "key": "2z2yttKfEsLQyQnHYdgKEuky9zB3gscxapn9IyexxWk="}`,
 		Moniker:     "volun moniker",
 		Identity:    "volun identity",
@@ -53,7 +53,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	unregisterVolunteerValidatorMsg := types.UnregisterVolunteerValidatorMsg{
 		Title:       "register volunteer validator",
 		Description: "register description",
-		Deposit:     "1000",
+		Deposit:     "1000axpla",
 		ValAddress:  tmpVal.String(),
 	}
 
@@ -30,15 +30,15 @@ func parseRegisterVolunteerValidatorArgs(
 	} else {
 		proposal.Title = registerVolunteerValidatorMsg.Title
 		proposal.Description = registerVolunteerValidatorMsg.Description
-		proposal.Deposit = util.DenomAdd(registerVolunteerValidatorMsg.Deposit)
+		proposal.Deposit = registerVolunteerValidatorMsg.Deposit
 	}
 
-	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	deposit, err := util.ParseCoins(proposal.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
 
-	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(registerVolunteerValidatorMsg.Amount))
+	amount, err := util.ParseCoin(registerVolunteerValidatorMsg.Amount)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -90,11 +90,11 @@ func parseUnregisterVolunteerValidatorArgs(unregisterVolunteerValidatorMsg types
 	} else {
 		proposal.Title = unregisterVolunteerValidatorMsg.Title
 		proposal.Description = unregisterVolunteerValidatorMsg.Description
-		proposal.Deposit = util.DenomAdd(unregisterVolunteerValidatorMsg.Deposit)
+		proposal.Deposit = unregisterVolunteerValidatorMsg.Deposit
 		proposal.ValidatorAddress = unregisterVolunteerValidatorMsg.ValAddress
 	}
 
-	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	deposit, err := util.ParseCoins(proposal.Deposit)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, types.ErrWrap(types.ErrParse, err)
 	}
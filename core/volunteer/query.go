@@ -9,12 +9,24 @@ import (
 	volunteertypes "github.com/xpladev/xpla/x/volunteer/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for volunteer module.
 func QueryVolunteer(i core.QueryClient) (string, error) {
+	res, err := QueryVolunteerProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for volunteer module.
+// The response is returned as the protobuf message regardless of query type.
+func QueryVolunteerProto(i core.QueryClient) (proto.Message, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcVolunteer(i)
 	} else {
@@ -22,7 +34,10 @@ func QueryVolunteer(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcVolunteer(i core.QueryClient) (string, error) {
+func queryByGrpcVolunteer(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := volunteertypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -34,44 +49,46 @@ func queryByGrpcVolunteer(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	return string(out), nil
+	return res, nil
 }
 
 const (
 	volunteerQueryValidatorsLabel = "validators"
 )
 
-func queryByLcdVolunteer(i core.QueryClient) (string, error) {
+func queryByLcdVolunteer(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := "/xpla/volunteer/v1beta1/"
 
 	switch {
 	// Skating validator
 	case i.Ixplac.GetMsgType() == VolunteerQueryValidatorsMsgType:
+		res = &volunteertypes.QueryVolunteerValidatorsResponse{}
 		url = url + util.MakeQueryLabels(volunteerQueryValidatorsLabel)
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
+
+	return res, nil
 }
@@ -0,0 +1,108 @@
+package wasm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Calculate the checksum of the wasm code which is the hex string of the sha256 hash.
+// The checksum of the stored code is calculated from the wasm binary which is not compressed,
+// so the code compressed by gzip is uncompressed before hashing.
+func WasmChecksum(wasmByteCode []byte) (string, error) {
+	wasm := wasmByteCode
+	if ioutils.IsGzip(wasm) {
+		var err error
+		wasm, err = ioutils.Uncompress(wasm, int64(wasmtypes.MaxWasmSize))
+		if err != nil {
+			return "", types.ErrWrap(types.ErrParse, err)
+		}
+	}
+	if !ioutils.IsWasm(wasm) {
+		return "", types.ErrWrap(types.ErrInvalidRequest, "invalid wasm code. Use wasm binary or gzip")
+	}
+
+	checksum := sha256.Sum256(wasm)
+	return hex.EncodeToString(checksum[:]), nil
+}
+
+// Compare the data hash of the stored code with the checksum.
+func verifyChecksum(codeInfo *wasmtypes.CodeInfoResponse, checksum string) error {
+	if codeInfo == nil {
+		return types.ErrWrap(types.ErrNotFound, "code info")
+	}
+
+	expected, err := hex.DecodeString(checksum)
+	if err != nil {
+		return types.ErrWrap(types.ErrParse, err)
+	}
+	if !bytes.Equal(codeInfo.DataHash, expected) {
+		return types.ErrWrap(types.ErrChecksumMismatch, "code", codeInfo.CodeID, "has the checksum", hex.EncodeToString(codeInfo.DataHash), "but expected", checksum)
+	}
+	return nil
+}
+
+// The downloaded code should be matched with the data hash of the stored code.
+func verifyDownloadedCode(res *wasmtypes.QueryCodeResponse) error {
+	checksum := sha256.Sum256(res.Data)
+	return verifyChecksum(res.CodeInfoResponse, hex.EncodeToString(checksum[:]))
+}
+
+// Verify codes which are stored by the transaction.
+// The checksum of the wasm code in each store code message is compared with the checksum of the stored code info
+// which is emitted in the event of the message, so the code uploaded by any broadcast path is verified without downloading it.
+// Nothing is verified if the transaction does not store the code or events do not exist, e.g. the broadcast mode "sync".
+func VerifyStoredCodeChecksum(res *types.TxRes, msgs []sdk.Msg) error {
+	if res == nil || res.Response == nil || len(res.Response.Logs) == 0 {
+		return nil
+	}
+
+	for _, log := range res.Response.Logs {
+		if int(log.MsgIndex) >= len(msgs) {
+			continue
+		}
+		storeCodeMsg, ok := msgs[log.MsgIndex].(*wasmtypes.MsgStoreCode)
+		if !ok {
+			continue
+		}
+
+		codeID, storedChecksum := storedCodeOf(log)
+		if storedChecksum == "" {
+			continue
+		}
+
+		checksum, err := WasmChecksum(storeCodeMsg.WASMByteCode)
+		if err != nil {
+			return err
+		}
+		if checksum != storedChecksum {
+			return types.ErrWrap(types.ErrChecksumMismatch, "code", codeID, "of the message", log.MsgIndex, "has the checksum", storedChecksum, "but expected", checksum)
+		}
+	}
+	return nil
+}
+
+// Read the code ID and the checksum from the store code event of the message log.
+func storedCodeOf(log sdk.ABCIMessageLog) (string, string) {
+	var codeID, checksum string
+	for _, event := range log.Events {
+		if event.Type != wasmtypes.EventTypeStoreCode {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			switch attribute.Key {
+			case wasmtypes.AttributeKeyCodeID:
+				codeID = attribute.Value
+			case wasmtypes.AttributeKeyChecksum:
+				checksum = attribute.Value
+			}
+		}
+	}
+	return codeID, checksum
+}
@@ -0,0 +1,129 @@
+package wasm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Messages of the standard cw20 and cw721 contracts.
+type cwRecipientMsg struct {
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount,omitempty"`
+	TokenId   string `json:"token_id,omitempty"`
+}
+
+type cwSendMsg struct {
+	Contract string `json:"contract"`
+	Amount   string `json:"amount,omitempty"`
+	TokenId  string `json:"token_id,omitempty"`
+	Msg      string `json:"msg"`
+}
+
+type cwSpenderMsg struct {
+	Spender string `json:"spender"`
+	Amount  string `json:"amount,omitempty"`
+	TokenId string `json:"token_id,omitempty"`
+}
+
+type cwQueryMsg struct {
+	Address        string  `json:"address,omitempty"`
+	Owner          string  `json:"owner,omitempty"`
+	TokenId        string  `json:"token_id,omitempty"`
+	IncludeExpired bool    `json:"include_expired,omitempty"`
+	StartAfter     *string `json:"start_after,omitempty"`
+	Limit          *uint32 `json:"limit,omitempty"`
+}
+
+// Responses of cw queries are unmarshaled to the typed response by the msg type.
+var cwQueryResponses = map[string]func() interface{}{
+	WasmCw20BalanceMsgType:     func() interface{} { return &types.Cw20BalanceResponse{} },
+	WasmCw20TokenInfoMsgType:   func() interface{} { return &types.Cw20TokenInfoResponse{} },
+	WasmCw20AllAccountsMsgType: func() interface{} { return &types.Cw20AllAccountsResponse{} },
+	WasmCw721OwnerOfMsgType:    func() interface{} { return &types.Cw721OwnerOfResponse{} },
+	WasmCw721NftInfoMsgType:    func() interface{} { return &types.Cw721NftInfoResponse{} },
+	WasmCw721TokensMsgType:     func() interface{} { return &types.Cw721TokensResponse{} },
+	WasmCw721AllTokensMsgType:  func() interface{} { return &types.Cw721TokensResponse{} },
+}
+
+// Make the page request of the next page by keys of the current page, which are accounts or token IDs.
+// If the current page is empty or has less keys than the limit, there is no next page and false is returned.
+func NextCwPageRequest(pageRequest types.CwPageRequest, keys []string) (types.CwPageRequest, bool) {
+	if len(keys) == 0 || (pageRequest.Limit != 0 && len(keys) < int(pageRequest.Limit)) {
+		return pageRequest, false
+	}
+
+	pageRequest.StartAfter = keys[len(keys)-1]
+	return pageRequest, true
+}
+
+func isCwQueryMsgType(msgType string) bool {
+	_, ok := cwQueryResponses[msgType]
+	return ok
+}
+
+// Make the execute msg of the cw contract whose message is the JSON object which has the single key.
+func makeCwExecuteMsg(contractAddress string, key string, value interface{}, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	execMsg, err := json.Marshal(map[string]interface{}{key: value})
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, types.ErrWrap(types.ErrFailedToMarshal, err)
+	}
+
+	return MakeExecuteMsg(types.ExecuteMsg{
+		ContractAddress: contractAddress,
+		ExecMsg:         string(execMsg),
+	}, addr)
+}
+
+// Make the smart query msg of the cw contract whose message is the JSON object which has the single key.
+func makeCwQueryMsg(contractAddress string, key string, value cwQueryMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	if contractAddress == "" {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "empty contract address")
+	}
+
+	queryMsg, err := json.Marshal(map[string]interface{}{key: value})
+	if err != nil {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrFailedToMarshal, err)
+	}
+
+	return wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddress,
+		QueryData: queryMsg,
+	}, nil
+}
+
+func cwPageQueryMsg(pageRequest types.CwPageRequest) cwQueryMsg {
+	var queryMsg cwQueryMsg
+	if pageRequest.StartAfter != "" {
+		queryMsg.StartAfter = &pageRequest.StartAfter
+	}
+	if pageRequest.Limit != 0 {
+		queryMsg.Limit = &pageRequest.Limit
+	}
+	return queryMsg
+}
+
+// Amounts of cw20 are Uint128 which is the decimal string.
+func parseCw20Amount(amount string) (string, error) {
+	n, err := util.FromStringToBigInt(amount)
+	if err != nil || n.Sign() < 0 {
+		return "", types.ErrWrap(types.ErrInvalidRequest, "invalid cw20 amount:", amount)
+	}
+	return n.String(), nil
+}
+
+// The message which is passed to the receiver contract is the binary which is encoded to base64.
+func encodeCwMsg(msg string) (string, error) {
+	if msg == "" {
+		return "", nil
+	}
+	if !json.Valid([]byte(msg)) {
+		return "", types.ErrWrap(types.ErrInvalidRequest, "msg must be the JSON message")
+	}
+	return base64.StdEncoding.EncodeToString([]byte(msg)), nil
+}
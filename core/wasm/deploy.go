@@ -0,0 +1,230 @@
+package wasm
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Deploy the wasm contract by storing the code and instantiating the contract.
+// Each transaction is signed by options of the xpla client and waited until it is committed.
+// If a step fails, the result which has steps done is returned with the error,
+// and the deployment is resumed by setting the result to DeployWasmMsg.Resume.
+// When resuming, the transaction whose hash is recorded without its result is queried first,
+// and it is broadcast again only if it is not committed or failed.
+func (e WasmExternal) DeployWasm(deployWasmMsg types.DeployWasmMsg) (*types.DeployWasmResult, error) {
+	var result types.DeployWasmResult
+	if deployWasmMsg.Resume != nil {
+		result = *deployWasmMsg.Resume
+	}
+
+	if result.CodeID == 0 {
+		if err := e.deployWasmStoreCode(deployWasmMsg, &result); err != nil {
+			return &result, err
+		}
+	}
+
+	if result.ContractAddress == "" && result.InstantiateTxHash != "" {
+		res, err := e.previousTxRes(result.InstantiateTxHash)
+		if err != nil {
+			return &result, err
+		}
+		if res != nil {
+			if res.ContractAddress == "" {
+				return &result, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "contract address in events of the transaction", result.InstantiateTxHash))
+			}
+			result.ContractAddress = res.ContractAddress
+		}
+	}
+
+	if result.ContractAddress == "" {
+		// no funds are sent to the contract if the amount is empty.
+		amount := deployWasmMsg.Amount
+		if amount == "" {
+			amount = "0"
+		}
+
+		msg, err := MakeInstantiateMsg(types.InstantiateMsg{
+			CodeId:  util.FromUint64ToString(result.CodeID),
+			Amount:  amount,
+			Label:   deployWasmMsg.Label,
+			InitMsg: deployWasmMsg.InitMsg,
+			Admin:   deployWasmMsg.Admin,
+			NoAdmin: deployWasmMsg.NoAdmin,
+		}, e.Xplac.GetFromAddress())
+		if err != nil {
+			return &result, e.Xplac.GetLogger().Err(err)
+		}
+
+		res, err := e.broadcastAndWait(WasmInstantiateMsgType, msg)
+		if txHash := broadcastTxHash(res, err); txHash != "" {
+			result.InstantiateTxHash = txHash
+		}
+		if err != nil {
+			return &result, err
+		}
+		result.ContractAddress = res.ContractAddress
+	}
+
+	return &result, nil
+}
+
+// Store the code, or find the code which has the same checksum if uploading is skipped.
+// The stored code is verified by the checksum of the local code.
+func (e WasmExternal) deployWasmStoreCode(deployWasmMsg types.DeployWasmMsg, result *types.DeployWasmResult) error {
+	msg, err := MakeStoreCodeMsg(types.StoreMsg{
+		FilePath:              deployWasmMsg.FilePath,
+		WasmByteCode:          deployWasmMsg.WasmByteCode,
+		InstantiatePermission: deployWasmMsg.InstantiatePermission,
+	}, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Xplac.GetLogger().Err(err)
+	}
+
+	checksum, err := WasmChecksum(msg.WASMByteCode)
+	if err != nil {
+		return e.Xplac.GetLogger().Err(err)
+	}
+
+	// The code is stored by the transaction of the previous deployment if it is committed.
+	if result.StoreTxHash != "" {
+		res, err := e.previousTxRes(result.StoreTxHash)
+		if err != nil {
+			return err
+		}
+		if res != nil {
+			return e.setStoredCode(res, checksum, result)
+		}
+	}
+
+	if deployWasmMsg.SkipStoredCode {
+		codeID, err := e.findCodeByChecksum(checksum)
+		if err != nil {
+			return err
+		}
+		if codeID != 0 {
+			result.CodeID = codeID
+			result.Checksum = checksum
+			result.CodeReused = true
+			return nil
+		}
+	}
+
+	res, err := e.broadcastAndWait(WasmStoreMsgType, msg)
+	if txHash := broadcastTxHash(res, err); txHash != "" {
+		result.StoreTxHash = txHash
+	}
+	if err != nil {
+		return err
+	}
+	return e.setStoredCode(res, checksum, result)
+}
+
+// Set the code ID of the store transaction to the result after the stored code is verified by the checksum.
+func (e WasmExternal) setStoredCode(res *types.TxRes, checksum string, result *types.DeployWasmResult) error {
+	if res.CodeID == 0 {
+		return e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrNotFound, "code ID in events of the transaction", result.StoreTxHash))
+	}
+
+	verifyCodeMsg, err := MakeVerifyCodeMsg(types.VerifyCodeMsg{
+		CodeId:   util.FromUint64ToString(res.CodeID),
+		Checksum: checksum,
+	})
+	if err != nil {
+		return e.Xplac.GetLogger().Err(err)
+	}
+	if _, err := e.ToExternal(WasmVerifyCodeMsgType, verifyCodeMsg).QueryProto(); err != nil {
+		return err
+	}
+
+	result.CodeID = res.CodeID
+	result.Checksum = checksum
+	return nil
+}
+
+// Query the transaction which is broadcast by the previous deployment.
+// It returns nil if the transaction is not committed or failed, so the step is broadcast again.
+// Otherwise the error is returned because broadcasting again may apply the step twice.
+func (e WasmExternal) previousTxRes(txHash string) (*types.TxRes, error) {
+	res, err := e.Xplac.GetTxRes(txHash)
+	if err != nil {
+		var notFoundErr *types.TxNotFoundError
+		if errors.As(err, &notFoundErr) || (res != nil && res.Response != nil && res.Response.Code != 0) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// The hash of the broadcast transaction, which is kept even if waiting the transaction is timed out.
+func broadcastTxHash(res *types.TxRes, err error) string {
+	if res != nil && res.Response != nil {
+		return res.Response.TxHash
+	}
+	var timeoutErr *types.TxTimeoutError
+	if errors.As(err, &timeoutErr) {
+		return timeoutErr.TxHash
+	}
+	return ""
+}
+
+// Find the stored code which has the checksum through all pages of codes.
+// It returns zero if the code is not found.
+func (e WasmExternal) findCodeByChecksum(checksum string) (uint64, error) {
+	pageRequest := &query.PageRequest{}
+	for {
+		msg, err := MakeListcodeMsg(pageRequest)
+		if err != nil {
+			return 0, e.Xplac.GetLogger().Err(err)
+		}
+
+		res, err := e.ToExternal(WasmListCodeMsgType, msg).QueryProto()
+		if err != nil {
+			return 0, err
+		}
+
+		codesResponse := res.(*wasmtypes.QueryCodesResponse)
+		for _, codeInfo := range codesResponse.CodeInfos {
+			if hex.EncodeToString(codeInfo.DataHash) == checksum {
+				return codeInfo.CodeID, nil
+			}
+		}
+
+		if codesResponse.Pagination == nil || len(codesResponse.Pagination.NextKey) == 0 {
+			return 0, nil
+		}
+		pageRequest = &query.PageRequest{Key: codesResponse.Pagination.NextKey}
+	}
+}
+
+// Sign the message and broadcast it until the transaction is committed.
+// The sequence which is loaded from the chain is not kept for the next transaction of the workflow,
+// and the sequence which is set manually is increased if the transaction is included in the block.
+func (e WasmExternal) broadcastAndWait(msgType string, msg interface{}) (*types.TxRes, error) {
+	sequence := e.Xplac.GetSequence()
+	if e.Xplac.GetSequenceManager() == nil && sequence == "" {
+		defer e.Xplac.WithSequence("")
+	}
+
+	txbytes, err := e.ToExternal(msgType, msg).CreateAndSignTx()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.Xplac.BroadcastAndWait(txbytes)
+	if e.Xplac.GetSequenceManager() == nil && sequence != "" &&
+		res != nil && res.Response != nil && res.Response.Height > 0 {
+		seq, convErr := util.FromStringToUint64(sequence)
+		if convErr != nil {
+			return res, e.Xplac.GetLogger().Err(types.ErrWrap(types.ErrConvert, convErr))
+		}
+		e.Xplac.WithSequence(util.FromUint64ToString(seq + 1))
+	}
+	return res, err
+}
@@ -38,6 +38,7 @@ func (e WasmExternal) Err(msgType string, err error) provider.XplaClient {
 // Tx
 
 // Upload a wasm binary.
+// The wasm binary is compressed by gzip before uploading if it is not compressed.
 func (e WasmExternal) StoreCode(storeMsg types.StoreMsg) provider.XplaClient {
 	msg, err := MakeStoreCodeMsg(storeMsg, e.Xplac.GetFromAddress())
 	if err != nil {
@@ -97,6 +98,66 @@ func (e WasmExternal) Migrate(migrateMsg types.MigrateMsg) provider.XplaClient {
 	return e.ToExternal(WasmMigrateMsgType, msg)
 }
 
+// Transfer cw20 tokens to the recipient.
+func (e WasmExternal) Cw20Transfer(cw20TransferMsg types.Cw20TransferMsg) provider.XplaClient {
+	msg, err := MakeCw20TransferMsg(cw20TransferMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
+// Send cw20 tokens to the contract with the hook message.
+func (e WasmExternal) Cw20Send(cw20SendMsg types.Cw20SendMsg) provider.XplaClient {
+	msg, err := MakeCw20SendMsg(cw20SendMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
+// Increase the cw20 allowance of the spender.
+func (e WasmExternal) Cw20IncreaseAllowance(cw20IncreaseAllowanceMsg types.Cw20IncreaseAllowanceMsg) provider.XplaClient {
+	msg, err := MakeCw20IncreaseAllowanceMsg(cw20IncreaseAllowanceMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
+// Transfer the cw721 token to the recipient.
+func (e WasmExternal) Cw721TransferNft(cw721TransferNftMsg types.Cw721TransferNftMsg) provider.XplaClient {
+	msg, err := MakeCw721TransferNftMsg(cw721TransferNftMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
+// Send the cw721 token to the contract with the hook message.
+func (e WasmExternal) Cw721SendNft(cw721SendNftMsg types.Cw721SendNftMsg) provider.XplaClient {
+	msg, err := MakeCw721SendNftMsg(cw721SendNftMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
+// Approve the spender to transfer the cw721 token.
+func (e WasmExternal) Cw721Approve(cw721ApproveMsg types.Cw721ApproveMsg) provider.XplaClient {
+	msg, err := MakeCw721ApproveMsg(cw721ApproveMsg, e.Xplac.GetFromAddress())
+	if err != nil {
+		return e.Err(WasmExecuteMsgType, err)
+	}
+
+	return e.ToExternal(WasmExecuteMsgType, msg)
+}
+
 // Query
 
 // Calls contract with given address with query data and prints the returned result.
@@ -111,7 +172,7 @@ func (e WasmExternal) QueryContract(queryMsg types.QueryMsg) provider.XplaClient
 
 // Query list all wasm bytecode on the chain.
 func (e WasmExternal) ListCode() provider.XplaClient {
-	msg, err := MakeListcodeMsg()
+	msg, err := MakeListcodeMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(WasmListCodeMsgType, err)
 	}
@@ -121,7 +182,7 @@ func (e WasmExternal) ListCode() provider.XplaClient {
 
 // Query list wasm all bytecode on the chain for given code ID.
 func (e WasmExternal) ListContractByCode(listContractByCodeMsg types.ListContractByCodeMsg) provider.XplaClient {
-	msg, err := MakeListContractByCodeMsg(listContractByCodeMsg)
+	msg, err := MakeListContractByCodeMsg(listContractByCodeMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(WasmListContractByCodeMsgType, err)
 	}
@@ -130,6 +191,7 @@ func (e WasmExternal) ListContractByCode(listContractByCodeMsg types.ListContrac
 }
 
 // Downloads wasm bytecode for given code ID.
+// The downloaded code is verified by the checksum of the stored code.
 func (e WasmExternal) Download(downloadMsg types.DownloadMsg) provider.XplaClient {
 	msg, err := MakeDownloadMsg(downloadMsg)
 	if err != nil {
@@ -149,6 +211,17 @@ func (e WasmExternal) CodeInfo(codeInfoMsg types.CodeInfoMsg) provider.XplaClien
 	return e.ToExternal(WasmCodeInfoMsgType, msg)
 }
 
+// Verify the stored code for given code ID by the local wasm file or the checksum.
+// The query fails if the checksum of the stored code is not matched.
+func (e WasmExternal) VerifyCode(verifyCodeMsg types.VerifyCodeMsg) provider.XplaClient {
+	msg, err := MakeVerifyCodeMsg(verifyCodeMsg)
+	if err != nil {
+		return e.Err(WasmVerifyCodeMsgType, err)
+	}
+
+	return e.ToExternal(WasmVerifyCodeMsgType, msg)
+}
+
 // Prints out metadata of a contract given its address.
 func (e WasmExternal) ContractInfo(contractInfoMsg types.ContractInfoMsg) provider.XplaClient {
 	msg, err := MakeContractInfoMsg(contractInfoMsg)
@@ -161,7 +234,7 @@ func (e WasmExternal) ContractInfo(contractInfoMsg types.ContractInfoMsg) provid
 
 // Prints out all internal state of a contract given its address.
 func (e WasmExternal) ContractStateAll(contractStateAllMsg types.ContractStateAllMsg) provider.XplaClient {
-	msg, err := MakeContractStateAllMsg(contractStateAllMsg)
+	msg, err := MakeContractStateAllMsg(contractStateAllMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(WasmContractStateAllMsgType, err)
 	}
@@ -169,9 +242,30 @@ func (e WasmExternal) ContractStateAll(contractStateAllMsg types.ContractStateAl
 	return e.ToExternal(WasmContractStateAllMsgType, msg)
 }
 
+// Prints out the value of the raw key in the internal state of a contract.
+func (e WasmExternal) ContractStateRaw(contractStateRawMsg types.ContractStateRawMsg) provider.XplaClient {
+	msg, err := MakeContractStateRawMsg(contractStateRawMsg)
+	if err != nil {
+		return e.Err(WasmContractStateRawMsgType, err)
+	}
+
+	return e.ToExternal(WasmContractStateRawMsgType, msg)
+}
+
+// Prints out the internal state of a contract whose keys have the prefix.
+// The pagination of the xpla client is applied, and the next page is requested by the next key of the response.
+func (e WasmExternal) ContractStatePrefix(contractStatePrefixMsg types.ContractStatePrefixMsg) provider.XplaClient {
+	msg, err := MakeContractStatePrefixMsg(contractStatePrefixMsg, e.Xplac.GetPagination())
+	if err != nil {
+		return e.Err(WasmContractStatePrefixMsgType, err)
+	}
+
+	return e.ToExternal(WasmContractStatePrefixMsgType, msg)
+}
+
 // Prints out the code history for a contract given its address.
 func (e WasmExternal) ContractHistory(contractHistoryMsg types.ContractHistoryMsg) provider.XplaClient {
-	msg, err := MakeContractHistoryMsg(contractHistoryMsg)
+	msg, err := MakeContractHistoryMsg(contractHistoryMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(WasmContractHistoryMsgType, err)
 	}
@@ -181,7 +275,7 @@ func (e WasmExternal) ContractHistory(contractHistoryMsg types.ContractHistoryMs
 
 // Query list all pinned code IDs.
 func (e WasmExternal) Pinned() provider.XplaClient {
-	msg, err := MakePinnedMsg()
+	msg, err := MakePinnedMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return e.Err(WasmPinnedMsgType, err)
 	}
@@ -198,3 +292,73 @@ func (e WasmExternal) LibwasmvmVersion() provider.XplaClient {
 
 	return e.ToExternal(WasmLibwasmvmVersionMsgType, msg)
 }
+
+// Query the cw20 balance of the address.
+func (e WasmExternal) Cw20Balance(cw20BalanceMsg types.Cw20BalanceMsg) provider.XplaClient {
+	msg, err := MakeCw20BalanceMsg(cw20BalanceMsg)
+	if err != nil {
+		return e.Err(WasmCw20BalanceMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw20BalanceMsgType, msg)
+}
+
+// Query the cw20 token info.
+func (e WasmExternal) Cw20TokenInfo(cw20TokenInfoMsg types.Cw20TokenInfoMsg) provider.XplaClient {
+	msg, err := MakeCw20TokenInfoMsg(cw20TokenInfoMsg)
+	if err != nil {
+		return e.Err(WasmCw20TokenInfoMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw20TokenInfoMsgType, msg)
+}
+
+// Query all accounts holding the cw20 token.
+func (e WasmExternal) Cw20AllAccounts(cw20AllAccountsMsg types.Cw20AllAccountsMsg) provider.XplaClient {
+	msg, err := MakeCw20AllAccountsMsg(cw20AllAccountsMsg)
+	if err != nil {
+		return e.Err(WasmCw20AllAccountsMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw20AllAccountsMsgType, msg)
+}
+
+// Query the owner of the cw721 token.
+func (e WasmExternal) Cw721OwnerOf(cw721OwnerOfMsg types.Cw721OwnerOfMsg) provider.XplaClient {
+	msg, err := MakeCw721OwnerOfMsg(cw721OwnerOfMsg)
+	if err != nil {
+		return e.Err(WasmCw721OwnerOfMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw721OwnerOfMsgType, msg)
+}
+
+// Query the cw721 token info.
+func (e WasmExternal) Cw721NftInfo(cw721NftInfoMsg types.Cw721NftInfoMsg) provider.XplaClient {
+	msg, err := MakeCw721NftInfoMsg(cw721NftInfoMsg)
+	if err != nil {
+		return e.Err(WasmCw721NftInfoMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw721NftInfoMsgType, msg)
+}
+
+// Query cw721 tokens owned by the owner.
+func (e WasmExternal) Cw721Tokens(cw721TokensMsg types.Cw721TokensMsg) provider.XplaClient {
+	msg, err := MakeCw721TokensMsg(cw721TokensMsg)
+	if err != nil {
+		return e.Err(WasmCw721TokensMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw721TokensMsgType, msg)
+}
+
+// Query all cw721 tokens of the contract.
+func (e WasmExternal) Cw721AllTokens(cw721AllTokensMsg types.Cw721AllTokensMsg) provider.XplaClient {
+	msg, err := MakeCw721AllTokensMsg(cw721AllTokensMsg)
+	if err != nil {
+		return e.Err(WasmCw721AllTokensMsgType, err)
+	}
+
+	return e.ToExternal(WasmCw721AllTokensMsgType, msg)
+}
@@ -1,9 +1,13 @@
 package wasm_test
 
 import (
+	"os"
+
 	mwasm "github.com/xpladev/xpla.go/core/wasm"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util/testutil"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
 )
 
 var (
@@ -31,10 +35,26 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 	_, err = s.xplac.StoreCode(storeMsg).CreateAndSignTx()
 	s.Require().NoError(err)
 
+	// store code by the byte code which is compressed
+	wasmByteCode, err := os.ReadFile(testWasmFilePath)
+	s.Require().NoError(err)
+	gzipped, err := ioutils.GzipIt(wasmByteCode)
+	s.Require().NoError(err)
+
+	makeStoreCodeByBytesMsg, err := mwasm.MakeStoreCodeMsg(types.StoreMsg{
+		WasmByteCode:          gzipped,
+		InstantiatePermission: "instantiate-only-sender",
+	}, account0.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(makeStoreCodeMsg, makeStoreCodeByBytesMsg)
+
+	_, err = mwasm.MakeStoreCodeMsg(types.StoreMsg{FilePath: testWasmFilePath, WasmByteCode: wasmByteCode}, account0.Address)
+	s.Require().Error(err)
+
 	// instantiate
 	instantiateMsg := types.InstantiateMsg{
 		CodeId:  "1",
-		Amount:  "10",
+		Amount:  "10axpla",
 		Label:   "Contract instant",
 		InitMsg: `{"owner":"` + account0.Address.String() + `"}`,
 		Admin:   account0.Address.String(),
@@ -77,6 +97,11 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 	s.Require().NoError(err)
 	s.Require().Equal(testutil.WasmExecuteContractTxTemplates, string(wasmExecuteContractJsonTxbytes))
 
+	// no funds are sent by the zero amount, and other amounts must have the denom
+	executeMsg.Amount = "10"
+	_, err = mwasm.MakeExecuteMsg(executeMsg, account0.Address)
+	s.Require().Error(err)
+
 	// clear contract admin
 	clearContractAdminMsg := types.ClearContractAdminMsg{
 		ContractAddress: testCWContractAddress,
@@ -138,6 +163,98 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 	wasmMigrateJsonTxbytes, err := s.xplac.EncodedTxbytesToJsonTx(wasmMigrateTxbytes)
 	s.Require().NoError(err)
 	s.Require().Equal(testutil.WasmMigrateTxTemplates, string(wasmMigrateJsonTxbytes))
+
+	// cw20 transfer
+	cw20TransferMsg := types.Cw20TransferMsg{
+		ContractAddress: testCWContractAddress,
+		Recipient:       account0.Address.String(),
+		Amount:          "1000",
+	}
+	s.xplac.Cw20Transfer(cw20TransferMsg)
+
+	makeCw20TransferMsg, err := mwasm.MakeCw20TransferMsg(cw20TransferMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20TransferMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
+
+	// cw20 send
+	cw20SendMsg := types.Cw20SendMsg{
+		ContractAddress: testCWContractAddress,
+		Contract:        testCWContractAddress,
+		Amount:          "1000",
+		Msg:             `{"hook":{}}`,
+	}
+	s.xplac.Cw20Send(cw20SendMsg)
+
+	makeCw20SendMsg, err := mwasm.MakeCw20SendMsg(cw20SendMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20SendMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
+
+	// cw20 increase allowance
+	cw20IncreaseAllowanceMsg := types.Cw20IncreaseAllowanceMsg{
+		ContractAddress: testCWContractAddress,
+		Spender:         account0.Address.String(),
+		Amount:          "1000",
+	}
+	s.xplac.Cw20IncreaseAllowance(cw20IncreaseAllowanceMsg)
+
+	makeCw20IncreaseAllowanceMsg, err := mwasm.MakeCw20IncreaseAllowanceMsg(cw20IncreaseAllowanceMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20IncreaseAllowanceMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
+
+	// cw721 transfer nft
+	cw721TransferNftMsg := types.Cw721TransferNftMsg{
+		ContractAddress: testCWContractAddress,
+		Recipient:       account0.Address.String(),
+		TokenId:         "1",
+	}
+	s.xplac.Cw721TransferNft(cw721TransferNftMsg)
+
+	makeCw721TransferNftMsg, err := mwasm.MakeCw721TransferNftMsg(cw721TransferNftMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721TransferNftMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
+
+	// cw721 send nft
+	cw721SendNftMsg := types.Cw721SendNftMsg{
+		ContractAddress: testCWContractAddress,
+		Contract:        testCWContractAddress,
+		TokenId:         "1",
+		Msg:             `{"hook":{}}`,
+	}
+	s.xplac.Cw721SendNft(cw721SendNftMsg)
+
+	makeCw721SendNftMsg, err := mwasm.MakeCw721SendNftMsg(cw721SendNftMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721SendNftMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
+
+	// cw721 approve
+	cw721ApproveMsg := types.Cw721ApproveMsg{
+		ContractAddress: testCWContractAddress,
+		Spender:         account0.Address.String(),
+		TokenId:         "1",
+	}
+	s.xplac.Cw721Approve(cw721ApproveMsg)
+
+	makeCw721ApproveMsg, err := mwasm.MakeCw721ApproveMsg(cw721ApproveMsg, account0.Address)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721ApproveMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmExecuteMsgType, s.xplac.GetMsgType())
 }
 
 func (s *IntegrationTestSuite) TestWasm() {
@@ -158,7 +275,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	// list code
 	s.xplac.ListCode()
 
-	makeListcodeMsg, err := mwasm.MakeListcodeMsg()
+	makeListcodeMsg, err := mwasm.MakeListcodeMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeListcodeMsg, s.xplac.GetMsg())
@@ -171,7 +288,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	}
 	s.xplac.ListContractByCode(listContractByCodeMsg)
 
-	makeListContractByCodeMsg, err := mwasm.MakeListContractByCodeMsg(listContractByCodeMsg)
+	makeListContractByCodeMsg, err := mwasm.MakeListContractByCodeMsg(listContractByCodeMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeListContractByCodeMsg, s.xplac.GetMsg())
@@ -205,6 +322,20 @@ func (s *IntegrationTestSuite) TestWasm() {
 	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
 	s.Require().Equal(mwasm.WasmCodeInfoMsgType, s.xplac.GetMsgType())
 
+	// verify code
+	verifyCodeMsg := types.VerifyCodeMsg{
+		CodeId:   "1",
+		FilePath: testWasmFilePath,
+	}
+	s.xplac.VerifyCode(verifyCodeMsg)
+
+	makeVerifyCodeMsg, err := mwasm.MakeVerifyCodeMsg(verifyCodeMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeVerifyCodeMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmVerifyCodeMsgType, s.xplac.GetMsgType())
+
 	// contract info
 	contractInfoMsg := types.ContractInfoMsg{
 		ContractAddress: testCWContractAddress,
@@ -224,20 +355,48 @@ func (s *IntegrationTestSuite) TestWasm() {
 	}
 	s.xplac.ContractStateAll(contractStateAllMsg)
 
-	makeContractStateAllMsg, err := mwasm.MakeContractStateAllMsg(contractStateAllMsg)
+	makeContractStateAllMsg, err := mwasm.MakeContractStateAllMsg(contractStateAllMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeContractStateAllMsg, s.xplac.GetMsg())
 	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
 	s.Require().Equal(mwasm.WasmContractStateAllMsgType, s.xplac.GetMsgType())
 
+	// contract state raw
+	contractStateRawMsg := types.ContractStateRawMsg{
+		ContractAddress: testCWContractAddress,
+		Key:             mwasm.CwItemKey("minter").String(),
+	}
+	s.xplac.ContractStateRaw(contractStateRawMsg)
+
+	makeContractStateRawMsg, err := mwasm.MakeContractStateRawMsg(contractStateRawMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeContractStateRawMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmContractStateRawMsgType, s.xplac.GetMsgType())
+
+	// contract state prefix
+	contractStatePrefixMsg := types.ContractStatePrefixMsg{
+		ContractAddress: testCWContractAddress,
+		Prefix:          mwasm.CwMapPrefix("tokens").String(),
+	}
+	s.xplac.ContractStatePrefix(contractStatePrefixMsg)
+
+	makeContractStatePrefixMsg, err := mwasm.MakeContractStatePrefixMsg(contractStatePrefixMsg, s.xplac.GetPagination())
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeContractStatePrefixMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmContractStatePrefixMsgType, s.xplac.GetMsgType())
+
 	// contract history
 	contractHistoryMsg := types.ContractHistoryMsg{
 		ContractAddress: testCWContractAddress,
 	}
 	s.xplac.ContractHistory(contractHistoryMsg)
 
-	makeContractHistoryMsg, err := mwasm.MakeContractHistoryMsg(contractHistoryMsg)
+	makeContractHistoryMsg, err := mwasm.MakeContractHistoryMsg(contractHistoryMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeContractHistoryMsg, s.xplac.GetMsg())
@@ -247,7 +406,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	// pinned
 	s.xplac.Pinned()
 
-	makePinnedMsg, err := mwasm.MakePinnedMsg()
+	makePinnedMsg, err := mwasm.MakePinnedMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makePinnedMsg, s.xplac.GetMsg())
@@ -263,4 +422,101 @@ func (s *IntegrationTestSuite) TestWasm() {
 	s.Require().Equal(makeLibwasmvmVersionMsg, s.xplac.GetMsg())
 	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
 	s.Require().Equal(mwasm.WasmLibwasmvmVersionMsgType, s.xplac.GetMsgType())
+
+	// cw20 balance
+	cw20BalanceMsg := types.Cw20BalanceMsg{
+		ContractAddress: testCWContractAddress,
+		Address:         testCWContractAddress,
+	}
+	s.xplac.Cw20Balance(cw20BalanceMsg)
+
+	makeCw20BalanceMsg, err := mwasm.MakeCw20BalanceMsg(cw20BalanceMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20BalanceMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw20BalanceMsgType, s.xplac.GetMsgType())
+
+	// cw20 token info
+	cw20TokenInfoMsg := types.Cw20TokenInfoMsg{
+		ContractAddress: testCWContractAddress,
+	}
+	s.xplac.Cw20TokenInfo(cw20TokenInfoMsg)
+
+	makeCw20TokenInfoMsg, err := mwasm.MakeCw20TokenInfoMsg(cw20TokenInfoMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20TokenInfoMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw20TokenInfoMsgType, s.xplac.GetMsgType())
+
+	// cw20 all accounts
+	cw20AllAccountsMsg := types.Cw20AllAccountsMsg{
+		ContractAddress: testCWContractAddress,
+		PageRequest:     types.CwPageRequest{Limit: 10},
+	}
+	s.xplac.Cw20AllAccounts(cw20AllAccountsMsg)
+
+	makeCw20AllAccountsMsg, err := mwasm.MakeCw20AllAccountsMsg(cw20AllAccountsMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw20AllAccountsMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw20AllAccountsMsgType, s.xplac.GetMsgType())
+
+	// cw721 owner of
+	cw721OwnerOfMsg := types.Cw721OwnerOfMsg{
+		ContractAddress: testCWContractAddress,
+		TokenId:         "1",
+	}
+	s.xplac.Cw721OwnerOf(cw721OwnerOfMsg)
+
+	makeCw721OwnerOfMsg, err := mwasm.MakeCw721OwnerOfMsg(cw721OwnerOfMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721OwnerOfMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw721OwnerOfMsgType, s.xplac.GetMsgType())
+
+	// cw721 nft info
+	cw721NftInfoMsg := types.Cw721NftInfoMsg{
+		ContractAddress: testCWContractAddress,
+		TokenId:         "1",
+	}
+	s.xplac.Cw721NftInfo(cw721NftInfoMsg)
+
+	makeCw721NftInfoMsg, err := mwasm.MakeCw721NftInfoMsg(cw721NftInfoMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721NftInfoMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw721NftInfoMsgType, s.xplac.GetMsgType())
+
+	// cw721 tokens
+	cw721TokensMsg := types.Cw721TokensMsg{
+		ContractAddress: testCWContractAddress,
+		Owner:           testCWContractAddress,
+	}
+	s.xplac.Cw721Tokens(cw721TokensMsg)
+
+	makeCw721TokensMsg, err := mwasm.MakeCw721TokensMsg(cw721TokensMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721TokensMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw721TokensMsgType, s.xplac.GetMsgType())
+
+	// cw721 all tokens
+	cw721AllTokensMsg := types.Cw721AllTokensMsg{
+		ContractAddress: testCWContractAddress,
+		PageRequest:     types.CwPageRequest{StartAfter: "1", Limit: 10},
+	}
+	s.xplac.Cw721AllTokens(cw721AllTokensMsg)
+
+	makeCw721AllTokensMsg, err := mwasm.MakeCw721AllTokensMsg(cw721AllTokensMsg)
+	s.Require().NoError(err)
+
+	s.Require().Equal(makeCw721AllTokensMsg, s.xplac.GetMsg())
+	s.Require().Equal(mwasm.WasmModule, s.xplac.GetModule())
+	s.Require().Equal(mwasm.WasmCw721AllTokensMsgType, s.xplac.GetMsgType())
 }
@@ -43,7 +43,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	// instantiate
 	instantiateMsg := types.InstantiateMsg{
 		CodeId:  "1",
-		Amount:  "10",
+		Amount:  "10axpla",
 		Label:   "Contract instant",
 		InitMsg: `{"owner":"` + account0.Address.String() + `"}`,
 		Admin:   account0.Address.String(),
@@ -1,12 +1,13 @@
 package wasm
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/xpladev/xpla.go/core"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 )
@@ -83,6 +84,36 @@ func MakeMigrateMsg(migrateMsg types.MigrateMsg, sender sdk.AccAddress) (wasmtyp
 	return parseMigrateArgs(migrateMsg, sender)
 }
 
+// (Tx) make msg - cw20 transfer
+func MakeCw20TransferMsg(cw20TransferMsg types.Cw20TransferMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw20TransferArgs(cw20TransferMsg, addr)
+}
+
+// (Tx) make msg - cw20 send
+func MakeCw20SendMsg(cw20SendMsg types.Cw20SendMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw20SendArgs(cw20SendMsg, addr)
+}
+
+// (Tx) make msg - cw20 increase allowance
+func MakeCw20IncreaseAllowanceMsg(cw20IncreaseAllowanceMsg types.Cw20IncreaseAllowanceMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw20IncreaseAllowanceArgs(cw20IncreaseAllowanceMsg, addr)
+}
+
+// (Tx) make msg - cw721 transfer nft
+func MakeCw721TransferNftMsg(cw721TransferNftMsg types.Cw721TransferNftMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw721TransferNftArgs(cw721TransferNftMsg, addr)
+}
+
+// (Tx) make msg - cw721 send nft
+func MakeCw721SendNftMsg(cw721SendNftMsg types.Cw721SendNftMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw721SendNftArgs(cw721SendNftMsg, addr)
+}
+
+// (Tx) make msg - cw721 approve
+func MakeCw721ApproveMsg(cw721ApproveMsg types.Cw721ApproveMsg, addr sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	return parseCw721ApproveArgs(cw721ApproveMsg, addr)
+}
+
 // (Query) make msg - query contract
 func MakeQueryMsg(queryMsg types.QueryMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
 	if (types.QueryMsg{}) == queryMsg {
@@ -93,14 +124,14 @@ func MakeQueryMsg(queryMsg types.QueryMsg) (wasmtypes.QuerySmartContractStateReq
 }
 
 // (Query) make msg - list code
-func MakeListcodeMsg() (wasmtypes.QueryCodesRequest, error) {
+func MakeListcodeMsg(pageRequest *query.PageRequest) (wasmtypes.QueryCodesRequest, error) {
 	return wasmtypes.QueryCodesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // (Query) make msg - list contract by code
-func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg) (wasmtypes.QueryContractsByCodeRequest, error) {
+func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg, pageRequest *query.PageRequest) (wasmtypes.QueryContractsByCodeRequest, error) {
 	if (types.ListContractByCodeMsg{}) == listContractByCodeMsg {
 		return wasmtypes.QueryContractsByCodeRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
@@ -110,7 +141,7 @@ func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg
 	}
 	return wasmtypes.QueryContractsByCodeRequest{
 		CodeId:     codeIdU64,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -148,6 +179,27 @@ func MakeCodeInfoMsg(codeInfoMsg types.CodeInfoMsg) (wasmtypes.QueryCodeRequest,
 	}, nil
 }
 
+// (Query) make msg - verify code
+func MakeVerifyCodeMsg(verifyCodeMsg types.VerifyCodeMsg) ([]interface{}, error) {
+	if verifyCodeMsg.CodeId == "" {
+		return nil, types.ErrWrap(types.ErrInsufficientParams, "no code ID")
+	}
+	codeIdU64, err := util.FromStringToUint64(verifyCodeMsg.CodeId)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrConvert, err)
+	}
+
+	checksum, err := parseVerifyCodeChecksum(verifyCodeMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := wasmtypes.QueryCodeRequest{
+		CodeId: codeIdU64,
+	}
+	return []interface{}{msg, checksum}, nil
+}
+
 // (Query) make msg - contract info
 func MakeContractInfoMsg(contractInfoMsg types.ContractInfoMsg) (wasmtypes.QueryContractInfoRequest, error) {
 	if (types.ContractInfoMsg{}) == contractInfoMsg {
@@ -159,31 +211,79 @@ func MakeContractInfoMsg(contractInfoMsg types.ContractInfoMsg) (wasmtypes.Query
 }
 
 // (Query) make msg - contract state all
-func MakeContractStateAllMsg(contractStateAllMsg types.ContractStateAllMsg) (wasmtypes.QueryAllContractStateRequest, error) {
+func MakeContractStateAllMsg(contractStateAllMsg types.ContractStateAllMsg, pageRequest *query.PageRequest) (wasmtypes.QueryAllContractStateRequest, error) {
 	if (types.ContractStateAllMsg{}) == contractStateAllMsg {
 		return wasmtypes.QueryAllContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 	return wasmtypes.QueryAllContractStateRequest{
 		Address:    contractStateAllMsg.ContractAddress,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
+	}, nil
+}
+
+// (Query) make msg - contract state raw
+func MakeContractStateRawMsg(contractStateRawMsg types.ContractStateRawMsg) (wasmtypes.QueryRawContractStateRequest, error) {
+	if contractStateRawMsg.ContractAddress == "" || contractStateRawMsg.Key == "" {
+		return wasmtypes.QueryRawContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty mandatory parameters")
+	}
+
+	key, err := hex.DecodeString(contractStateRawMsg.Key)
+	if err != nil {
+		return wasmtypes.QueryRawContractStateRequest{}, types.ErrWrap(types.ErrParse, err)
+	}
+	return wasmtypes.QueryRawContractStateRequest{
+		Address:   contractStateRawMsg.ContractAddress,
+		QueryData: key,
 	}, nil
 }
 
+// (Query) make msg - contract state prefix
+// Iterating the contract state starts from the prefix if the page key is not set.
+func MakeContractStatePrefixMsg(contractStatePrefixMsg types.ContractStatePrefixMsg, pageRequest *query.PageRequest) ([]interface{}, error) {
+	if contractStatePrefixMsg.ContractAddress == "" || contractStatePrefixMsg.Prefix == "" {
+		return nil, types.ErrWrap(types.ErrInsufficientParams, "Empty mandatory parameters")
+	}
+
+	prefix, err := hex.DecodeString(contractStatePrefixMsg.Prefix)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+
+	var prefixPageRequest query.PageRequest
+	if pageRequest != nil {
+		prefixPageRequest = *pageRequest
+	}
+	if prefixPageRequest.Reverse || prefixPageRequest.Offset != 0 {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "the contract state by prefix only supports the page key and the limit")
+	}
+	if len(prefixPageRequest.Key) == 0 {
+		prefixPageRequest.Key = prefix
+	} else if !bytes.HasPrefix(prefixPageRequest.Key, prefix) {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "the page key does not have the prefix")
+	}
+
+	msg := wasmtypes.QueryAllContractStateRequest{
+		Address:    contractStatePrefixMsg.ContractAddress,
+		Pagination: &prefixPageRequest,
+	}
+	return []interface{}{msg, prefix}, nil
+}
+
 // (Query) make msg - history
-func MakeContractHistoryMsg(contractHistoryMsg types.ContractHistoryMsg) (wasmtypes.QueryContractHistoryRequest, error) {
+func MakeContractHistoryMsg(contractHistoryMsg types.ContractHistoryMsg, pageRequest *query.PageRequest) (wasmtypes.QueryContractHistoryRequest, error) {
 	if (types.ContractHistoryMsg{}) == contractHistoryMsg {
 		return wasmtypes.QueryContractHistoryRequest{}, types.ErrWrap(types.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 	return wasmtypes.QueryContractHistoryRequest{
 		Address:    contractHistoryMsg.ContractAddress,
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
 // (Query) make msg - pinned
-func MakePinnedMsg() (wasmtypes.QueryPinnedCodesRequest, error) {
+func MakePinnedMsg(pageRequest *query.PageRequest) (wasmtypes.QueryPinnedCodesRequest, error) {
 	return wasmtypes.QueryPinnedCodesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageRequest,
 	}, nil
 }
 
@@ -192,6 +292,58 @@ func MakeLibwasmvmVersionMsg() (string, error) {
 	return parseLibwasmvmVersionArgs()
 }
 
+// (Query) make msg - cw20 balance
+func MakeCw20BalanceMsg(cw20BalanceMsg types.Cw20BalanceMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	if cw20BalanceMsg.Address == "" {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "empty address")
+	}
+	return makeCwQueryMsg(cw20BalanceMsg.ContractAddress, "balance", cwQueryMsg{Address: cw20BalanceMsg.Address})
+}
+
+// (Query) make msg - cw20 token info
+func MakeCw20TokenInfoMsg(cw20TokenInfoMsg types.Cw20TokenInfoMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	return makeCwQueryMsg(cw20TokenInfoMsg.ContractAddress, "token_info", cwQueryMsg{})
+}
+
+// (Query) make msg - cw20 all accounts
+func MakeCw20AllAccountsMsg(cw20AllAccountsMsg types.Cw20AllAccountsMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	return makeCwQueryMsg(cw20AllAccountsMsg.ContractAddress, "all_accounts", cwPageQueryMsg(cw20AllAccountsMsg.PageRequest))
+}
+
+// (Query) make msg - cw721 owner of
+func MakeCw721OwnerOfMsg(cw721OwnerOfMsg types.Cw721OwnerOfMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	if cw721OwnerOfMsg.TokenId == "" {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "empty token ID")
+	}
+	return makeCwQueryMsg(cw721OwnerOfMsg.ContractAddress, "owner_of", cwQueryMsg{
+		TokenId:        cw721OwnerOfMsg.TokenId,
+		IncludeExpired: cw721OwnerOfMsg.IncludeExpired,
+	})
+}
+
+// (Query) make msg - cw721 nft info
+func MakeCw721NftInfoMsg(cw721NftInfoMsg types.Cw721NftInfoMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	if cw721NftInfoMsg.TokenId == "" {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "empty token ID")
+	}
+	return makeCwQueryMsg(cw721NftInfoMsg.ContractAddress, "nft_info", cwQueryMsg{TokenId: cw721NftInfoMsg.TokenId})
+}
+
+// (Query) make msg - cw721 tokens
+func MakeCw721TokensMsg(cw721TokensMsg types.Cw721TokensMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	if cw721TokensMsg.Owner == "" {
+		return wasmtypes.QuerySmartContractStateRequest{}, types.ErrWrap(types.ErrInsufficientParams, "empty owner")
+	}
+	queryMsg := cwPageQueryMsg(cw721TokensMsg.PageRequest)
+	queryMsg.Owner = cw721TokensMsg.Owner
+	return makeCwQueryMsg(cw721TokensMsg.ContractAddress, "tokens", queryMsg)
+}
+
+// (Query) make msg - cw721 all tokens
+func MakeCw721AllTokensMsg(cw721AllTokensMsg types.Cw721AllTokensMsg) (wasmtypes.QuerySmartContractStateRequest, error) {
+	return makeCwQueryMsg(cw721AllTokensMsg.ContractAddress, "all_tokens", cwPageQueryMsg(cw721AllTokensMsg.PageRequest))
+}
+
 type ArgumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -1,6 +1,8 @@
 package wasm
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"strings"
 
@@ -22,13 +24,9 @@ const (
 
 // Parsing - store code
 func parseStoreCodeArgs(storeMsg types.StoreMsg, sender sdk.AccAddress) (wasmtypes.MsgStoreCode, error) {
-	if storeMsg.FilePath == "" {
-		return wasmtypes.MsgStoreCode{}, types.ErrWrap(types.ErrInsufficientParams, "filepath is empty")
-	}
-
-	wasm, err := os.ReadFile(storeMsg.FilePath)
+	wasm, err := readWasmByteCode(storeMsg.FilePath, storeMsg.WasmByteCode)
 	if err != nil {
-		return wasmtypes.MsgStoreCode{}, types.ErrWrap(types.ErrCannotRead, err)
+		return wasmtypes.MsgStoreCode{}, err
 	}
 
 	// gzip the wasm file
@@ -55,6 +53,50 @@ func parseStoreCodeArgs(storeMsg types.StoreMsg, sender sdk.AccAddress) (wasmtyp
 	return msg, nil
 }
 
+// The wasm code is the byte code or read from the file path, and only one of them should be set.
+func readWasmByteCode(filePath string, wasmByteCode []byte) ([]byte, error) {
+	if filePath != "" && len(wasmByteCode) != 0 {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "only one of the file path and the wasm byte code can be set")
+	}
+	if len(wasmByteCode) != 0 {
+		return wasmByteCode, nil
+	}
+	if filePath == "" {
+		return nil, types.ErrWrap(types.ErrInsufficientParams, "filepath is empty")
+	}
+
+	wasm, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrCannotRead, err)
+	}
+	return wasm, nil
+}
+
+// Parsing - verify code
+// The checksum is calculated from the local wasm file, or given as the hex string.
+func parseVerifyCodeChecksum(verifyCodeMsg types.VerifyCodeMsg) (string, error) {
+	if verifyCodeMsg.FilePath != "" && verifyCodeMsg.Checksum != "" {
+		return "", types.ErrWrap(types.ErrInvalidRequest, "only one of the file path and the checksum can be set")
+	}
+
+	if verifyCodeMsg.Checksum != "" {
+		checksum, err := hex.DecodeString(verifyCodeMsg.Checksum)
+		if err != nil {
+			return "", types.ErrWrap(types.ErrParse, err)
+		}
+		if len(checksum) != sha256.Size {
+			return "", types.ErrWrap(types.ErrInvalidRequest, "the checksum must be the sha256 hash")
+		}
+		return hex.EncodeToString(checksum), nil
+	}
+
+	wasm, err := readWasmByteCode(verifyCodeMsg.FilePath, nil)
+	if err != nil {
+		return "", err
+	}
+	return WasmChecksum(wasm)
+}
+
 func instantiatePermission(permission string, sender sdk.AccAddress) (*wasmtypes.AccessConfig, error) {
 	var permMethod string
 	var onlyAddr string
@@ -111,11 +153,7 @@ func parseInstantiateArgs(
 		return wasmtypes.MsgInstantiateContract{}, types.ErrWrap(types.ErrConvert, err)
 	}
 
-	amountStr := instantiateMsgData.Amount
-	if amountStr == "" {
-		amountStr = "0"
-	}
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(amountStr))
+	amount, err := parseFunds(instantiateMsgData.Amount)
 	if err != nil {
 		return wasmtypes.MsgInstantiateContract{}, types.ErrWrap(types.ErrParse, err)
 	}
@@ -165,11 +203,7 @@ func parseInstantiateArgs(
 // Parsing - execute
 func parseExecuteArgs(executeMsgData types.ExecuteMsg,
 	sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
-	amountStr := executeMsgData.Amount
-	if amountStr == "" {
-		amountStr = "0"
-	}
-	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(amountStr))
+	amount, err := parseFunds(executeMsgData.Amount)
 	if err != nil {
 		return wasmtypes.MsgExecuteContract{}, types.ErrWrap(types.ErrParse, "amount:", err)
 	}
@@ -236,3 +270,99 @@ func parseLibwasmvmVersionArgs() (string, error) {
 	}
 	return version, nil
 }
+
+// Parsing - cw20 transfer
+func parseCw20TransferArgs(cw20TransferMsg types.Cw20TransferMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	amount, err := parseCw20Amount(cw20TransferMsg.Amount)
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, err
+	}
+
+	return makeCwExecuteMsg(cw20TransferMsg.ContractAddress, "transfer", cwRecipientMsg{
+		Recipient: cw20TransferMsg.Recipient,
+		Amount:    amount,
+	}, sender)
+}
+
+// Parsing - cw20 send
+func parseCw20SendArgs(cw20SendMsg types.Cw20SendMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	amount, err := parseCw20Amount(cw20SendMsg.Amount)
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, err
+	}
+
+	msg, err := encodeCwMsg(cw20SendMsg.Msg)
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, err
+	}
+
+	return makeCwExecuteMsg(cw20SendMsg.ContractAddress, "send", cwSendMsg{
+		Contract: cw20SendMsg.Contract,
+		Amount:   amount,
+		Msg:      msg,
+	}, sender)
+}
+
+// Parsing - cw20 increase allowance
+func parseCw20IncreaseAllowanceArgs(cw20IncreaseAllowanceMsg types.Cw20IncreaseAllowanceMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	amount, err := parseCw20Amount(cw20IncreaseAllowanceMsg.Amount)
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, err
+	}
+
+	return makeCwExecuteMsg(cw20IncreaseAllowanceMsg.ContractAddress, "increase_allowance", cwSpenderMsg{
+		Spender: cw20IncreaseAllowanceMsg.Spender,
+		Amount:  amount,
+	}, sender)
+}
+
+// Parsing - cw721 transfer nft
+func parseCw721TransferNftArgs(cw721TransferNftMsg types.Cw721TransferNftMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	if cw721TransferNftMsg.TokenId == "" {
+		return wasmtypes.MsgExecuteContract{}, types.ErrWrap(types.ErrInsufficientParams, "empty token ID")
+	}
+
+	return makeCwExecuteMsg(cw721TransferNftMsg.ContractAddress, "transfer_nft", cwRecipientMsg{
+		Recipient: cw721TransferNftMsg.Recipient,
+		TokenId:   cw721TransferNftMsg.TokenId,
+	}, sender)
+}
+
+// Parsing - cw721 send nft
+func parseCw721SendNftArgs(cw721SendNftMsg types.Cw721SendNftMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	if cw721SendNftMsg.TokenId == "" {
+		return wasmtypes.MsgExecuteContract{}, types.ErrWrap(types.ErrInsufficientParams, "empty token ID")
+	}
+
+	msg, err := encodeCwMsg(cw721SendNftMsg.Msg)
+	if err != nil {
+		return wasmtypes.MsgExecuteContract{}, err
+	}
+
+	return makeCwExecuteMsg(cw721SendNftMsg.ContractAddress, "send_nft", cwSendMsg{
+		Contract: cw721SendNftMsg.Contract,
+		TokenId:  cw721SendNftMsg.TokenId,
+		Msg:      msg,
+	}, sender)
+}
+
+// Parsing - cw721 approve
+func parseCw721ApproveArgs(cw721ApproveMsg types.Cw721ApproveMsg, sender sdk.AccAddress) (wasmtypes.MsgExecuteContract, error) {
+	if cw721ApproveMsg.TokenId == "" {
+		return wasmtypes.MsgExecuteContract{}, types.ErrWrap(types.ErrInsufficientParams, "empty token ID")
+	}
+
+	return makeCwExecuteMsg(cw721ApproveMsg.ContractAddress, "approve", cwSpenderMsg{
+		Spender: cw721ApproveMsg.Spender,
+		TokenId: cw721ApproveMsg.TokenId,
+	}, sender)
+}
+
+// Parse funds which are sent to the contract.
+// No funds are sent if the amount is empty or zero, and other amounts must have denoms.
+func parseFunds(amount string) (sdk.Coins, error) {
+	if amount == "" || amount == "0" {
+		return sdk.Coins{}, nil
+	}
+	return util.ParseCoins(amount)
+}
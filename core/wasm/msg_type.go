@@ -1,21 +1,31 @@
 package wasm
 
 const (
-	WasmModule                    = "wasm"
-	WasmStoreMsgType              = "store-code"
-	WasmInstantiateMsgType        = "instantiate-contract"
-	WasmExecuteMsgType            = "execute-contract"
-	WasmClearContractAdminMsgType = "clear-contract-admin"
-	WasmSetContractAdminMsgType   = "set-contract-admin"
-	WasmMigrateMsgType            = "migrate"
-	WasmQueryContractMsgType      = "query-contract"
-	WasmListCodeMsgType           = "list-code"
-	WasmListContractByCodeMsgType = "list-contract-by-code"
-	WasmDownloadMsgType           = "download"
-	WasmCodeInfoMsgType           = "code-info"
-	WasmContractInfoMsgType       = "contract-info"
-	WasmContractStateAllMsgType   = "contract-state-all"
-	WasmContractHistoryMsgType    = "contract-history"
-	WasmPinnedMsgType             = "pinned"
-	WasmLibwasmvmVersionMsgType   = "libwasmvm-version"
+	WasmModule                     = "wasm"
+	WasmStoreMsgType               = "store-code"
+	WasmInstantiateMsgType         = "instantiate-contract"
+	WasmExecuteMsgType             = "execute-contract"
+	WasmClearContractAdminMsgType  = "clear-contract-admin"
+	WasmSetContractAdminMsgType    = "set-contract-admin"
+	WasmMigrateMsgType             = "migrate"
+	WasmQueryContractMsgType       = "query-contract"
+	WasmListCodeMsgType            = "list-code"
+	WasmListContractByCodeMsgType  = "list-contract-by-code"
+	WasmDownloadMsgType            = "download"
+	WasmCodeInfoMsgType            = "code-info"
+	WasmVerifyCodeMsgType          = "verify-code"
+	WasmContractInfoMsgType        = "contract-info"
+	WasmContractStateAllMsgType    = "contract-state-all"
+	WasmContractStateRawMsgType    = "contract-state-raw"
+	WasmContractStatePrefixMsgType = "contract-state-prefix"
+	WasmContractHistoryMsgType     = "contract-history"
+	WasmPinnedMsgType              = "pinned"
+	WasmLibwasmvmVersionMsgType    = "libwasmvm-version"
+	WasmCw20BalanceMsgType         = "cw20-balance"
+	WasmCw20TokenInfoMsgType       = "cw20-token-info"
+	WasmCw20AllAccountsMsgType     = "cw20-all-accounts"
+	WasmCw721OwnerOfMsgType        = "cw721-owner-of"
+	WasmCw721NftInfoMsgType        = "cw721-nft-info"
+	WasmCw721TokensMsgType         = "cw721-tokens"
+	WasmCw721AllTokensMsgType      = "cw721-all-tokens"
 )
@@ -1,7 +1,10 @@
 package wasm
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/json"
+	neturl "net/url"
 	"os"
 	"strings"
 
@@ -11,34 +14,138 @@ import (
 	"github.com/xpladev/xpla.go/util"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for wasm module.
 func QueryWasm(i core.QueryClient) (string, error) {
+	// Wasm libwasmvm version
+	if i.Ixplac.GetMsgType() == WasmLibwasmvmVersionMsgType {
+		return i.Ixplac.GetMsg().(string), nil
+	}
+
+	res, err := QueryWasmProto(i)
+	if err != nil {
+		return "", err
+	}
+
+	// Wasm download
+	if i.Ixplac.GetMsgType() == WasmDownloadMsgType {
+		downloadFileName, _ := i.Ixplac.GetMsg().([]interface{})[1].(string)
+		if !strings.Contains(downloadFileName, ".wasm") {
+			downloadFileName = downloadFileName + ".wasm"
+		}
+		os.WriteFile(downloadFileName, res.(*wasmtypes.QueryCodeResponse).Data, 0o600)
+		return "download complete", nil
+	}
+
+	// Wasm verify code
+	if i.Ixplac.GetMsgType() == WasmVerifyCodeMsgType {
+		verifyCodeResponse := types.VerifyCodeResponse{
+			CodeId:   res.(*wasmtypes.QueryCodeResponse).CodeID,
+			Checksum: i.Ixplac.GetMsg().([]interface{})[1].(string),
+		}
+		out, err := util.JsonMarshalDataIndent(verifyCodeResponse)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+		}
+
+		return string(out), nil
+	}
+
+	// Wasm cw20 and cw721 queries
+	if isCwQueryMsgType(i.Ixplac.GetMsgType()) {
+		cwResponse := cwQueryResponses[i.Ixplac.GetMsgType()]()
+		err = json.Unmarshal(res.(*wasmtypes.QuerySmartContractStateResponse).Data, cwResponse)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToUnmarshal, err))
+		}
+
+		out, err := util.JsonMarshalDataIndent(cwResponse)
+		if err != nil {
+			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrFailedToMarshal, err))
+		}
+
+		return string(out), nil
+	}
+
+	out, err := core.PrintProto(i, res)
+	if err != nil {
+		return "", i.Ixplac.GetLogger().Err(err)
+	}
+
+	return string(out), nil
+}
+
+// Query client for wasm module.
+// The response is returned as the protobuf message regardless of query type.
+// The libwasmvm version is not supported because it is not the protobuf message.
+func QueryWasmProto(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
 	if i.QueryType == types.QueryGrpc {
-		return queryByGrpcWasm(i)
+		res, err = queryByGrpcWasm(i)
 	} else {
-		return queryByLcdWasm(i)
+		res, err = queryByLcdWasm(i)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch i.Ixplac.GetMsgType() {
+	// Wasm download
+	case WasmDownloadMsgType:
+		if err := verifyDownloadedCode(res.(*wasmtypes.QueryCodeResponse)); err != nil {
+			return nil, i.Ixplac.GetLogger().Err(err)
+		}
+
+	// Wasm verify code
+	case WasmVerifyCodeMsgType:
+		checksum := i.Ixplac.GetMsg().([]interface{})[1].(string)
+		if err := verifyChecksum(res.(*wasmtypes.QueryCodeResponse).CodeInfoResponse, checksum); err != nil {
+			return nil, i.Ixplac.GetLogger().Err(err)
+		}
+
+	// Wasm contract state prefix
+	case WasmContractStatePrefixMsgType:
+		prefix := i.Ixplac.GetMsg().([]interface{})[1].([]byte)
+		filterContractStateByPrefix(res.(*wasmtypes.QueryAllContractStateResponse), prefix)
+	}
+
+	return res, nil
+}
+
+// Keys of the contract state are iterated in order from the prefix,
+// so the state after the first key which does not have the prefix is dropped and there is no next page.
+func filterContractStateByPrefix(res *wasmtypes.QueryAllContractStateResponse, prefix []byte) {
+	for j, model := range res.Models {
+		if !bytes.HasPrefix(model.Key, prefix) {
+			res.Models = res.Models[:j]
+			res.Pagination = &query.PageResponse{}
+			return
+		}
+	}
+	if res.Pagination != nil && !bytes.HasPrefix(res.Pagination.NextKey, prefix) {
+		res.Pagination.NextKey = nil
 	}
 }
 
-func queryByGrpcWasm(i core.QueryClient) (string, error) {
+func queryByGrpcWasm(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := wasmtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
 	// Wasm query contract
-	case i.Ixplac.GetMsgType() == WasmQueryContractMsgType:
+	case i.Ixplac.GetMsgType() == WasmQueryContractMsgType || isCwQueryMsgType(i.Ixplac.GetMsgType()):
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QuerySmartContractStateRequest)
 		res, err = queryClient.SmartContractState(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm list code
@@ -49,7 +156,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm list contract by code
@@ -60,25 +167,19 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm download
 	case i.Ixplac.GetMsgType() == WasmDownloadMsgType:
 		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryCodeRequest)
-		downloadFileName, _ := i.Ixplac.GetMsg().([]interface{})[1].(string)
-		if !strings.Contains(downloadFileName, ".wasm") {
-			downloadFileName = downloadFileName + ".wasm"
-		}
-		res, err := queryClient.Code(
+		res, err = queryClient.Code(
 			i.Ixplac.GetContext(),
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
-		os.WriteFile(downloadFileName, res.Data, 0o600)
-		return "download complete", nil
 
 	// Wasm code info
 	case i.Ixplac.GetMsgType() == WasmCodeInfoMsgType:
@@ -88,7 +189,18 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
+
+	// Wasm verify code
+	case i.Ixplac.GetMsgType() == WasmVerifyCodeMsgType:
+		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryCodeRequest)
+		res, err = queryClient.Code(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm contract info
@@ -99,7 +211,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm contract state all
@@ -110,7 +222,29 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
+
+	// Wasm contract state raw
+	case i.Ixplac.GetMsgType() == WasmContractStateRawMsgType:
+		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryRawContractStateRequest)
+		res, err = queryClient.RawContractState(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+		}
+
+	// Wasm contract state prefix
+	case i.Ixplac.GetMsgType() == WasmContractStatePrefixMsgType:
+		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryAllContractStateRequest)
+		res, err = queryClient.AllContractState(
+			i.Ixplac.GetContext(),
+			&convertMsg,
+		)
+		if err != nil {
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm contract history
@@ -121,7 +255,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm pinned
@@ -132,42 +266,41 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
+			return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrGrpcRequest, err))
 		}
 
 	// Wasm libwasmvm version
 	case i.Ixplac.GetMsgType() == WasmLibwasmvmVersionMsgType:
-		convertMsg := i.Ixplac.GetMsg().(string)
-		return convertMsg, nil
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "libwasmvm version is not returned as protobuf message"))
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", i.Ixplac.GetLogger().Err(err)
-	}
-
-	return string(out), nil
+	return res, nil
 }
 
 const (
-	wasmContractLabel = "contract"
-	wasmSmartLabel    = "smart"
-	wasmCodeLabel     = "code"
-	wasmCodesLabel    = "codes"
-	wasmStateLabel    = "state"
-	wasmHistoryLabel  = "history"
-	wasmPinnedLabel   = "pinned"
+	wasmContractLabel  = "contract"
+	wasmContractsLabel = "contracts"
+	wasmSmartLabel     = "smart"
+	wasmCodeLabel      = "code"
+	wasmCodesLabel     = "codes"
+	wasmStateLabel     = "state"
+	wasmRawLabel       = "raw"
+	wasmHistoryLabel   = "history"
+	wasmPinnedLabel    = "pinned"
 )
 
-func queryByLcdWasm(i core.QueryClient) (string, error) {
+func queryByLcdWasm(i core.QueryClient) (proto.Message, error) {
+	var res proto.Message
+
 	url := "/cosmwasm/wasm/v1/"
 
 	switch {
 	// Wasm query contract
-	case i.Ixplac.GetMsgType() == WasmQueryContractMsgType:
+	case i.Ixplac.GetMsgType() == WasmQueryContractMsgType || isCwQueryMsgType(i.Ixplac.GetMsgType()):
+		res = &wasmtypes.QuerySmartContractStateResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QuerySmartContractStateRequest)
 		based64EncodedData := base64.StdEncoding.EncodeToString([]byte(convertMsg.QueryData))
 
@@ -175,64 +308,105 @@ func queryByLcdWasm(i core.QueryClient) (string, error) {
 
 	// Wasm list code
 	case i.Ixplac.GetMsgType() == WasmListCodeMsgType:
-		url = url + wasmCodeLabel
+		res = &wasmtypes.QueryCodesResponse{}
+		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryCodesRequest)
+
+		url = url + wasmCodeLabel + core.LcdPaginationQuery(convertMsg.Pagination)
 
 	// Wasm list contract by code
 	case i.Ixplac.GetMsgType() == WasmListContractByCodeMsgType:
+		res = &wasmtypes.QueryContractsByCodeResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryContractsByCodeRequest)
 
-		url = url + util.MakeQueryLabels(wasmCodeLabel, util.FromUint64ToString(convertMsg.CodeId))
+		url = url + util.MakeQueryLabels(wasmCodeLabel, util.FromUint64ToString(convertMsg.CodeId), wasmContractsLabel)
 
 	// Wasm download
 	case i.Ixplac.GetMsgType() == WasmDownloadMsgType:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "unsupported download wasm file by using LCD"))
+		res = &wasmtypes.QueryCodeResponse{}
+		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryCodeRequest)
+
+		url = url + util.MakeQueryLabels(wasmCodeLabel, util.FromUint64ToString(convertMsg.CodeId))
 
 	// Wasm code info
 	case i.Ixplac.GetMsgType() == WasmCodeInfoMsgType:
+		res = &wasmtypes.QueryCodeResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryCodeRequest)
 
 		url = url + util.MakeQueryLabels(wasmCodeLabel, util.FromUint64ToString(convertMsg.CodeId))
 
+	// Wasm verify code
+	case i.Ixplac.GetMsgType() == WasmVerifyCodeMsgType:
+		res = &wasmtypes.QueryCodeResponse{}
+		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryCodeRequest)
+
+		url = url + util.MakeQueryLabels(wasmCodeLabel, util.FromUint64ToString(convertMsg.CodeId))
+
 	// Wasm contract info
 	case i.Ixplac.GetMsgType() == WasmContractInfoMsgType:
+		res = &wasmtypes.QueryContractInfoResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryContractInfoRequest)
 
 		url = url + util.MakeQueryLabels(wasmContractLabel, convertMsg.Address)
 
 	// Wasm contract state all
 	case i.Ixplac.GetMsgType() == WasmContractStateAllMsgType:
+		res = &wasmtypes.QueryAllContractStateResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryAllContractStateRequest)
 
 		url = url + util.MakeQueryLabels(wasmContractLabel, convertMsg.Address, wasmStateLabel)
 
+	// Wasm contract state raw
+	case i.Ixplac.GetMsgType() == WasmContractStateRawMsgType:
+		res = &wasmtypes.QueryRawContractStateResponse{}
+		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryRawContractStateRequest)
+		based64EncodedKey := base64.URLEncoding.EncodeToString(convertMsg.QueryData)
+
+		url = url + util.MakeQueryLabels(wasmContractLabel, convertMsg.Address, wasmRawLabel, based64EncodedKey)
+
+	// Wasm contract state prefix
+	case i.Ixplac.GetMsgType() == WasmContractStatePrefixMsgType:
+		res = &wasmtypes.QueryAllContractStateResponse{}
+		convertMsg := i.Ixplac.GetMsg().([]interface{})[0].(wasmtypes.QueryAllContractStateRequest)
+
+		params := neturl.Values{}
+		params.Set("pagination.key", base64.StdEncoding.EncodeToString(convertMsg.Pagination.Key))
+		if convertMsg.Pagination.Limit != 0 {
+			params.Set("pagination.limit", util.FromUint64ToString(convertMsg.Pagination.Limit))
+		}
+		url = url + util.MakeQueryLabels(wasmContractLabel, convertMsg.Address, wasmStateLabel) + "?" + params.Encode()
+
 	// Wasm contract history
 	case i.Ixplac.GetMsgType() == WasmContractHistoryMsgType:
+		res = &wasmtypes.QueryContractHistoryResponse{}
 		convertMsg := i.Ixplac.GetMsg().(wasmtypes.QueryContractHistoryRequest)
 
 		url = url + util.MakeQueryLabels(wasmContractLabel, convertMsg.Address, wasmHistoryLabel)
 
 	// Wasm pinned
 	case i.Ixplac.GetMsgType() == WasmPinnedMsgType:
+		res = &wasmtypes.QueryPinnedCodesResponse{}
 
 		url = url + util.MakeQueryLabels(wasmCodesLabel, wasmPinnedLabel)
 
 	// Wasm libwasmvm version
 	case i.Ixplac.GetMsgType() == WasmLibwasmvmVersionMsgType:
-		convertMsg := i.Ixplac.GetMsg().(string)
-		return convertMsg, nil
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrNotSupport, "libwasmvm version is not returned as protobuf message"))
 
 	default:
-		return "", i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
+		return nil, i.Ixplac.GetLogger().Err(types.ErrWrap(types.ErrInvalidMsgType, i.Ixplac.GetMsgType()))
 	}
 
 	i.Ixplac.GetHttpMutex().Lock()
 	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
 	if err != nil {
 		i.Ixplac.GetHttpMutex().Unlock()
-		return "", i.Ixplac.GetLogger().Err(err)
+		return nil, i.Ixplac.GetLogger().Err(err)
 	}
 	i.Ixplac.GetHttpMutex().Unlock()
 
-	return string(out), nil
+	if err := core.UnmarshalLcdResponse(i, out, res); err != nil {
+		return nil, i.Ixplac.GetLogger().Err(err)
+	}
 
+	return res, nil
 }
@@ -1,28 +1,36 @@
 package wasm_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/xpladev/xpla.go/client"
+	mwasm "github.com/xpladev/xpla.go/core/wasm"
 	"github.com/xpladev/xpla.go/provider"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/xpladev/xpla.go/types"
 	"github.com/xpladev/xpla.go/util"
 	"github.com/xpladev/xpla.go/util/testutil"
+	"github.com/xpladev/xpla.go/util/testutil/bindings/cw721"
 	"github.com/xpladev/xpla.go/util/testutil/network"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 var (
-	validatorNumber   = 1
-	testContractLabel = "test contract"
-	testWasmFilePath  = "../../util/testutil/test_files/cw721_metadata_onchain.wasm"
+	validatorNumber      = 1
+	testContractLabel    = "test contract"
+	testWasmFilePath     = "../../util/testutil/test_files/cw721_metadata_onchain.wasm"
+	testCw20WasmFilePath = "../../util/testutil/test_files/cw20_base.wasm"
 )
 
 type IntegrationTestSuite struct {
@@ -76,10 +84,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	storeTxQuery, err := xplac.Tx(queryTxMsg).Query()
 	s.Require().NoError(err)
 
-	var getTxResponse sdktx.GetTxResponse
-	jsonpb.Unmarshal(strings.NewReader(storeTxQuery), &getTxResponse)
+	var txResponse sdk.TxResponse
+	jsonpb.Unmarshal(strings.NewReader(storeTxQuery), &txResponse)
 
-	s.wasmCodeID = getTxResponse.TxResponse.Logs[0].Events[1].Attributes[1].Value
+	s.wasmCodeID = txResponse.Logs[0].Events[1].Attributes[1].Value
 
 	// instantiate contract
 	instantiateMsg := types.InstantiateMsg{
@@ -106,9 +114,9 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	instTxQuery, err := xplac.Tx(queryTxMsg).Query()
 	s.Require().NoError(err)
 
-	jsonpb.Unmarshal(strings.NewReader(instTxQuery), &getTxResponse)
+	jsonpb.Unmarshal(strings.NewReader(instTxQuery), &txResponse)
 
-	s.contractAddr = getTxResponse.TxResponse.Logs[0].Events[0].Attributes[0].Value
+	s.contractAddr = txResponse.Logs[0].Events[0].Attributes[0].Value
 }
 
 func (s *IntegrationTestSuite) TearDownSuite() {
@@ -173,41 +181,24 @@ func (s *IntegrationTestSuite) TestListCode() {
 }
 
 func (s *IntegrationTestSuite) TestListContractByCode() {
-	account0 := s.network.Validators[0].AdditionalAccount
-
 	for i, api := range s.apis {
-		// LCD cannot support list contract by code, replace code info
 		if i == 0 {
 			s.xplac.WithURL(api)
-
-			listContractByCodeMsg := types.ListContractByCodeMsg{
-				CodeId: s.wasmCodeID,
-			}
-			res, err := s.xplac.ListContractByCode(listContractByCodeMsg).Query()
-			s.Require().NoError(err)
-
-			var queryCodeResponse wasmtypes.QueryCodeResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryCodeResponse)
-
-			s.Require().Equal(uint64(1), queryCodeResponse.CodeID)
-			s.Require().Equal(account0.Address.String(), queryCodeResponse.Creator)
-
 		} else {
 			s.xplac.WithGrpc(api)
+		}
 
-			listContractByCodeMsg := types.ListContractByCodeMsg{
-				CodeId: s.wasmCodeID,
-			}
-			res, err := s.xplac.ListContractByCode(listContractByCodeMsg).Query()
-			s.Require().NoError(err)
-
-			var queryContractsByCodeResponse wasmtypes.QueryContractsByCodeResponse
-			jsonpb.Unmarshal(strings.NewReader(res), &queryContractsByCodeResponse)
-
-			s.Require().Len(queryContractsByCodeResponse.Contracts, 1)
-			s.Require().Equal(s.contractAddr, queryContractsByCodeResponse.Contracts[0])
+		listContractByCodeMsg := types.ListContractByCodeMsg{
+			CodeId: s.wasmCodeID,
 		}
+		res, err := s.xplac.ListContractByCode(listContractByCodeMsg).Query()
+		s.Require().NoError(err)
+
+		var queryContractsByCodeResponse wasmtypes.QueryContractsByCodeResponse
+		jsonpb.Unmarshal(strings.NewReader(res), &queryContractsByCodeResponse)
 
+		s.Require().Len(queryContractsByCodeResponse.Contracts, 1)
+		s.Require().Equal(s.contractAddr, queryContractsByCodeResponse.Contracts[0])
 	}
 	s.xplac = provider.ResetXplac(s.xplac)
 }
@@ -240,6 +231,108 @@ func (s *IntegrationTestSuite) TestCodeInfo() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *IntegrationTestSuite) TestVerifyCode() {
+	checksum := "2dd26686622a5bf5a94df201867c82e638e3a139e3fde30b5b8d33f37af1cd89"
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		// the stored code is compared with the local wasm file
+		res, err := s.xplac.VerifyCode(types.VerifyCodeMsg{
+			CodeId:   s.wasmCodeID,
+			FilePath: testWasmFilePath,
+		}).Query()
+		s.Require().NoError(err)
+
+		var verifyCodeResponse types.VerifyCodeResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &verifyCodeResponse))
+		s.Require().Equal(uint64(1), verifyCodeResponse.CodeId)
+		s.Require().Equal(checksum, verifyCodeResponse.Checksum)
+
+		_, err = s.xplac.VerifyCode(types.VerifyCodeMsg{
+			CodeId:   s.wasmCodeID,
+			FilePath: testCw20WasmFilePath,
+		}).Query()
+		s.Require().Error(err)
+
+		// the downloaded code is verified, and it is same as the local wasm file
+		downloadFileName := filepath.Join(s.T().TempDir(), "cw721.wasm")
+		_, err = s.xplac.Download(types.DownloadMsg{
+			CodeId:           s.wasmCodeID,
+			DownloadFileName: downloadFileName,
+		}).Query()
+		s.Require().NoError(err)
+
+		downloaded, err := os.ReadFile(downloadFileName)
+		s.Require().NoError(err)
+		downloadedChecksum, err := mwasm.WasmChecksum(downloaded)
+		s.Require().NoError(err)
+		s.Require().Equal(checksum, downloadedChecksum)
+
+		_, err = s.xplac.VerifyCode(types.VerifyCodeMsg{
+			CodeId:   s.wasmCodeID,
+			Checksum: downloadedChecksum,
+		}).Query()
+		s.Require().NoError(err)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestStoreCodeChecksum() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	checksum := "2dd26686622a5bf5a94df201867c82e638e3a139e3fde30b5b8d33f37af1cd89"
+
+	for i, api := range s.apis {
+		xplac := client.NewXplaClient(testutil.TestChainId).
+			WithPrivateKey(account0.PrivKey).
+			WithGasAdjustment(types.DefaultGasAdjustment)
+		if i == 0 {
+			xplac.WithURL(api)
+		} else {
+			xplac.WithGrpc(api)
+		}
+
+		// the stored code is verified by the checksum of the uploaded code in the broadcast response
+		storeMsg := types.StoreMsg{FilePath: testWasmFilePath}
+		txbytes, err := xplac.StoreCode(storeMsg).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		res, err := xplac.BroadcastAndWait(txbytes)
+		s.Require().NoError(err)
+		s.Require().NotZero(res.CodeID)
+		s.Require().Equal(checksum, res.Checksum)
+
+		sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+		s.Require().NoError(err)
+		s.Require().NoError(mwasm.VerifyStoredCodeChecksum(res, sdkTx.GetMsgs()))
+
+		// each stored code is verified by the event of its message
+		txbytes, err = xplac.WithSequence("").StoreCode(storeMsg).AppendMsg().StoreCode(storeMsg).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		res, err = xplac.BroadcastAndWait(txbytes)
+		s.Require().NoError(err)
+		s.Require().Len(res.Response.Logs, 2)
+
+		sdkTx, err = xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+		s.Require().NoError(err)
+		s.Require().NoError(mwasm.VerifyStoredCodeChecksum(res, sdkTx.GetMsgs()))
+
+		for _, event := range res.Response.Logs[1].Events {
+			for j, attribute := range event.Attributes {
+				if attribute.Key == wasmtypes.AttributeKeyChecksum {
+					event.Attributes[j].Value = strings.Repeat("0", 64)
+				}
+			}
+		}
+		s.Require().ErrorContains(mwasm.VerifyStoredCodeChecksum(res, sdkTx.GetMsgs()), "checksum mismatch")
+	}
+}
+
 func (s *IntegrationTestSuite) TestContractInfo() {
 	account0 := s.network.Validators[0].AdditionalAccount
 
@@ -297,6 +390,126 @@ func (s *IntegrationTestSuite) TestContractStateAll() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *IntegrationTestSuite) TestContractStateRaw() {
+	account0 := s.network.Validators[0].AdditionalAccount
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		contractStateRawMsg := types.ContractStateRawMsg{
+			ContractAddress: s.contractAddr,
+			Key:             mwasm.CwItemKey("minter").String(),
+		}
+		res, err := s.xplac.ContractStateRaw(contractStateRawMsg).QueryProto()
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(`"`+account0.Address.String()+`"`), res.(*wasmtypes.QueryRawContractStateResponse).Data)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestContractStatePrefix() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	holders := []string{account0.Address.String(), s.network.Validators[0].Address.String()}
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	result, err := xplac.DeployWasm(types.DeployWasmMsg{
+		FilePath: testCw20WasmFilePath,
+		Label:    "test contract state prefix",
+		InitMsg: `{"name":"prefix token","symbol":"PFX","decimals":6,"initial_balances":[` +
+			`{"address":"` + holders[0] + `","amount":"1000"},{"address":"` + holders[1] + `","amount":"2000"}]}`,
+		NoAdmin: "true",
+	})
+	s.Require().NoError(err)
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		// balances of cw20 are the map whose key is the address
+		balanceKey := mwasm.CwMapKey("balance", mwasm.CwStringKey(holders[0]))
+		res, err := s.xplac.ContractStateRaw(types.ContractStateRawMsg{
+			ContractAddress: result.ContractAddress,
+			Key:             balanceKey.String(),
+		}).QueryProto()
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(`"1000"`), res.(*wasmtypes.QueryRawContractStateResponse).Data)
+
+		// page through balances one by one
+		contractStatePrefixMsg := types.ContractStatePrefixMsg{
+			ContractAddress: result.ContractAddress,
+			Prefix:          mwasm.CwMapPrefix("balance").String(),
+		}
+		balances := make(map[string]string)
+		pagination := types.Pagination{Limit: 1}
+		for {
+			res, err := s.xplac.WithPagination(pagination).ContractStatePrefix(contractStatePrefixMsg).QueryProto()
+			s.Require().NoError(err)
+
+			stateResponse := res.(*wasmtypes.QueryAllContractStateResponse)
+			for _, model := range stateResponse.Models {
+				parts, err := mwasm.DecodeCwKey(model.Key, 1)
+				s.Require().NoError(err)
+				s.Require().Equal([]byte("balance"), parts[0])
+				balances[string(parts[1])] = string(model.Value)
+			}
+
+			if stateResponse.Pagination == nil || len(stateResponse.Pagination.NextKey) == 0 {
+				break
+			}
+			pagination.PageKey = string(stateResponse.Pagination.NextKey)
+		}
+		s.Require().Equal(map[string]string{holders[0]: `"1000"`, holders[1]: `"2000"`}, balances)
+
+		// the state out of the prefix is not included
+		res, err = s.xplac.WithPagination(types.Pagination{}).ContractStatePrefix(contractStatePrefixMsg).QueryProto()
+		s.Require().NoError(err)
+		stateResponse := res.(*wasmtypes.QueryAllContractStateResponse)
+		s.Require().Len(stateResponse.Models, 2)
+		s.Require().Empty(stateResponse.Pagination.NextKey)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestCwStorageKey() {
+	// the map whose key is (u64, string)
+	key := mwasm.CwMapKey("orders", mwasm.CwUint64Key(1), mwasm.CwStringKey("id"))
+	s.Require().Equal("00066F7264657273000800000000000000016964", key.String())
+	s.Require().Equal("00066F726465727300080000000000000001", mwasm.CwMapPrefix("orders", mwasm.CwUint64Key(1)).String())
+
+	parts, err := mwasm.DecodeCwKey(key, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("orders"), mwasm.CwUint64Key(1), []byte("id")}, parts)
+
+	n, err := mwasm.CwKeyToUint64(parts[1])
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), n)
+
+	_, err = mwasm.DecodeCwKey(key, 4)
+	s.Require().Error(err)
+
+	// signed keys keep the order
+	s.Require().Equal("7FFFFFFFFFFFFFFF", tmbytes.HexBytes(mwasm.CwInt64Key(-1)).String())
+	i, err := mwasm.CwKeyToInt64(mwasm.CwInt64Key(-1))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(-1), i)
+
+	// the item key is the namespace
+	parts, err = mwasm.DecodeCwKey(mwasm.CwItemKey("config"), 0)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("config")}, parts)
+}
+
 func (s *IntegrationTestSuite) TestContractHistory() {
 	account0 := s.network.Validators[0].AdditionalAccount
 
@@ -366,3 +579,424 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = validatorNumber
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 }
+
+func (s *IntegrationTestSuite) TestCw20() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	recipient := s.network.Validators[0].Address.String()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	// store and instantiate the cw20 contract
+	txbytes, err := xplac.StoreCode(types.StoreMsg{FilePath: testCw20WasmFilePath}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	storeTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// the code ID and the checksum of the stored code are returned without parsing events
+	wasmByteCode, err := os.ReadFile(testCw20WasmFilePath)
+	s.Require().NoError(err)
+	checksum := sha256.Sum256(wasmByteCode)
+	s.Require().NotZero(storeTxRes.CodeID)
+	s.Require().Equal(hex.EncodeToString(checksum[:]), storeTxRes.Checksum)
+
+	txbytes, err = xplac.WithSequence("").InstantiateContract(types.InstantiateMsg{
+		CodeId:  util.FromUint64ToString(storeTxRes.CodeID),
+		Amount:  "0",
+		Label:   "test cw20",
+		InitMsg: `{"name":"test token","symbol":"TTT","decimals":6,"initial_balances":[{"address":"` + account0.Address.String() + `","amount":"1000000"}]}`,
+		Admin:   account0.Address.String(),
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	instTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	s.Require().Equal(storeTxRes.CodeID, instTxRes.CodeID)
+	cw20Addr := instTxRes.ContractAddress
+
+	// transfer and increase allowance
+	txbytes, err = xplac.WithSequence("").Cw20Transfer(types.Cw20TransferMsg{
+		ContractAddress: cw20Addr,
+		Recipient:       recipient,
+		Amount:          "1000",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	txbytes, err = xplac.WithSequence("").Cw20IncreaseAllowance(types.Cw20IncreaseAllowanceMsg{
+		ContractAddress: cw20Addr,
+		Spender:         recipient,
+		Amount:          "500",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		// balance
+		res, err := s.xplac.Cw20Balance(types.Cw20BalanceMsg{
+			ContractAddress: cw20Addr,
+			Address:         account0.Address.String(),
+		}).Query()
+		s.Require().NoError(err)
+
+		var cw20BalanceResponse types.Cw20BalanceResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &cw20BalanceResponse))
+		s.Require().Equal("999000", cw20BalanceResponse.Balance)
+
+		// token info
+		res, err = s.xplac.Cw20TokenInfo(types.Cw20TokenInfoMsg{ContractAddress: cw20Addr}).Query()
+		s.Require().NoError(err)
+
+		var cw20TokenInfoResponse types.Cw20TokenInfoResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &cw20TokenInfoResponse))
+		s.Require().Equal(types.Cw20TokenInfoResponse{
+			Name:        "test token",
+			Symbol:      "TTT",
+			Decimals:    6,
+			TotalSupply: "1000000",
+		}, cw20TokenInfoResponse)
+
+		// all accounts by the page which has the single account
+		var accounts []string
+		pageRequest := types.CwPageRequest{Limit: 1}
+		for {
+			res, err = s.xplac.Cw20AllAccounts(types.Cw20AllAccountsMsg{
+				ContractAddress: cw20Addr,
+				PageRequest:     pageRequest,
+			}).Query()
+			s.Require().NoError(err)
+
+			var cw20AllAccountsResponse types.Cw20AllAccountsResponse
+			s.Require().NoError(json.Unmarshal([]byte(res), &cw20AllAccountsResponse))
+			accounts = append(accounts, cw20AllAccountsResponse.Accounts...)
+
+			var next bool
+			pageRequest, next = mwasm.NextCwPageRequest(pageRequest, cw20AllAccountsResponse.Accounts)
+			if !next {
+				break
+			}
+		}
+		s.Require().ElementsMatch([]string{account0.Address.String(), recipient}, accounts)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestCw721() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	recipient := s.network.Validators[0].Address.String()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	// the new contract is instantiated by the new code in order not to change states of the contract of the suite
+	txbytes, err := xplac.StoreCode(types.StoreMsg{FilePath: testWasmFilePath}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	storeTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	txbytes, err = xplac.WithSequence("").InstantiateContract(types.InstantiateMsg{
+		CodeId:  util.FromUint64ToString(storeTxRes.CodeID),
+		Amount:  "0",
+		Label:   "test cw721",
+		InitMsg: `{"name":"cw721-metadata-onchain","symbol":"CW721","minter":"` + account0.Address.String() + `"}`,
+		Admin:   account0.Address.String(),
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	instTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+	cw721Addr := instTxRes.ContractAddress
+
+	// mint, transfer and approve
+	for _, tokenId := range []string{"1", "2"} {
+		txbytes, err = xplac.WithSequence("").ExecuteContract(types.ExecuteMsg{
+			ContractAddress: cw721Addr,
+			Amount:          "0",
+			ExecMsg:         `{"mint":{"token_id":"` + tokenId + `","owner":"` + account0.Address.String() + `","token_uri":"https://xpla.io/nft/` + tokenId + `","extension":{"name":"test nft"}}}`,
+		}).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		_, err = xplac.BroadcastAndWait(txbytes)
+		s.Require().NoError(err)
+	}
+
+	txbytes, err = xplac.WithSequence("").Cw721TransferNft(types.Cw721TransferNftMsg{
+		ContractAddress: cw721Addr,
+		Recipient:       recipient,
+		TokenId:         "2",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	txbytes, err = xplac.WithSequence("").Cw721Approve(types.Cw721ApproveMsg{
+		ContractAddress: cw721Addr,
+		Spender:         recipient,
+		TokenId:         "1",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+
+		// owner of
+		res, err := s.xplac.Cw721OwnerOf(types.Cw721OwnerOfMsg{
+			ContractAddress: cw721Addr,
+			TokenId:         "1",
+		}).Query()
+		s.Require().NoError(err)
+
+		var cw721OwnerOfResponse types.Cw721OwnerOfResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &cw721OwnerOfResponse))
+		s.Require().Equal(account0.Address.String(), cw721OwnerOfResponse.Owner)
+		s.Require().Len(cw721OwnerOfResponse.Approvals, 1)
+		s.Require().Equal(recipient, cw721OwnerOfResponse.Approvals[0].Spender)
+
+		// nft info
+		res, err = s.xplac.Cw721NftInfo(types.Cw721NftInfoMsg{
+			ContractAddress: cw721Addr,
+			TokenId:         "2",
+		}).Query()
+		s.Require().NoError(err)
+
+		var cw721NftInfoResponse types.Cw721NftInfoResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &cw721NftInfoResponse))
+		s.Require().Equal("https://xpla.io/nft/2", cw721NftInfoResponse.TokenUri)
+		s.Require().Equal("test nft", cw721NftInfoResponse.Extension.(map[string]interface{})["name"])
+
+		// tokens
+		res, err = s.xplac.Cw721Tokens(types.Cw721TokensMsg{
+			ContractAddress: cw721Addr,
+			Owner:           recipient,
+		}).Query()
+		s.Require().NoError(err)
+
+		var cw721TokensResponse types.Cw721TokensResponse
+		s.Require().NoError(json.Unmarshal([]byte(res), &cw721TokensResponse))
+		s.Require().Equal([]string{"2"}, cw721TokensResponse.Tokens)
+
+		// all tokens by pages
+		var tokens []string
+		pageRequest := types.CwPageRequest{Limit: 1}
+		for {
+			res, err = s.xplac.Cw721AllTokens(types.Cw721AllTokensMsg{
+				ContractAddress: cw721Addr,
+				PageRequest:     pageRequest,
+			}).Query()
+			s.Require().NoError(err)
+
+			var cw721AllTokensResponse types.Cw721TokensResponse
+			s.Require().NoError(json.Unmarshal([]byte(res), &cw721AllTokensResponse))
+			tokens = append(tokens, cw721AllTokensResponse.Tokens...)
+
+			var next bool
+			pageRequest, next = mwasm.NextCwPageRequest(pageRequest, cw721AllTokensResponse.Tokens)
+			if !next {
+				break
+			}
+		}
+		s.Require().Equal([]string{"1", "2"}, tokens)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestCw721Binding() {
+	account0 := s.network.Validators[0].AdditionalAccount
+	recipient := s.network.Validators[0].Address.String()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	txbytes, err := xplac.StoreCode(types.StoreMsg{FilePath: testWasmFilePath}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	storeTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	// instantiate, mint and transfer by the generated binding
+	txbytes, err = cw721.InstantiateCw721(xplac.WithSequence(""), types.InstantiateMsg{
+		CodeId: util.FromUint64ToString(storeTxRes.CodeID),
+		Amount: "0",
+		Label:  "test cw721 binding",
+		Admin:  account0.Address.String(),
+	}, cw721.InstantiateMsg{
+		Name:   "cw721-metadata-onchain",
+		Symbol: "CW721",
+		Minter: account0.Address.String(),
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	instTxRes, err := xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	binding := cw721.NewCw721(xplac, instTxRes.ContractAddress)
+
+	tokenUri := "https://xpla.io/nft/1"
+	nftName := "test nft"
+	xplac.WithSequence("")
+	txbytes, err = binding.Mint(cw721.MintMsgForNullableMetadata{
+		TokenId:   "1",
+		Owner:     account0.Address.String(),
+		TokenUri:  &tokenUri,
+		Extension: &cw721.Metadata{Name: &nftName},
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	xplac.WithSequence("")
+	txbytes, err = binding.TransferNft(cw721.TransferNftMsg{
+		Recipient: recipient,
+		TokenId:   "1",
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.BroadcastAndWait(txbytes)
+	s.Require().NoError(err)
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac.WithURL(api)
+		} else {
+			s.xplac.WithGrpc(api)
+		}
+		queryBinding := cw721.NewCw721(s.xplac, binding.ContractAddress())
+
+		ownerOfResponse, err := queryBinding.OwnerOf(cw721.OwnerOfQuery{TokenId: "1"})
+		s.Require().NoError(err)
+		s.Require().Equal(recipient, ownerOfResponse.Owner)
+		s.Require().Len(ownerOfResponse.Approvals, 0)
+
+		nftInfoResponse, err := queryBinding.NftInfo(cw721.NftInfoQuery{TokenId: "1"})
+		s.Require().NoError(err)
+		s.Require().Equal(tokenUri, *nftInfoResponse.TokenUri)
+		s.Require().Equal(nftName, *nftInfoResponse.Extension.Name)
+
+		numTokensResponse, err := queryBinding.NumTokens()
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), numTokensResponse.Count)
+
+		contractInfoResponse, err := queryBinding.ContractInfo()
+		s.Require().NoError(err)
+		s.Require().Equal(cw721.ContractInfoResponse{Name: "cw721-metadata-onchain", Symbol: "CW721"}, contractInfoResponse)
+
+		minterResponse, err := queryBinding.Minter()
+		s.Require().NoError(err)
+		s.Require().Equal(account0.Address.String(), minterResponse.Minter)
+
+		// the query which fails in the contract returns the error
+		_, err = queryBinding.OwnerOf(cw721.OwnerOfQuery{TokenId: "2"})
+		s.Require().Error(err)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *IntegrationTestSuite) TestDeployWasm() {
+	account0 := s.network.Validators[0].AdditionalAccount
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithURL(s.apis[0]).
+		WithPrivateKey(account0.PrivKey).
+		WithGasAdjustment(types.DefaultGasAdjustment)
+
+	// the instantiation fails by the invalid message, but the stored code is kept in the result
+	deployWasmMsg := types.DeployWasmMsg{
+		FilePath: testCw20WasmFilePath,
+		Label:    "test deploy wasm",
+		InitMsg:  `{"name":"deploy token"}`,
+		Admin:    account0.Address.String(),
+	}
+	result, err := xplac.DeployWasm(deployWasmMsg)
+	s.Require().Error(err)
+	s.Require().NotZero(result.CodeID)
+	s.Require().NotEmpty(result.Checksum)
+	s.Require().NotEmpty(result.StoreTxHash)
+	s.Require().False(result.CodeReused)
+	s.Require().Empty(result.ContractAddress)
+
+	// resume the deployment without storing the code again
+	deployWasmMsg.InitMsg = `{"name":"deploy token","symbol":"DPT","decimals":6,"initial_balances":[]}`
+	deployWasmMsg.Resume = result
+	resumed, err := xplac.DeployWasm(deployWasmMsg)
+	s.Require().NoError(err)
+	s.Require().Equal(result.CodeID, resumed.CodeID)
+	s.Require().Equal(result.StoreTxHash, resumed.StoreTxHash)
+	s.Require().NotEmpty(resumed.InstantiateTxHash)
+	s.Require().NotEmpty(resumed.ContractAddress)
+
+	res, err := xplac.ContractInfo(types.ContractInfoMsg{ContractAddress: resumed.ContractAddress}).QueryProto()
+	s.Require().NoError(err)
+	contractInfo := res.(*wasmtypes.QueryContractInfoResponse)
+	s.Require().Equal(resumed.CodeID, contractInfo.CodeID)
+	s.Require().Equal(account0.Address.String(), contractInfo.Admin)
+
+	// the committed transactions whose results are missing are queried instead of broadcasting again
+	recovered, err := xplac.DeployWasm(types.DeployWasmMsg{
+		FilePath: deployWasmMsg.FilePath,
+		Label:    deployWasmMsg.Label,
+		InitMsg:  deployWasmMsg.InitMsg,
+		Admin:    deployWasmMsg.Admin,
+		Resume: &types.DeployWasmResult{
+			StoreTxHash:       resumed.StoreTxHash,
+			InstantiateTxHash: resumed.InstantiateTxHash,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(resumed.CodeID, recovered.CodeID)
+	s.Require().Equal(resumed.Checksum, recovered.Checksum)
+	s.Require().Equal(resumed.StoreTxHash, recovered.StoreTxHash)
+	s.Require().Equal(resumed.InstantiateTxHash, recovered.InstantiateTxHash)
+	s.Require().Equal(resumed.ContractAddress, recovered.ContractAddress)
+
+	// the transaction which is not found is broadcast again
+	notFoundTxHash := strings.Repeat("A", 64)
+	restored, err := xplac.DeployWasm(types.DeployWasmMsg{
+		FilePath: deployWasmMsg.FilePath,
+		Label:    deployWasmMsg.Label,
+		InitMsg:  deployWasmMsg.InitMsg,
+		Admin:    deployWasmMsg.Admin,
+		Resume:   &types.DeployWasmResult{StoreTxHash: notFoundTxHash},
+	})
+	s.Require().NoError(err)
+	s.Require().NotEqual(notFoundTxHash, restored.StoreTxHash)
+	s.Require().Greater(restored.CodeID, resumed.CodeID)
+	s.Require().NotEqual(resumed.ContractAddress, restored.ContractAddress)
+
+	// the code which has the same checksum is reused
+	deployWasmMsg.Resume = nil
+	deployWasmMsg.SkipStoredCode = true
+	reused, err := xplac.DeployWasm(deployWasmMsg)
+	s.Require().NoError(err)
+	s.Require().True(reused.CodeReused)
+	s.Require().Empty(reused.StoreTxHash)
+	s.Require().Equal(resumed.Checksum, reused.Checksum)
+	s.Require().NotEmpty(reused.ContractAddress)
+	s.Require().NotEqual(resumed.ContractAddress, reused.ContractAddress)
+}
@@ -0,0 +1,108 @@
+package wasm
+
+import (
+	"encoding/binary"
+
+	"github.com/xpladev/xpla.go/types"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Keys of the contract storage which is managed by cw-storage-plus.
+// The key of the map is the namespace and keys except the last one which are prefixed by the 2 bytes length,
+// and the last key is appended as it is. The hex string of the key is used for ContractStateRaw and ContractStatePrefix.
+
+// The key of the cw-storage-plus item is the namespace itself.
+func CwItemKey(namespace string) tmbytes.HexBytes {
+	return tmbytes.HexBytes(namespace)
+}
+
+// The key of the cw-storage-plus map entry.
+// Keys of the tuple key are given in order, and each key is made by CwStringKey, CwUint64Key and so on.
+func CwMapKey(namespace string, keys ...[]byte) tmbytes.HexBytes {
+	if len(keys) == 0 {
+		return CwMapPrefix(namespace)
+	}
+	key := CwMapPrefix(namespace, keys[:len(keys)-1]...)
+	return append(key, keys[len(keys)-1]...)
+}
+
+// The prefix of entries of the cw-storage-plus map.
+// Without keys, it is the prefix of all entries of the map,
+// and with the first keys of the tuple key, it is the prefix of entries which have the same first keys.
+func CwMapPrefix(namespace string, keys ...[]byte) tmbytes.HexBytes {
+	key := lengthPrefixed([]byte(namespace))
+	for _, k := range keys {
+		key = append(key, lengthPrefixed(k)...)
+	}
+	return key
+}
+
+// The string key, and also the key of the address.
+func CwStringKey(key string) []byte {
+	return []byte(key)
+}
+
+// The u64 key is the big endian bytes.
+func CwUint64Key(key uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, key)
+}
+
+// The u32 key is the big endian bytes.
+func CwUint32Key(key uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, key)
+}
+
+// The i64 key is the big endian bytes whose sign bit is flipped to keep the order of keys.
+func CwInt64Key(key int64) []byte {
+	return CwUint64Key(uint64(key) ^ (1 << 63))
+}
+
+// Split the key of the contract storage to the namespace and keys.
+// The depth is the number of length prefixed parts, i.e. 1 for the map which has the single key,
+// 2 for the map which has the pair key and 0 for the item.
+// The last part is the rest of the key after length prefixed parts.
+func DecodeCwKey(key []byte, depth int) ([][]byte, error) {
+	var parts [][]byte
+	for i := 0; i < depth; i++ {
+		if len(key) < 2 {
+			return nil, types.ErrWrap(types.ErrParse, "the key is shorter than the length prefix")
+		}
+		length := int(binary.BigEndian.Uint16(key[:2]))
+		if len(key) < 2+length {
+			return nil, types.ErrWrap(types.ErrParse, "the key is shorter than the length", length)
+		}
+		parts = append(parts, key[2:2+length])
+		key = key[2+length:]
+	}
+	return append(parts, key), nil
+}
+
+// Convert the u64 key to the number.
+func CwKeyToUint64(key []byte) (uint64, error) {
+	if len(key) != 8 {
+		return 0, types.ErrWrap(types.ErrParse, "the length of the u64 key must be 8, but", len(key))
+	}
+	return binary.BigEndian.Uint64(key), nil
+}
+
+// Convert the u32 key to the number.
+func CwKeyToUint32(key []byte) (uint32, error) {
+	if len(key) != 4 {
+		return 0, types.ErrWrap(types.ErrParse, "the length of the u32 key must be 4, but", len(key))
+	}
+	return binary.BigEndian.Uint32(key), nil
+}
+
+// Convert the i64 key to the number by flipping the sign bit.
+func CwKeyToInt64(key []byte) (int64, error) {
+	n, err := CwKeyToUint64(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(n ^ (1 << 63)), nil
+}
+
+func lengthPrefixed(key []byte) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(key))), key...)
+}
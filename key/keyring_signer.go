@@ -0,0 +1,67 @@
+package key
+
+import (
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+var _ Signer = &KeyringSigner{}
+
+// Keyring signer signs transactions by using the key stored in the keyring of the cosmos sdk.
+// The private key is not exported from the keyring.
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	name    string
+	pubKey  PublicKey
+}
+
+// Make new signer by using the key of the name in the keyring.
+func NewKeyringSigner(kr keyring.Keyring, name string) (*KeyringSigner, error) {
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrKeyNotFound, err)
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		name:    name,
+		pubKey:  info.GetPubKey(),
+	}, nil
+}
+
+// Get the name of the key in the keyring.
+func (s *KeyringSigner) Name() string {
+	return s.name
+}
+
+func (s *KeyringSigner) PubKey() PublicKey {
+	return s.pubKey
+}
+
+func (s *KeyringSigner) Sign(signBytes []byte) ([]byte, error) {
+	sig, _, err := s.keyring.Sign(s.name, signBytes)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return sig, nil
+}
+
+// The key of eth_secp256k1 signs 32 bytes hash without hashing it again,
+// and the signature is recoverable, so the evm hash is signed by the keyring directly.
+func (s *KeyringSigner) SignEvmHash(hash []byte) ([]byte, error) {
+	if _, ok := s.pubKey.(*ethsecp256k1.PubKey); !ok {
+		return nil, types.ErrWrap(types.ErrNotSupport, "evm transaction must be signed by the key of", types.DefaultXplaKeyAlgo)
+	}
+	if len(hash) != ethcrypto.DigestLength {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "invalid hash length:", len(hash))
+	}
+
+	sig, _, err := s.keyring.Sign(s.name, hash)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return sig, nil
+}
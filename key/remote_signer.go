@@ -0,0 +1,139 @@
+package key
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+)
+
+const (
+	remoteSignerPubKeyPath      = "/pubkey"
+	remoteSignerSignPath        = "/sign"
+	remoteSignerSignEvmHashPath = "/sign_evm_hash"
+)
+
+type remoteSignRequest struct {
+	Bytes []byte `json:"bytes"`
+}
+
+type remoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+var _ Signer = &RemoteSigner{}
+
+// Remote signer requests signatures to the signing server by HTTP, so the private key is not kept in the process.
+// The signing server is served by NewSignerHandler.
+type RemoteSigner struct {
+	url    string
+	ctx    context.Context
+	pubKey PublicKey
+}
+
+// Make new remote signer. The public key is loaded from the signing server.
+func NewRemoteSigner(url string, ctx context.Context) (*RemoteSigner, error) {
+	url = strings.TrimSuffix(url, "/")
+	out, err := util.CtxHttpClient("GET", url+remoteSignerPubKeyPath, nil, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey PublicKey
+	if err := util.MakeEncodingConfig().Codec.UnmarshalInterfaceJSON(out, &pubKey); err != nil {
+		return nil, types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+
+	return &RemoteSigner{
+		url:    url,
+		ctx:    ctx,
+		pubKey: pubKey,
+	}, nil
+}
+
+func (s *RemoteSigner) PubKey() PublicKey {
+	return s.pubKey
+}
+
+func (s *RemoteSigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.request(remoteSignerSignPath, signBytes)
+}
+
+func (s *RemoteSigner) SignEvmHash(hash []byte) ([]byte, error) {
+	return s.request(remoteSignerSignEvmHashPath, hash)
+}
+
+func (s *RemoteSigner) request(path string, bytes []byte) ([]byte, error) {
+	reqBytes, err := json.Marshal(remoteSignRequest{Bytes: bytes})
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrFailedToMarshal, err)
+	}
+
+	out, err := util.CtxHttpClient("POST", s.url+path, reqBytes, s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res remoteSignResponse
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+	return res.Signature, nil
+}
+
+// Make HTTP handler of the signing server for the remote signer.
+// It is a simple stand-in of the signing service, e.g. for tests, so do not expose it to the public network.
+func NewSignerHandler(signer Signer) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(remoteSignerPubKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		out, err := util.MakeEncodingConfig().Codec.MarshalInterfaceJSON(signer.PubKey())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(out)
+	})
+	mux.HandleFunc(remoteSignerSignPath, signHandlerFunc(signer.Sign))
+	mux.HandleFunc(remoteSignerSignEvmHashPath, signHandlerFunc(signer.SignEvmHash))
+
+	return mux
+}
+
+func signHandlerFunc(sign func([]byte) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var req remoteSignRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sig, err := sign(req.Bytes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		out, err := json.Marshal(remoteSignResponse{Signature: sig})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(out)
+	}
+}
@@ -0,0 +1,59 @@
+package key
+
+import (
+	"github.com/xpladev/xpla.go/types"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs transactions without exposing the private key to the xpla client.
+// The private key can be kept in other process, e.g. a signing service or a hardware wallet.
+type Signer interface {
+	// Public key of the signer.
+	PubKey() PublicKey
+	// Sign bytes of the cosmos transaction (sign mode direct or legacy amino JSON).
+	// The result is same as the signature of PrivKey.Sign.
+	Sign(signBytes []byte) ([]byte, error)
+	// Sign 32 bytes hash of the evm transaction.
+	// The result is the 65 bytes recoverable signature [R || S || V] which V is 0 or 1.
+	SignEvmHash(hash []byte) ([]byte, error)
+}
+
+var _ Signer = &PrivKeySigner{}
+
+// Default signer which has the private key in memory.
+type PrivKeySigner struct {
+	privKey PrivateKey
+}
+
+// Make new signer by using the private key.
+func NewPrivKeySigner(privKey PrivateKey) *PrivKeySigner {
+	return &PrivKeySigner{
+		privKey: privKey,
+	}
+}
+
+func (s *PrivKeySigner) PubKey() PublicKey {
+	return s.privKey.PubKey()
+}
+
+func (s *PrivKeySigner) Sign(signBytes []byte) ([]byte, error) {
+	sig, err := s.privKey.Sign(signBytes)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return sig, nil
+}
+
+func (s *PrivKeySigner) SignEvmHash(hash []byte) ([]byte, error) {
+	ethPrivKey, err := ethcrypto.ToECDSA(s.privKey.Bytes())
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+
+	sig, err := ethcrypto.Sign(hash, ethPrivKey)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return sig, nil
+}
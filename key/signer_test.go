@@ -0,0 +1,120 @@
+package key
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xpladev/xpla.go/util"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivKeySigner(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+
+	privKey, err := NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	signer := NewPrivKeySigner(privKey)
+	require.True(t, privKey.PubKey().Equals(signer.PubKey()))
+
+	// cosmos signature
+	msg := []byte("xpla")
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, signer.PubKey().VerifySignature(msg, sig))
+
+	// evm recoverable signature
+	hash := ethcrypto.Keccak256(msg)
+	evmSig, err := signer.SignEvmHash(hash)
+	require.NoError(t, err)
+	require.Len(t, evmSig, 65)
+
+	recovered, err := ethcrypto.SigToPub(hash, evmSig)
+	require.NoError(t, err)
+	require.Equal(t, signer.PubKey().Address().Bytes(), ethcrypto.PubkeyToAddress(*recovered).Bytes())
+}
+
+func TestRemoteSigner(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+
+	privKey, err := NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(NewSignerHandler(NewPrivKeySigner(privKey)))
+	defer server.Close()
+
+	signer, err := NewRemoteSigner(server.URL, context.Background())
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().Equals(signer.PubKey()))
+
+	// signatures of the remote signer are same as signatures of the private key
+	msg := []byte("xpla")
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+
+	hash := ethcrypto.Keccak256(msg)
+	evmSig, err := signer.SignEvmHash(hash)
+	require.NoError(t, err)
+
+	expectedEvmSig, err := NewPrivKeySigner(privKey).SignEvmHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, expectedEvmSig, evmSig)
+
+	// invalid hash length
+	_, err = signer.SignEvmHash(msg)
+	require.Error(t, err)
+}
+
+func TestKeyringSigner(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+
+	privKey, err := NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	kr, err := util.NewKeyring(util.BackendMemory, "")
+	require.NoError(t, err)
+
+	_, err = NewKeyringSigner(kr, "xpla")
+	require.Error(t, err)
+
+	// the armor of the private key is imported to the keyring
+	armor, err := EncryptArmorPrivKey(privKey, "passphrase")
+	require.NoError(t, err)
+	require.NoError(t, kr.ImportPrivKey("xpla", armor, "passphrase"))
+
+	signer, err := NewKeyringSigner(kr, "xpla")
+	require.NoError(t, err)
+	require.Equal(t, "xpla", signer.Name())
+	require.True(t, privKey.PubKey().Equals(signer.PubKey()))
+
+	msg := []byte("xpla")
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+
+	hash := ethcrypto.Keccak256(msg)
+	evmSig, err := signer.SignEvmHash(hash)
+	require.NoError(t, err)
+
+	recovered, err := ethcrypto.SigToPub(hash, evmSig)
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey().Address().Bytes(), ethcrypto.PubkeyToAddress(*recovered).Bytes())
+
+	_, err = signer.SignEvmHash(msg)
+	require.Error(t, err)
+
+	// the exported armor is decrypted to the same private key
+	exported, err := kr.ExportPrivKeyArmor("xpla", "passphrase")
+	require.NoError(t, err)
+
+	decrypted, _, err := UnarmorDecryptPrivKey(exported, "passphrase")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decrypted))
+}
@@ -6,15 +6,18 @@ import (
 
 	"github.com/xpladev/xpla.go/key"
 	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
 	"github.com/xpladev/xpla/app/params"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
 )
 
 // The standard form of XPLA client is interface type.
@@ -53,34 +56,47 @@ type XplaClient interface {
 	TxProvider
 	QueryProvider
 	BroadcastProvider
+	SubscribeProvider
+	KeyringProvider
 	InfoRequestProvider
 	TxMsgProvider
 	QueryMsgProvider
+	WorkflowProvider
 	HelperProvider
 }
 
 // Optional parameters of client.xplaClient.
 type Options struct {
-	PrivateKey     key.PrivateKey
-	PublicKey      key.PublicKey
-	AccountNumber  string
-	Sequence       string
-	BroadcastMode  string
-	GasLimit       string
-	GasPrice       string
-	GasAdjustment  string
-	FeeAmount      string
-	SignMode       signing.SignMode
-	FeeGranter     sdk.AccAddress
-	TimeoutHeight  string
-	LcdURL         string
-	GrpcURL        string
-	RpcURL         string
-	EvmRpcURL      string
-	Pagination     types.Pagination
-	OutputDocument string
-	FromAddress    sdk.AccAddress
-	Verbose        int
+	PrivateKey      key.PrivateKey
+	PublicKey       key.PublicKey
+	Signer          key.Signer
+	KeyringBackend  string
+	KeyringDir      string
+	FromName        string
+	AccountNumber   string
+	Sequence        string
+	BroadcastMode   string
+	GasLimit        string
+	GasPrice        string
+	GasAdjustment   string
+	FeeAmount       string
+	EvmDynamicFee   bool
+	GasFeeCap       string
+	GasTipCap       string
+	SignMode        signing.SignMode
+	FeeGranter      sdk.AccAddress
+	TimeoutHeight   string
+	Memo            string
+	LcdURL          string
+	GrpcURL         string
+	RpcURL          string
+	EvmRpcURL       string
+	Pagination      types.Pagination
+	OutputDocument  string
+	FromAddress     sdk.AccAddress
+	Verbose         int
+	SequenceManager *util.SequenceManager
+	DenomTraceCache *util.DenomTraceCache
 }
 
 // Methods set params of client.xplaClient.
@@ -92,6 +108,9 @@ type WithProvider interface {
 	WithContext(context.Context) XplaClient
 	WithPrivateKey(key.PrivateKey) XplaClient
 	WithPublicKey(key.PublicKey) XplaClient
+	WithSigner(key.Signer) XplaClient
+	WithKeyring(string, string) XplaClient
+	WithFromName(string) XplaClient
 	WithAccountNumber(string) XplaClient
 	WithBroadcastMode(string) XplaClient
 	WithSequence(string) XplaClient
@@ -99,9 +118,13 @@ type WithProvider interface {
 	WithGasPrice(string) XplaClient
 	WithGasAdjustment(string) XplaClient
 	WithFeeAmount(string) XplaClient
+	WithEvmDynamicFee(bool) XplaClient
+	WithGasFeeCap(string) XplaClient
+	WithGasTipCap(string) XplaClient
 	WithSignMode(signing.SignMode) XplaClient
 	WithFeeGranter(sdk.AccAddress) XplaClient
 	WithTimeoutHeight(string) XplaClient
+	WithMemo(string) XplaClient
 	WithURL(string) XplaClient
 	WithGrpc(string) XplaClient
 	WithRpc(string) XplaClient
@@ -110,10 +133,14 @@ type WithProvider interface {
 	WithOutputDocument(string) XplaClient
 	WithFromAddress(sdk.AccAddress) XplaClient
 	WithVerbose(int) XplaClient
+	WithSequenceManager(*util.SequenceManager) XplaClient
+	WithDenomTraceCache(*util.DenomTraceCache) XplaClient
 	WithModule(string) XplaClient
 	WithMsgType(string) XplaClient
 	WithMsg(interface{}) XplaClient
 	WithErr(error) XplaClient
+	AppendMsg() XplaClient
+	ClearMsgs() XplaClient
 }
 
 // Methods get params of client.xplaClient.
@@ -121,6 +148,9 @@ type GetProvider interface {
 	GetChainId() string
 	GetPrivateKey() key.PrivateKey
 	GetPublicKey() key.PublicKey
+	GetSigner() key.Signer
+	GetKeyring() keyring.Keyring
+	GetFromName() string
 	GetEncoding() params.EncodingConfig
 	GetContext() context.Context
 	GetLcdURL() string
@@ -135,16 +165,23 @@ type GetProvider interface {
 	GetGasPrice() string
 	GetGasAdjustment() string
 	GetFeeAmount() string
+	GetEvmDynamicFee() bool
+	GetGasFeeCap() string
+	GetGasTipCap() string
 	GetSignMode() signing.SignMode
 	GetFeeGranter() sdk.AccAddress
 	GetTimeoutHeight() string
+	GetMemo() string
 	GetPagination() *query.PageRequest
 	GetOutputDocument() string
 	GetFromAddress() sdk.AccAddress
 	GetHttpMutex() *sync.Mutex
 	GetLogger() types.Logger
+	GetSequenceManager() *util.SequenceManager
+	GetDenomTraceCache() *util.DenomTraceCache
 	GetModule() string
 	GetMsg() interface{}
+	GetMsgs() []sdk.Msg
 	GetMsgType() string
 	GetErr() error
 }
@@ -163,6 +200,7 @@ type TxProvider interface {
 // Method handles query functions.
 type QueryProvider interface {
 	Query() (string, error)
+	QueryProto() (proto.Message, error)
 }
 
 // Methods handle functions of broadcasting.
@@ -170,6 +208,24 @@ type BroadcastProvider interface {
 	Broadcast([]byte) (*types.TxRes, error)
 	BroadcastBlock([]byte) (*types.TxRes, error)
 	BroadcastAsync([]byte) (*types.TxRes, error)
+	BroadcastAndWait([]byte) (*types.TxRes, error)
+	GetTxRes(string) (*types.TxRes, error)
+}
+
+// Methods subscribe events by using the tendermint websocket.
+type SubscribeProvider interface {
+	Subscribe(string) (*util.Subscription, error)
+	SubscribeNewBlock() (*util.Subscription, error)
+	SubscribeTx(string) (*util.Subscription, error)
+}
+
+// Methods manage keys in the keyring of the xpla client.
+type KeyringProvider interface {
+	ListKeys() ([]keyring.Info, error)
+	AddKey(string, string) (keyring.Info, error)
+	ImportKey(string, string, string) error
+	ExportKey(string, string) (string, error)
+	DeleteKey(string) error
 }
 
 // Methods get information from XPLA chain.
@@ -202,6 +258,13 @@ type TxMsgProvider interface {
 	EvmSendCoin(types.SendCoinMsg) XplaClient
 	DeploySolidityContract(types.DeploySolContractMsg) XplaClient
 	InvokeSolidityContract(types.InvokeSolContractMsg) XplaClient
+	Erc20Transfer(types.Erc20TransferMsg) XplaClient
+	Erc20Approve(types.Erc20ApproveMsg) XplaClient
+	Erc20TransferFrom(types.Erc20TransferFromMsg) XplaClient
+	Erc721SafeTransferFrom(types.Erc721SafeTransferFromMsg) XplaClient
+	Erc721Approve(types.Erc721ApproveMsg) XplaClient
+	Erc721SetApprovalForAll(types.Erc721SetApprovalForAllMsg) XplaClient
+	Erc1155SafeBatchTransferFrom(types.Erc1155SafeBatchTransferFromMsg) XplaClient
 
 	// feegrant
 	FeeGrant(types.FeeGrantMsg) XplaClient
@@ -213,6 +276,9 @@ type TxMsgProvider interface {
 	Vote(types.VoteMsg) XplaClient
 	WeightedVote(types.WeightedVoteMsg) XplaClient
 
+	// ibc
+	IbcTransfer(types.IbcTransferMsg) XplaClient
+
 	// params
 	ParamChange(types.ParamChangeMsg) XplaClient
 
@@ -244,6 +310,12 @@ type TxMsgProvider interface {
 	ClearContractAdmin(types.ClearContractAdminMsg) XplaClient
 	SetContractAdmin(types.SetContractAdminMsg) XplaClient
 	Migrate(types.MigrateMsg) XplaClient
+	Cw20Transfer(types.Cw20TransferMsg) XplaClient
+	Cw20Send(types.Cw20SendMsg) XplaClient
+	Cw20IncreaseAllowance(types.Cw20IncreaseAllowanceMsg) XplaClient
+	Cw721TransferNft(types.Cw721TransferNftMsg) XplaClient
+	Cw721SendNft(types.Cw721SendNftMsg) XplaClient
+	Cw721Approve(types.Cw721ApproveMsg) XplaClient
 }
 
 // Methods are external functions of each module for querying.
@@ -308,6 +380,14 @@ type QueryMsgProvider interface {
 	EthGetFilterLogs(types.EthGetFilterLogsMsg) XplaClient
 	EthGetLogs(types.EthGetLogsMsg) XplaClient
 	EthCoinbase() XplaClient
+	Erc20BalanceOf(types.Erc20BalanceOfMsg) XplaClient
+	Erc20Allowance(types.Erc20AllowanceMsg) XplaClient
+	Erc20TokenInfo(types.Erc20TokenInfoMsg) XplaClient
+	Erc721OwnerOf(types.Erc721OwnerOfMsg) XplaClient
+	Erc721TokenURI(types.Erc721TokenURIMsg) XplaClient
+	Erc721BalanceOf(types.Erc721BalanceOfMsg) XplaClient
+	Erc1155BalanceOfBatch(types.Erc1155BalanceOfBatchMsg) XplaClient
+	Erc1155Uri(types.Erc1155UriMsg) XplaClient
 
 	// feegrant
 	QueryFeeGrants(types.QueryFeeGrantMsg) XplaClient
@@ -352,6 +432,9 @@ type QueryMsgProvider interface {
 	IbcDenomHash(types.IbcDenomHashMsg) XplaClient
 	IbcEscrowAddress(types.IbcEscrowAddressMsg) XplaClient
 	IbcTransferParams() XplaClient
+	IbcInterchainAccount(types.IbcInterchainAccountMsg) XplaClient
+	IbcIcaControllerParams() XplaClient
+	IbcIcaHostParams() XplaClient
 
 	// params
 	QuerySubspace(types.SubspaceMsg) XplaClient
@@ -387,11 +470,35 @@ type QueryMsgProvider interface {
 	ListContractByCode(types.ListContractByCodeMsg) XplaClient
 	Download(types.DownloadMsg) XplaClient
 	CodeInfo(types.CodeInfoMsg) XplaClient
+	VerifyCode(types.VerifyCodeMsg) XplaClient
 	ContractInfo(types.ContractInfoMsg) XplaClient
 	ContractStateAll(types.ContractStateAllMsg) XplaClient
+	ContractStateRaw(types.ContractStateRawMsg) XplaClient
+	ContractStatePrefix(types.ContractStatePrefixMsg) XplaClient
 	ContractHistory(types.ContractHistoryMsg) XplaClient
 	Pinned() XplaClient
 	LibwasmvmVersion() XplaClient
+	Cw20Balance(types.Cw20BalanceMsg) XplaClient
+	Cw20TokenInfo(types.Cw20TokenInfoMsg) XplaClient
+	Cw20AllAccounts(types.Cw20AllAccountsMsg) XplaClient
+	Cw721OwnerOf(types.Cw721OwnerOfMsg) XplaClient
+	Cw721NftInfo(types.Cw721NftInfoMsg) XplaClient
+	Cw721Tokens(types.Cw721TokensMsg) XplaClient
+	Cw721AllTokens(types.Cw721AllTokensMsg) XplaClient
+}
+
+// Methods run workflows which consist of multiple transactions or queries.
+// Each transaction is signed by options of the xpla client and waited until it is committed.
+type WorkflowProvider interface {
+	// ibc
+	IbcPacketStatus(types.IbcPacket, XplaClient) (types.IbcPacketStatus, error)
+	TrackIbcPacket(types.IbcPacket, XplaClient, func(types.IbcPacketStatus)) (types.IbcPacketStatus, error)
+	ResolvedBalances(types.BankBalancesMsg) (types.ResolvedBalancesResponse, error)
+	IbcChannelHealth(types.IbcChannelMsg, XplaClient) (types.IbcChannelHealth, error)
+	IbcChannelHealthReport(...XplaClient) ([]types.IbcChannelHealth, error)
+
+	// wasm
+	DeployWasm(types.DeployWasmMsg) (*types.DeployWasmResult, error)
 }
 
 // Method of helper.
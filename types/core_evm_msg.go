@@ -86,6 +86,9 @@ type EthGetLogsMsg struct {
 	ToBlock   string
 	Address   []string
 	Topics    []string
+	// Optional ABI of the contract in order to decode logs
+	ABI             string
+	ABIJsonFilePath string
 }
 
 type EthUninstallFilterMsg struct {
@@ -94,10 +97,113 @@ type EthUninstallFilterMsg struct {
 
 type EthGetFilterChangesMsg struct {
 	FilterId string
+	// Optional ABI of the contract in order to decode logs
+	ABI             string
+	ABIJsonFilePath string
 }
 
 type EthGetFilterLogsMsg struct {
 	FilterId string
+	// Optional ABI of the contract in order to decode logs
+	ABI             string
+	ABIJsonFilePath string
+}
+
+// Amounts of ERC-20 messages are the amount of the smallest unit of the token.
+// If decimals are set, the amount can have fractional digits as the token unit, e.g. "1.5".
+type Erc20TransferMsg struct {
+	ContractAddress string
+	Recipient       string
+	Amount          string
+	Decimals        string
+}
+
+type Erc20ApproveMsg struct {
+	ContractAddress string
+	Spender         string
+	Amount          string
+	Decimals        string
+}
+
+type Erc20TransferFromMsg struct {
+	ContractAddress string
+	Owner           string
+	Recipient       string
+	Amount          string
+	Decimals        string
+}
+
+type Erc20BalanceOfMsg struct {
+	ContractAddress string
+	Account         string
+}
+
+type Erc20AllowanceMsg struct {
+	ContractAddress string
+	Owner           string
+	Spender         string
+}
+
+type Erc20TokenInfoMsg struct {
+	ContractAddress string
+}
+
+// Token IDs and amounts of ERC-721 and ERC-1155 messages are decimal uint256 values.
+// Data is the hex encoded bytes which are passed to the receiver contract, and it can be empty.
+type Erc721SafeTransferFromMsg struct {
+	ContractAddress string
+	From            string
+	To              string
+	TokenId         string
+	Data            string
+}
+
+type Erc721ApproveMsg struct {
+	ContractAddress string
+	To              string
+	TokenId         string
+}
+
+type Erc721SetApprovalForAllMsg struct {
+	ContractAddress string
+	Operator        string
+	Approved        bool
+}
+
+type Erc721OwnerOfMsg struct {
+	ContractAddress string
+	TokenId         string
+}
+
+type Erc721TokenURIMsg struct {
+	ContractAddress string
+	TokenId         string
+}
+
+type Erc721BalanceOfMsg struct {
+	ContractAddress string
+	Owner           string
+}
+
+type Erc1155SafeBatchTransferFromMsg struct {
+	ContractAddress string
+	From            string
+	To              string
+	Ids             []string
+	Amounts         []string
+	Data            string
+}
+
+// The balance of accounts[i] and ids[i] is queried.
+type Erc1155BalanceOfBatchMsg struct {
+	ContractAddress string
+	Accounts        []string
+	Ids             []string
+}
+
+type Erc1155UriMsg struct {
+	ContractAddress string
+	Id              string
 }
 
 // Responses
@@ -197,17 +303,94 @@ type EthUninstallFilterResponse struct {
 }
 
 type EthGetFilterChangesResponse struct {
+	// Hashes of blocks or transactions of the block filter and the pending transaction filter
 	GetFilterChanges []string `json:"eth_getFilterChanges"`
+	// Logs of the log filter
+	Logs        []ethtypes.Log `json:"logs,omitempty"`
+	DecodedLogs []EvmEventLog  `json:"decoded_logs,omitempty"`
 }
 
 type EthGetFilterLogsResponse struct {
-	GetFilterLogs []string `json:"eth_getFilterLogs"`
+	GetFilterLogs []ethtypes.Log `json:"eth_getFilterLogs"`
+	DecodedLogs   []EvmEventLog  `json:"decoded_logs,omitempty"`
 }
 
 type EthGetLogsResponse struct {
-	GetLogs interface{} `json:"eth_getLogs"`
+	GetLogs     interface{}   `json:"eth_getLogs"`
+	DecodedLogs []EvmEventLog `json:"decoded_logs,omitempty"`
+}
+
+// The evm log which is decoded by the ABI of the contract.
+// If the event of the log is not found in the ABI or the log cannot be decoded, the event name is empty
+// and the reason is recorded in the decode error, so the raw log is still delivered.
+type EvmEventLog struct {
+	Event      string                 `json:"event,omitempty"`
+	Signature  string                 `json:"signature,omitempty"`
+	Anonymous  bool                   `json:"anonymous,omitempty"`
+	Indexed    map[string]interface{} `json:"indexed,omitempty"`
+	NonIndexed map[string]interface{} `json:"non_indexed,omitempty"`
+	DecodeErr  string                 `json:"decode_error,omitempty"`
+	Log        ethtypes.Log           `json:"log"`
 }
 
 type EthCoinbaseResponse struct {
 	Coinbase string `json:"eth_coinbase"`
 }
+
+// Amounts of ERC-20 responses are the amount of the smallest unit of the token,
+// and formatted amounts are the amount of the token unit by decimals.
+type Erc20BalanceOfResponse struct {
+	Balance          string `json:"balance"`
+	Decimals         uint8  `json:"decimals"`
+	FormattedBalance string `json:"formatted_balance"`
+}
+
+type Erc20AllowanceResponse struct {
+	Allowance          string `json:"allowance"`
+	Decimals           uint8  `json:"decimals"`
+	FormattedAllowance string `json:"formatted_allowance"`
+}
+
+type Erc20TokenInfoResponse struct {
+	Name                 string `json:"name"`
+	Symbol               string `json:"symbol"`
+	Decimals             uint8  `json:"decimals"`
+	TotalSupply          string `json:"total_supply"`
+	FormattedTotalSupply string `json:"formatted_total_supply"`
+}
+
+type Erc721OwnerOfResponse struct {
+	Owner string `json:"owner"`
+}
+
+type Erc721TokenURIResponse struct {
+	TokenURI string `json:"token_uri"`
+}
+
+type Erc721BalanceOfResponse struct {
+	Balance string `json:"balance"`
+}
+
+type Erc1155BalanceOfBatchResponse struct {
+	Balances []string `json:"balances"`
+}
+
+// The URI of ERC-1155 can include "{id}", and it is substituted by the hex token ID in the resolved URI.
+type Erc1155UriResponse struct {
+	Uri         string `json:"uri"`
+	ResolvedUri string `json:"resolved_uri"`
+}
+
+// The NFT transfer which is decoded from Transfer event of ERC-721 or TransferSingle and TransferBatch events of ERC-1155.
+// Amounts of ERC-721 transfers are always 1.
+type NftTransfer struct {
+	Standard        string       `json:"standard"`
+	Event           string       `json:"event"`
+	ContractAddress string       `json:"contract_address"`
+	Operator        string       `json:"operator,omitempty"`
+	From            string       `json:"from"`
+	To              string       `json:"to"`
+	TokenIds        []string     `json:"token_ids"`
+	Amounts         []string     `json:"amounts"`
+	Log             ethtypes.Log `json:"log"`
+}
@@ -1,5 +1,26 @@
 package types
 
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Amount is the coin whose denom can be the IBC denom or the denom trace, e.g. "transfer/channel-0/uatom".
+// TimeoutHeight is "{revision}-{height}" and TimeoutTimestamp is nanoseconds. If they are empty, defaults of ibc-go are used,
+// and the timeout is disabled when it is set to zero.
+// Timeouts are relative to the latest consensus state of the counterparty chain unless AbsoluteTimeouts is true.
+type IbcTransferMsg struct {
+	SourcePort       string
+	SourceChannel    string
+	Receiver         string
+	Amount           string
+	TimeoutHeight    string
+	TimeoutTimestamp string
+	AbsoluteTimeouts bool
+	Memo             string
+}
+
 type IbcClientStateMsg struct {
 	ClientId string
 }
@@ -62,12 +83,14 @@ type IbcChannelPacketAckMsg struct {
 	Sequence  string
 }
 
+// Sequence can be the list of sequences which are separated by commas, e.g. "1,2,3".
 type IbcChannelUnreceivedPacketsMsg struct {
 	ChannelId string
 	PortId    string
 	Sequence  string
 }
 
+// Sequence can be the list of sequences which are separated by commas, e.g. "1,2,3".
 type IbcChannelUnreceivedAcksMsg struct {
 	ChannelId string
 	PortId    string
@@ -91,3 +114,99 @@ type IbcEscrowAddressMsg struct {
 	ChannelId string
 	PortId    string
 }
+
+type IbcInterchainAccountMsg struct {
+	Owner        string
+	ConnectionId string
+}
+
+// The packet which is sent by the transaction, which is parsed from the send_packet event.
+type IbcPacket struct {
+	Sequence           uint64
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	TimeoutHeight      string
+	TimeoutTimestamp   uint64
+}
+
+// The status of the IBC packet moves from pending to received, and then acknowledged.
+// If the packet is not received until the timeout, it is timed out.
+type IbcPacketState string
+
+const (
+	IbcPacketPending      IbcPacketState = "pending"
+	IbcPacketReceived     IbcPacketState = "received"
+	IbcPacketAcknowledged IbcPacketState = "acknowledged"
+	IbcPacketTimedOut     IbcPacketState = "timed-out"
+)
+
+// The acknowledgement is read from the write_acknowledgement event on the counterparty chain.
+// If the acknowledgement is the error, AckError has the error message.
+type IbcPacketStatus struct {
+	Packet     IbcPacket
+	State      IbcPacketState
+	AckSuccess bool
+	AckError   string
+}
+
+// The balance whose denomination is resolved to the origin.
+// Path and BaseDenom are the trace of the IBC voucher, and the path is empty for the native denomination.
+// Display and Exponent are the display unit of the bank metadata if the metadata of the denomination is registered.
+type ResolvedBalance struct {
+	Denom         string `json:"denom"`
+	Amount        string `json:"amount"`
+	Path          string `json:"path"`
+	BaseDenom     string `json:"base_denom"`
+	Display       string `json:"display,omitempty"`
+	Exponent      uint32 `json:"exponent,omitempty"`
+	DisplayAmount string `json:"display_amount,omitempty"`
+}
+
+type ResolvedBalancesResponse struct {
+	Balances   []ResolvedBalance   `json:"balances"`
+	Pagination *query.PageResponse `json:"pagination,omitempty"`
+}
+
+// The health of the open channel, its connection and the light client which tracks the counterparty chain.
+// The client expires when the trusting period is passed since the latest consensus state, so ExpiresIn is negative
+// if the client is expired. The trusting period is zero if the light client is not the tendermint client.
+// Packets of the counterparty chain are counted only if the xpla client of the counterparty chain is given.
+// Error is the reason why the channel is reported partially in the health report of all channels.
+type IbcChannelHealth struct {
+	PortId                          string          `json:"port_id"`
+	ChannelId                       string          `json:"channel_id"`
+	State                           string          `json:"state"`
+	Ordering                        string          `json:"ordering"`
+	Version                         string          `json:"version"`
+	CounterpartyPortId              string          `json:"counterparty_port_id"`
+	CounterpartyChannelId           string          `json:"counterparty_channel_id"`
+	ConnectionId                    string          `json:"connection_id"`
+	CounterpartyConnectionId        string          `json:"counterparty_connection_id"`
+	ClientId                        string          `json:"client_id"`
+	CounterpartyClientId            string          `json:"counterparty_client_id"`
+	CounterpartyChainId             string          `json:"counterparty_chain_id"`
+	ClientStatus                    string          `json:"client_status"`
+	LatestHeight                    string          `json:"latest_height"`
+	LastUpdateTime                  time.Time       `json:"last_update_time"`
+	SinceLastUpdate                 time.Duration   `json:"since_last_update"`
+	TrustingPeriod                  time.Duration   `json:"trusting_period"`
+	ExpiresIn                       time.Duration   `json:"expires_in"`
+	NextSequenceReceive             uint64          `json:"next_sequence_receive"`
+	CounterpartyNextSequenceReceive uint64          `json:"counterparty_next_sequence_receive"`
+	CounterpartyQueried             bool            `json:"counterparty_queried"`
+	Sent                            IbcPacketCounts `json:"sent"`
+	Received                        IbcPacketCounts `json:"received"`
+	Error                           string          `json:"error,omitempty"`
+}
+
+// Counts of packets which are sent through the channel in one direction.
+// Pending packets are committed on the sending chain, i.e. they are not acknowledged or timed out yet.
+// Unreceived acks are pending packets which are received by the receiving chain, but whose acknowledgements
+// are not relayed to the sending chain yet.
+type IbcPacketCounts struct {
+	Pending        uint64 `json:"pending"`
+	Unreceived     uint64 `json:"unreceived"`
+	UnreceivedAcks uint64 `json:"unreceived_acks"`
+}
@@ -12,7 +12,6 @@ var (
 	DefaultCommissionMaxChangeRate = "0.01"
 	DefaultMinSelfDelegation       = "1"
 	DefaultHomeDir                 = ".xpla"
-	Memo                           string
 )
 
 type CreateValidatorMsg struct {
@@ -1,7 +1,9 @@
 package types
 
+// The wasm code is read from FilePath or given by WasmByteCode, and it is compressed by gzip if it is not compressed.
 type StoreMsg struct {
 	FilePath              string
+	WasmByteCode          []byte
 	InstantiatePermission string
 }
 
@@ -14,6 +16,21 @@ type InstantiateMsg struct {
 	NoAdmin string
 }
 
+type DeployWasmMsg struct {
+	FilePath              string
+	WasmByteCode          []byte
+	InstantiatePermission string
+	// Skip uploading if the code which has the same checksum is already stored.
+	SkipStoredCode bool
+	Amount         string
+	Label          string
+	InitMsg        string
+	Admin          string
+	NoAdmin        string
+	// The result of the previous deployment which failed in the middle. Steps which are done are skipped.
+	Resume *DeployWasmResult
+}
+
 type ExecuteMsg struct {
 	ContractAddress string
 	Amount          string
@@ -53,6 +70,13 @@ type CodeInfoMsg struct {
 	CodeId string
 }
 
+// The stored code is compared with the local wasm file of FilePath, or the hex string of the sha256 checksum.
+type VerifyCodeMsg struct {
+	CodeId   string
+	FilePath string
+	Checksum string
+}
+
 type ContractInfoMsg struct {
 	ContractAddress string
 }
@@ -61,6 +85,143 @@ type ContractStateAllMsg struct {
 	ContractAddress string
 }
 
+// Key is the hex string of the raw key of the contract storage.
+type ContractStateRawMsg struct {
+	ContractAddress string
+	Key             string
+}
+
+// Prefix is the hex string of the key prefix of the contract storage, e.g. the namespace of the cw-storage-plus map.
+type ContractStatePrefixMsg struct {
+	ContractAddress string
+	Prefix          string
+}
+
 type ContractHistoryMsg struct {
 	ContractAddress string
 }
+
+// Page request of cw queries which use start_after and limit.
+// If the limit is zero, the default limit of the contract is used.
+type CwPageRequest struct {
+	StartAfter string
+	Limit      uint32
+}
+
+type Cw20TransferMsg struct {
+	ContractAddress string
+	Recipient       string
+	Amount          string
+}
+
+// Msg is the JSON message which is passed to the receiver contract, and it is encoded to base64.
+type Cw20SendMsg struct {
+	ContractAddress string
+	Contract        string
+	Amount          string
+	Msg             string
+}
+
+type Cw20IncreaseAllowanceMsg struct {
+	ContractAddress string
+	Spender         string
+	Amount          string
+}
+
+type Cw20BalanceMsg struct {
+	ContractAddress string
+	Address         string
+}
+
+type Cw20TokenInfoMsg struct {
+	ContractAddress string
+}
+
+type Cw20AllAccountsMsg struct {
+	ContractAddress string
+	PageRequest     CwPageRequest
+}
+
+type Cw721TransferNftMsg struct {
+	ContractAddress string
+	Recipient       string
+	TokenId         string
+}
+
+// Msg is the JSON message which is passed to the receiver contract, and it is encoded to base64.
+type Cw721SendNftMsg struct {
+	ContractAddress string
+	Contract        string
+	TokenId         string
+	Msg             string
+}
+
+type Cw721ApproveMsg struct {
+	ContractAddress string
+	Spender         string
+	TokenId         string
+}
+
+type Cw721OwnerOfMsg struct {
+	ContractAddress string
+	TokenId         string
+	IncludeExpired  bool
+}
+
+type Cw721NftInfoMsg struct {
+	ContractAddress string
+	TokenId         string
+}
+
+type Cw721TokensMsg struct {
+	ContractAddress string
+	Owner           string
+	PageRequest     CwPageRequest
+}
+
+type Cw721AllTokensMsg struct {
+	ContractAddress string
+	PageRequest     CwPageRequest
+}
+
+// The stored code which is matched with the local code.
+type VerifyCodeResponse struct {
+	CodeId   uint64 `json:"code_id"`
+	Checksum string `json:"checksum"`
+}
+
+// Responses of cw20 and cw721 queries are same as responses of the contract.
+type Cw20BalanceResponse struct {
+	Balance string `json:"balance"`
+}
+
+type Cw20TokenInfoResponse struct {
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Decimals    uint8  `json:"decimals"`
+	TotalSupply string `json:"total_supply"`
+}
+
+type Cw20AllAccountsResponse struct {
+	Accounts []string `json:"accounts"`
+}
+
+type Cw721OwnerOfResponse struct {
+	Owner     string          `json:"owner"`
+	Approvals []Cw721Approval `json:"approvals"`
+}
+
+type Cw721Approval struct {
+	Spender string      `json:"spender"`
+	Expires interface{} `json:"expires"`
+}
+
+// The extension is different by the contract, e.g. the metadata of cw721-metadata-onchain.
+type Cw721NftInfoResponse struct {
+	TokenUri  string      `json:"token_uri"`
+	Extension interface{} `json:"extension"`
+}
+
+type Cw721TokensResponse struct {
+	Tokens []string `json:"tokens"`
+}
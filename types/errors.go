@@ -32,8 +32,44 @@ var (
 	ErrSdkClient           = new(18, "cosmos sdk client set error")
 	ErrAlreadyExist        = new(19, "already exist")
 	ErrCannotRead          = new(20, "cannot read")
+	ErrTimeout             = new(21, "timeout")
+	ErrChecksumMismatch    = new(22, "checksum mismatch")
 )
 
+// The error is returned when the broadcasted transaction is not committed until the context is done.
+// The transaction may still be committed later, so it can be queried again by the hash.
+type TxTimeoutError struct {
+	TxHash string
+	Err    error
+}
+
+func (e *TxTimeoutError) Error() string {
+	return ErrWrap(ErrTimeout, "tx", e.TxHash, "is not committed :", e.Err).Error()
+}
+
+func (e *TxTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// The error is returned when the transaction is not found by the hash, e.g. it is not committed yet.
+type TxNotFoundError struct {
+	TxHash string
+}
+
+func (e *TxNotFoundError) Error() string {
+	return ErrWrap(ErrNotFound, "tx", e.TxHash).Error()
+}
+
+// The error is returned when the status code of the HTTP response is not 200.
+type HttpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HttpStatusError) Error() string {
+	return ErrWrap(ErrHttpRequest, e.StatusCode, ":", e.Body).Error()
+}
+
 func new(errCode uint64, desc string) XGoError {
 	var xErr XGoError
 	xErr.errCode = errCode
@@ -8,4 +8,28 @@ import (
 type TxRes struct {
 	Response   *sdk.TxResponse
 	EvmReceipt *evmtypes.Receipt
+
+	// Results of contracts which are deployed by the transaction, so deployment steps can be chained without parsing logs.
+	// For wasm, they are read from events of the first stored code and the first instantiated contract,
+	// and events exist only if the transaction is committed, e.g. the broadcast mode "block" or BroadcastAndWait.
+	// For evm, the contract address is set only if the receipt of the transaction is successful.
+	CodeID          uint64
+	Checksum        string
+	ContractAddress string
+
+	// The evm contract address which is computed from the sender and the nonce. It is set regardless of the broadcast mode,
+	// but the contract exists only if the deploy transaction succeeds.
+	PredictedContractAddress string
+}
+
+// The result of deploying the wasm contract by storing the code and instantiating the contract.
+// If a step fails, the result has steps which are done, so the deployment is resumed by DeployWasmMsg.Resume.
+type DeployWasmResult struct {
+	CodeID   uint64
+	Checksum string
+	// The code is not uploaded because the code which has the same checksum is already stored.
+	CodeReused        bool
+	ContractAddress   string
+	StoreTxHash       string
+	InstantiateTxHash string
 }
@@ -0,0 +1,15 @@
+package types
+
+import (
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Event delivered by the tendermint websocket subscription.
+// NewBlock or Tx is set according to the type of the event, and Data has the original event data.
+type SubscriptionEvent struct {
+	Query    string
+	NewBlock *tmtypes.EventDataNewBlock
+	Tx       *tmtypes.EventDataTx
+	Data     tmtypes.TMEventData
+	Events   map[string][]string
+}
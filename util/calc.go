@@ -1,6 +1,11 @@
 package util
 
-import "math/big"
+import (
+	"math/big"
+	"strings"
+
+	"github.com/xpladev/xpla.go/types"
+)
 
 func MulUint64(val1 uint64, val2 uint64) uint64 {
 	return val1 * val2
@@ -10,3 +15,51 @@ func MulBigInt(val1 *big.Int, val2 *big.Int) *big.Int {
 	result := big.NewInt(0)
 	return result.Mul(val1, val2)
 }
+
+// Convert the token amount which can have fractional digits to the amount of the smallest unit by the decimals.
+// e.g. the amount "1.5" of the token which has 6 decimals is 1500000.
+func ParseTokenAmount(amount string, decimals uint8) (*big.Int, error) {
+	parts := strings.Split(strings.TrimSpace(amount), ".")
+	if len(parts) > 2 || parts[0] == "" && (len(parts) == 1 || parts[1] == "") {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "invalid token amount:", amount)
+	}
+
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = parts[1]
+	}
+	if len(fraction) > int(decimals) {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "the token amount", amount, "has more fractional digits than decimals", decimals)
+	}
+
+	value, ok := new(big.Int).SetString(parts[0]+fraction+strings.Repeat("0", int(decimals)-len(fraction)), 10)
+	if !ok || value.Sign() < 0 {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "invalid token amount:", amount)
+	}
+	return value, nil
+}
+
+// Format the amount of the smallest unit as the token amount by the decimals.
+// e.g. the amount 1500000 of the token which has 6 decimals is "1.5".
+func FormatTokenAmount(amount *big.Int, decimals uint8) string {
+	if decimals == 0 {
+		return amount.String()
+	}
+
+	sign := ""
+	digits := amount.String()
+	if amount.Sign() < 0 {
+		sign = "-"
+		digits = digits[1:]
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	integer := digits[:len(digits)-int(decimals)]
+	fraction := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + "." + fraction
+}
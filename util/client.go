@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"math/big"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	erpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/crypto/hd"
@@ -50,6 +54,23 @@ const (
 	DefaultEvmQueryGasLimit    = "200000" // Gas is not consumed when querying
 	DefaultSolidityValue       = "0"
 	DefaultEvmTxReceiptTimeout = 100
+	// Percentile of priority fees in the fee history which is used as the max priority fee
+	DefaultEvmFeeHistoryRewardPercentile = 50
+)
+
+const (
+	// Wait time for the transaction to be committed when the context has no deadline
+	DefaultTxWaitTimeout = 60 * time.Second
+	// First interval of querying the transaction, and it is doubled up to the max interval
+	DefaultTxWaitInterval    = 500 * time.Millisecond
+	DefaultTxWaitMaxInterval = 5 * time.Second
+)
+
+const (
+	// Wait time for the IBC packet to be acknowledged or timed out when the context has no deadline
+	DefaultIbcPacketWaitTimeout = 10 * time.Minute
+	// Interval of querying the status of the IBC packet
+	DefaultIbcPacketWaitInterval = 3 * time.Second
 )
 
 type EvmClient struct {
@@ -80,6 +101,45 @@ func NewEvmClient(evmRpcUrl string, ctx context.Context) (*EvmClient, error) {
 	return &EvmClient{ctx, ethClient, rpcClient}, nil
 }
 
+type feeHistoryResult struct {
+	BaseFee []*hexutil.Big   `json:"baseFeePerGas"`
+	Reward  [][]*hexutil.Big `json:"reward"`
+}
+
+// Suggest the max fee and the max priority fee of the EIP-1559 dynamic fee transaction.
+// If the max priority fee is nil, it is the priority fee of the latest block in eth_feeHistory, or it is
+// suggested by eth_maxPriorityFeePerGas when the fee history has no priority fee.
+// The max fee is twice the base fee of the next block plus the max priority fee, so the transaction is
+// valid even if the base fee is increased in several blocks.
+func (c *EvmClient) SuggestDynamicFee(gasTipCap *big.Int) (*big.Int, *big.Int, error) {
+	var feeHistory feeHistoryResult
+	err := c.RpcClient.CallContext(c.Ctx, &feeHistory, "eth_feeHistory", hexutil.Uint64(1), "latest", []float64{DefaultEvmFeeHistoryRewardPercentile})
+	if err != nil {
+		return nil, nil, types.ErrWrap(types.ErrEvmRpcRequest, err)
+	}
+
+	baseFee := big.NewInt(0)
+	if len(feeHistory.BaseFee) > 0 && feeHistory.BaseFee[len(feeHistory.BaseFee)-1] != nil {
+		baseFee = feeHistory.BaseFee[len(feeHistory.BaseFee)-1].ToInt()
+	}
+
+	if gasTipCap == nil && len(feeHistory.Reward) > 0 && len(feeHistory.Reward[0]) > 0 && feeHistory.Reward[0][0] != nil {
+		gasTipCap = feeHistory.Reward[0][0].ToInt()
+		if gasTipCap.Sign() == 0 {
+			gasTipCap = nil
+		}
+	}
+	if gasTipCap == nil {
+		gasTipCap, err = c.Client.SuggestGasTipCap(c.Ctx)
+		if err != nil {
+			return nil, nil, types.ErrWrap(types.ErrEvmRpcRequest, err)
+		}
+	}
+
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gasTipCap)
+	return gasFeeCap, gasTipCap, nil
+}
+
 // Provide cosmos sdk keyring
 func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error) {
 	switch {
@@ -102,7 +162,8 @@ func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error)
 			types.XplaToolDefaultName,
 			keyring.BackendFile,
 			keyringPath,
-			nil,
+			// The passphrase of the file keyring is read from the standard input.
+			os.Stdin,
 			hd.EthSecp256k1Option(),
 		)
 		if err != nil {
@@ -130,6 +191,21 @@ func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error)
 	}
 }
 
+// Get the directory of the keyring.
+// If the directory is empty, ~/.xpla is used for the file and test backends.
+func KeyringPath(backendType string, keyringPath string) (string, error) {
+	if keyringPath != "" || (backendType != BackendFile && backendType != BackendTest) {
+		return keyringPath, nil
+	}
+
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", types.ErrWrap(types.ErrParse, err)
+	}
+
+	return filepath.Join(userHomeDir, ".xpla"), nil
+}
+
 // Provide cosmos sdk tx factory.
 func NewFactory(clientCtx cmclient.Context) tx.Factory {
 	txFactory := tx.Factory{}.
@@ -169,7 +245,7 @@ func CtxHttpClient(methodType string, url string, reqBody []byte, ctx context.Co
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, types.ErrWrap(types.ErrHttpRequest, resp.StatusCode, ":", string(out))
+		return nil, &types.HttpStatusError{StatusCode: resp.StatusCode, Body: string(out)}
 	}
 
 	return out, nil
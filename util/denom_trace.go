@@ -0,0 +1,73 @@
+package util
+
+import (
+	"sync"
+)
+
+// Denom trace cache keeps traces of IBC denominations which are queried from the chain.
+// It is safe for concurrent use, so xpla clients which query the same chain can share one cache
+// and the trace table is loaded only once.
+type DenomTraceCache struct {
+	mtx    sync.RWMutex
+	traces map[string]DenomTrace
+	loaded bool
+}
+
+// The trace of the IBC denomination, i.e. the path of ports and channels and the base denomination.
+type DenomTrace struct {
+	Path      string
+	BaseDenom string
+}
+
+// Make new denom trace cache.
+func NewDenomTraceCache() *DenomTraceCache {
+	return &DenomTraceCache{
+		traces: make(map[string]DenomTrace),
+	}
+}
+
+// Get the trace of the IBC denomination, e.g. ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2.
+func (c *DenomTraceCache) DenomTrace(ibcDenom string) (DenomTrace, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	trace, ok := c.traces[ibcDenom]
+	return trace, ok
+}
+
+// Add the trace of the IBC denomination.
+func (c *DenomTraceCache) AddDenomTrace(ibcDenom string, trace DenomTrace) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.traces[ibcDenom] = trace
+}
+
+// Add all traces of the chain and mark the trace table as loaded.
+func (c *DenomTraceCache) LoadDenomTraces(traces map[string]DenomTrace) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for ibcDenom, trace := range traces {
+		c.traces[ibcDenom] = trace
+	}
+	c.loaded = true
+}
+
+// Check whether the trace table of the chain has been loaded.
+// Traces which are created after loading are added one by one.
+func (c *DenomTraceCache) Loaded() bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.loaded
+}
+
+// Clear all traces, then the trace table is loaded again at the next time.
+func (c *DenomTraceCache) Clear() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.traces = make(map[string]DenomTrace)
+	c.loaded = false
+}
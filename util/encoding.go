@@ -1,10 +1,19 @@
 package util
 
 import (
+	"sync"
+
 	"github.com/xpladev/xpla/app"
 	"github.com/xpladev/xpla/app/params"
 )
 
+// Registering codecs of the encoding config writes package-level codecs of dependencies,
+// so making encoding configs is serialized for xpla clients which are made concurrently.
+var encodingConfigMtx sync.Mutex
+
 func MakeEncodingConfig() params.EncodingConfig {
+	encodingConfigMtx.Lock()
+	defer encodingConfigMtx.Unlock()
+
 	return app.MakeTestEncodingConfig()
 }
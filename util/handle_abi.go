@@ -2,8 +2,15 @@ package util
 
 import (
 	"os"
+	"sort"
+	"strings"
 
+	"github.com/xpladev/xpla.go/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func AbiParsing(jsonFilePath string) (string, error) {
@@ -78,3 +85,162 @@ func GetBindMetaData(abi, bytecode string) *bind.MetaData {
 		Bin: bytecode,
 	}
 }
+
+// Decode evm logs by using the ABI of the contract.
+// Logs which cannot be decoded are not dropped, and the reason is recorded in the decode error of each log.
+func DecodeEvmLogs(abiStr string, logs []ethtypes.Log) ([]types.EvmEventLog, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(abiStr))
+	if err != nil {
+		return nil, err
+	}
+
+	eventLogs := make([]types.EvmEventLog, len(logs))
+	for i, log := range logs {
+		eventLogs[i] = DecodeEvmLog(contractAbi, log)
+	}
+	return eventLogs, nil
+}
+
+// Decode an evm log by using the parsed ABI.
+// The event is found by the first topic which is the event signature. Anonymous events do not have the
+// signature in topics, so the log is decoded by the anonymous event only if it is the only one which can decode
+// the log. Otherwise the event is ambiguous, and the raw log is returned with the decode error.
+func DecodeEvmLog(contractAbi abi.ABI, log ethtypes.Log) types.EvmEventLog {
+	eventLog := types.EvmEventLog{Log: log}
+
+	if len(log.Topics) > 0 {
+		if event, err := contractAbi.EventByID(log.Topics[0]); err == nil {
+			if err := unpackEvmEvent(&eventLog, *event, log.Topics[1:], log.Data); err != nil {
+				eventLog.DecodeErr = err.Error()
+			}
+			return eventLog
+		}
+	}
+
+	// Sort event names in order to decode the log deterministically.
+	var names []string
+	for name, event := range contractAbi.Events {
+		if event.Anonymous {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var candidates []types.EvmEventLog
+	for _, name := range names {
+		candidate := types.EvmEventLog{Log: log}
+		if err := unpackEvmEvent(&candidate, contractAbi.Events[name], log.Topics, log.Data); err == nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	if len(candidates) > 1 {
+		matched := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			matched[i] = candidate.Event
+		}
+		eventLog.DecodeErr = "ambiguous anonymous events " + strings.Join(matched, ", ")
+		return eventLog
+	}
+
+	eventLog.DecodeErr = "unknown event"
+	if len(log.Topics) > 0 {
+		eventLog.DecodeErr = "unknown event of the topic " + log.Topics[0].Hex()
+	}
+	return eventLog
+}
+
+// Unpack indexed arguments from topics and non-indexed arguments from data, and set them to the event log by names.
+// Indexed arguments of dynamic types (string, bytes, array, slice and tuple) are the keccak256 hash of the value.
+func unpackEvmEvent(eventLog *types.EvmEventLog, event abi.Event, topics []common.Hash, data []byte) error {
+	values, err := unpackEvmEventValues(event, topics, data)
+	if err != nil {
+		return err
+	}
+
+	indexed := make(map[string]interface{})
+	nonIndexed := make(map[string]interface{})
+	for i, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed[arg.Name] = values[i]
+		} else {
+			nonIndexed[arg.Name] = values[i]
+		}
+	}
+
+	eventLog.Event = event.Name
+	eventLog.Signature = event.Sig
+	eventLog.Anonymous = event.Anonymous
+	eventLog.Indexed = indexed
+	eventLog.NonIndexed = nonIndexed
+	eventLog.DecodeErr = ""
+	return nil
+}
+
+// Unpack the log of the event and return values of all arguments in the order of event inputs.
+// Indexed arguments of dynamic types (string, bytes, array, slice and tuple) are the keccak256 hash of the value,
+// so the topic is returned as common.Hash.
+func UnpackEvmEventValues(contractAbi abi.ABI, eventName string, log ethtypes.Log) ([]interface{}, error) {
+	event, ok := contractAbi.Events[eventName]
+	if !ok {
+		return nil, types.ErrWrap(types.ErrNotFound, "event", eventName)
+	}
+
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 || topics[0] != event.ID {
+			return nil, types.ErrWrap(types.ErrParse, "the log is not the event", eventName)
+		}
+		topics = topics[1:]
+	}
+
+	return unpackEvmEventValues(event, topics, log.Data)
+}
+
+// Topics do not include the event signature.
+func unpackEvmEventValues(event abi.Event, topics []common.Hash, data []byte) ([]interface{}, error) {
+	indexedCount := 0
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexedCount++
+		}
+	}
+	if indexedCount != len(topics) {
+		return nil, types.ErrWrap(types.ErrParse, "the number of topics", len(topics), "does not match indexed arguments of the event", event.Name)
+	}
+
+	var nonIndexed []interface{}
+	if len(event.Inputs.NonIndexed()) > 0 {
+		var err error
+		nonIndexed, err = event.Inputs.NonIndexed().Unpack(data)
+		if err != nil {
+			return nil, types.ErrWrap(types.ErrParse, err)
+		}
+	}
+
+	values := make([]interface{}, 0, len(event.Inputs))
+	for _, arg := range event.Inputs {
+		if !arg.Indexed {
+			values = append(values, nonIndexed[0])
+			nonIndexed = nonIndexed[1:]
+			continue
+		}
+
+		topic := topics[0]
+		topics = topics[1:]
+
+		switch arg.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			values = append(values, topic)
+		default:
+			value, err := abi.Arguments{{Type: arg.Type}}.Unpack(topic.Bytes())
+			if err != nil {
+				return nil, types.ErrWrap(types.ErrParse, err)
+			}
+			values = append(values, value[0])
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,138 @@
+package util
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Retry count of broadcasting when the sequence of the transaction is mismatched
+	DefaultSequenceMismatchRetryCount = 10
+	// Wait time before broadcasting again when previous transactions are not delivered yet
+	DefaultSequenceMismatchRetryInterval = 100 * time.Millisecond
+)
+
+var expectedSequenceRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+// Account sequence manager tracks the account number and the next sequence of accounts locally.
+// It is safe for concurrent use, so xpla clients which sign transactions with the same account
+// in several goroutines can share one sequence manager.
+type SequenceManager struct {
+	mtx      sync.Mutex
+	accounts map[string]*accountSequence
+}
+
+type accountSequence struct {
+	accountNumber uint64
+	sequence      uint64
+	synced        bool
+}
+
+// Make new sequence manager.
+func NewSequenceManager() *SequenceManager {
+	return &SequenceManager{
+		accounts: make(map[string]*accountSequence),
+	}
+}
+
+// Get the account number and the sequence to sign the next transaction of the address.
+// The sequence is reserved and increased locally, so concurrent callers get different sequences.
+// If the account has not been synced yet, the account number and the sequence are loaded by the loader.
+func (s *SequenceManager) NextSequence(address string, loader func() (uint64, uint64, error)) (uint64, uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	account, ok := s.accounts[address]
+	if !ok {
+		account = &accountSequence{}
+		s.accounts[address] = account
+	}
+
+	if !account.synced {
+		accNum, seq, err := loader()
+		if err != nil {
+			return 0, 0, err
+		}
+		account.accountNumber = accNum
+		account.sequence = seq
+		account.synced = true
+	}
+
+	seq := account.sequence
+	account.sequence++
+
+	return account.accountNumber, seq, nil
+}
+
+// Release the reserved sequence when the transaction which has the sequence is not delivered to the chain.
+// If the sequence is the last reserved one, it is returned to the sequence manager to be used again.
+// If not, the account is resynced at the next time because following sequences have already been reserved.
+func (s *SequenceManager) ReleaseSequence(address string, sequence uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	account, ok := s.accounts[address]
+	if !ok || !account.synced {
+		return
+	}
+
+	if account.sequence == sequence+1 {
+		account.sequence = sequence
+	} else {
+		account.synced = false
+	}
+}
+
+// Resync the sequence of the address.
+// If the expected sequence is known by the raw log of the sequence mismatch error, the next sequence is
+// moved forward to the expected sequence. Sequences which are already reserved are not handed out again.
+// If not, the sequence is loaded again when the next sequence is requested.
+func (s *SequenceManager) Resync(address string, rawLog string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	account, ok := s.accounts[address]
+	if !ok {
+		return
+	}
+
+	expected, ok := ExpectedSequence(rawLog)
+	if account.synced && ok {
+		if account.sequence < expected {
+			account.sequence = expected
+		}
+		return
+	}
+
+	account.synced = false
+}
+
+// Get the expected sequence from the raw log of the account sequence mismatch error.
+func ExpectedSequence(rawLog string) (uint64, bool) {
+	match := expectedSequenceRegexp.FindStringSubmatch(rawLog)
+	if len(match) != 2 {
+		return 0, false
+	}
+
+	expected, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return expected, true
+}
+
+// Get the next sequence of the address which is tracked locally.
+// If the account is not synced, the returned boolean is false.
+func (s *SequenceManager) Sequence(address string) (uint64, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	account, ok := s.accounts[address]
+	if !ok || !account.synced {
+		return 0, false
+	}
+
+	return account.sequence, true
+}
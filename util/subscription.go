@@ -0,0 +1,205 @@
+package util
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/xpladev/xpla.go/types"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// Wait time before reconnecting to the node, and it is doubled up to the max interval
+	DefaultSubscriptionReconnectInterval    = 1 * time.Second
+	DefaultSubscriptionReconnectMaxInterval = 30 * time.Second
+	// Ping period and read wait of the websocket in order to detect the dead connection
+	DefaultSubscriptionPingPeriod = 10 * time.Second
+	DefaultSubscriptionReadWait   = 30 * time.Second
+	// Buffer size of the event channel
+	DefaultSubscriptionBufferSize = 100
+)
+
+const tmWebsocketEndpoint = "/websocket"
+
+// Subscription delivers events of the query which is subscribed by the tendermint websocket.
+// When the connection is lost, it reconnects to the node and subscribes the query again until the subscription is closed.
+// Events which are emitted while reconnecting are not delivered, so query them by the height if necessary.
+type Subscription struct {
+	rpcURL string
+	query  string
+	events chan types.SubscriptionEvent
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Make new subscription of the query by using the tendermint RPC URL.
+// The subscription is finished when the context is done or the subscription is closed.
+func NewSubscription(ctx context.Context, rpcURL string, query string) (*Subscription, error) {
+	ws, err := dialSubscription(ctx, rpcURL, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Subscription{
+		rpcURL: rpcURL,
+		query:  query,
+		events: make(chan types.SubscriptionEvent, DefaultSubscriptionBufferSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go s.run(ctx, ws)
+
+	return s, nil
+}
+
+// Get the subscribed query.
+func (s *Subscription) Query() string {
+	return s.query
+}
+
+// Get the channel of events. The channel is closed when the subscription is finished.
+func (s *Subscription) Events() <-chan types.SubscriptionEvent {
+	return s.events
+}
+
+// Close the subscription and wait until the websocket connection is closed.
+func (s *Subscription) Close() {
+	s.cancel()
+	<-s.done
+}
+
+// Receive events, and reconnect with backoff when the connection is lost.
+func (s *Subscription) run(ctx context.Context, ws *jsonrpcclient.WSClient) {
+	defer close(s.done)
+	defer close(s.events)
+
+	for {
+		s.receive(ctx, ws)
+		ws.Stop()
+
+		interval := DefaultSubscriptionReconnectInterval
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			var err error
+			ws, err = dialSubscription(ctx, s.rpcURL, s.query)
+			if err == nil {
+				break
+			}
+
+			interval = interval * 2
+			if interval > DefaultSubscriptionReconnectMaxInterval {
+				interval = DefaultSubscriptionReconnectMaxInterval
+			}
+		}
+	}
+}
+
+// Deliver events of the websocket until the context is done or the connection is lost.
+func (s *Subscription) receive(ctx context.Context, ws *jsonrpcclient.WSClient) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case resp, ok := <-ws.ResponsesCh:
+			if !ok {
+				return
+			}
+			if resp.Error != nil {
+				// The query is subscribed again after the websocket client reconnects by itself.
+				if strings.Contains(resp.Error.Error(), "already subscribed") {
+					continue
+				}
+				return
+			}
+
+			var result coretypes.ResultEvent
+			if err := tmjson.Unmarshal(resp.Result, &result); err != nil || result.Query == "" {
+				// The response of the subscribe request does not have any event.
+				continue
+			}
+
+			select {
+			case s.events <- toSubscriptionEvent(result):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// Connect to the websocket of the node and subscribe the query.
+// It waits the response of the subscribe request, so the invalid query is returned as the error.
+func dialSubscription(ctx context.Context, rpcURL string, query string) (*jsonrpcclient.WSClient, error) {
+	var ws *jsonrpcclient.WSClient
+	ws, err := jsonrpcclient.NewWS(
+		rpcURL,
+		tmWebsocketEndpoint,
+		// Reconnecting for a long time is handled by the subscription, not the websocket client.
+		jsonrpcclient.MaxReconnectAttempts(0),
+		jsonrpcclient.PingPeriod(DefaultSubscriptionPingPeriod),
+		jsonrpcclient.ReadWait(DefaultSubscriptionReadWait),
+		jsonrpcclient.OnReconnect(func() {
+			ws.Subscribe(context.Background(), query)
+		}),
+	)
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrRpcRequest, err)
+	}
+
+	if err := ws.Start(); err != nil {
+		return nil, types.ErrWrap(types.ErrRpcRequest, err)
+	}
+
+	if err := ws.Subscribe(ctx, query); err != nil {
+		ws.Stop()
+		return nil, types.ErrWrap(types.ErrRpcRequest, err)
+	}
+
+	select {
+	case resp, ok := <-ws.ResponsesCh:
+		if !ok {
+			// The connection is lost, and the websocket client is stopped to close its routines.
+			ws.Stop()
+			return nil, types.ErrWrap(types.ErrRpcRequest, "websocket is closed")
+		}
+		if resp.Error != nil {
+			ws.Stop()
+			return nil, types.ErrWrap(types.ErrRpcRequest, resp.Error)
+		}
+	case <-ctx.Done():
+		ws.Stop()
+		return nil, types.ErrWrap(types.ErrRpcRequest, ctx.Err())
+	}
+
+	return ws, nil
+}
+
+// Convert the result event of the websocket to the event of the subscription.
+func toSubscriptionEvent(result coretypes.ResultEvent) types.SubscriptionEvent {
+	event := types.SubscriptionEvent{
+		Query:  result.Query,
+		Data:   result.Data,
+		Events: result.Events,
+	}
+
+	switch data := result.Data.(type) {
+	case tmtypes.EventDataNewBlock:
+		event.NewBlock = &data
+	case tmtypes.EventDataTx:
+		event.Tx = &data
+	}
+
+	return event
+}
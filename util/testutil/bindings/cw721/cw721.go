@@ -0,0 +1,437 @@
+// Code generated by xplabind. DO NOT EDIT.
+
+package cw721
+
+import (
+	"encoding/json"
+
+	"github.com/xpladev/xpla.go/provider"
+	"github.com/xpladev/xpla.go/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// Cw721 is the binding of cw721-metadata-onchain 0.15.0.
+// Execute methods return the xpla client which has the message, so the transaction is created by CreateAndSignTx.
+type Cw721 struct {
+	xplac           provider.XplaClient
+	contractAddress string
+	amount          string
+}
+
+// NewCw721 binds the contract which is deployed at the contract address.
+func NewCw721(xplac provider.XplaClient, contractAddress string) *Cw721 {
+	return &Cw721{xplac: xplac, contractAddress: contractAddress, amount: "0"}
+}
+
+// InstantiateCw721 makes the instantiate message of the contract.
+// InitMsg of the instantiate message is replaced by the typed message.
+func InstantiateCw721(xplac provider.XplaClient, instantiateMsg types.InstantiateMsg, msg InstantiateMsg) provider.XplaClient {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return xplac.WithErr(types.ErrWrap(types.ErrFailedToMarshal, err))
+	}
+	instantiateMsg.InitMsg = string(bz)
+	return xplac.InstantiateContract(instantiateMsg)
+}
+
+// ContractAddress returns the address of the bound contract.
+func (c *Cw721) ContractAddress() string {
+	return c.contractAddress
+}
+
+// WithAmount returns the copy of the binding which sends the amount with execute messages.
+func (c *Cw721) WithAmount(amount string) *Cw721 {
+	copied := *c
+	copied.amount = amount
+	return &copied
+}
+
+func (c *Cw721) execute(msg interface{}) provider.XplaClient {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return c.xplac.WithErr(types.ErrWrap(types.ErrFailedToMarshal, err))
+	}
+	return c.xplac.ExecuteContract(types.ExecuteMsg{ContractAddress: c.contractAddress, Amount: c.amount, ExecMsg: string(bz)})
+}
+
+func (c *Cw721) query(msg interface{}, res interface{}) error {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return types.ErrWrap(types.ErrFailedToMarshal, err)
+	}
+	protoRes, err := c.xplac.QueryContract(types.QueryMsg{ContractAddress: c.contractAddress, QueryMsg: string(bz)}).QueryProto()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(protoRes.(*wasmtypes.QuerySmartContractStateResponse).Data, res); err != nil {
+		return types.ErrWrap(types.ErrFailedToUnmarshal, err)
+	}
+	return nil
+}
+
+// TransferNft executes "transfer_nft" of the contract.
+// Transfer is a base message to move a token to another account without triggering actions
+func (c *Cw721) TransferNft(msg TransferNftMsg) provider.XplaClient {
+	return c.execute(map[string]TransferNftMsg{"transfer_nft": msg})
+}
+
+// SendNft executes "send_nft" of the contract.
+// Send is a base message to transfer a token to a contract and trigger an action on the receiving contract.
+func (c *Cw721) SendNft(msg SendNftMsg) provider.XplaClient {
+	return c.execute(map[string]SendNftMsg{"send_nft": msg})
+}
+
+// Approve executes "approve" of the contract.
+// Allows operator to transfer / send the token from the owner's account. If expiration is set, then this allowance has a time/height limit
+func (c *Cw721) Approve(msg ApproveMsg) provider.XplaClient {
+	return c.execute(map[string]ApproveMsg{"approve": msg})
+}
+
+// Revoke executes "revoke" of the contract.
+// Remove previously granted Approval
+func (c *Cw721) Revoke(msg RevokeMsg) provider.XplaClient {
+	return c.execute(map[string]RevokeMsg{"revoke": msg})
+}
+
+// ApproveAll executes "approve_all" of the contract.
+// Allows operator to transfer / send any token from the owner's account. If expiration is set, then this allowance has a time/height limit
+func (c *Cw721) ApproveAll(msg ApproveAllMsg) provider.XplaClient {
+	return c.execute(map[string]ApproveAllMsg{"approve_all": msg})
+}
+
+// RevokeAll executes "revoke_all" of the contract.
+// Remove previously granted ApproveAll permission
+func (c *Cw721) RevokeAll(msg RevokeAllMsg) provider.XplaClient {
+	return c.execute(map[string]RevokeAllMsg{"revoke_all": msg})
+}
+
+// Mint executes "mint" of the contract.
+// Mint a new NFT, can only be called by the contract minter
+func (c *Cw721) Mint(msg MintMsgForNullableMetadata) provider.XplaClient {
+	return c.execute(map[string]MintMsgForNullableMetadata{"mint": msg})
+}
+
+// Burn executes "burn" of the contract.
+// Burn an NFT the sender has access to
+func (c *Cw721) Burn(msg BurnMsg) provider.XplaClient {
+	return c.execute(map[string]BurnMsg{"burn": msg})
+}
+
+// Extension executes "extension" of the contract.
+// Extension msg
+func (c *Cw721) Extension(msg ExtensionMsg) provider.XplaClient {
+	return c.execute(map[string]ExtensionMsg{"extension": msg})
+}
+
+// OwnerOf queries "owner_of" of the contract.
+// Return the owner of the given token, error if token does not exist Return type: OwnerOfResponse
+func (c *Cw721) OwnerOf(msg OwnerOfQuery) (OwnerOfResponse, error) {
+	var res OwnerOfResponse
+	err := c.query(map[string]OwnerOfQuery{"owner_of": msg}, &res)
+	return res, err
+}
+
+// Approval queries "approval" of the contract.
+// Return operator that can access all of the owner's tokens. Return type: `ApprovalResponse`
+func (c *Cw721) Approval(msg ApprovalQuery) (ApprovalResponse, error) {
+	var res ApprovalResponse
+	err := c.query(map[string]ApprovalQuery{"approval": msg}, &res)
+	return res, err
+}
+
+// Approvals queries "approvals" of the contract.
+// Return approvals that a token has Return type: `ApprovalsResponse`
+func (c *Cw721) Approvals(msg ApprovalsQuery) (ApprovalsResponse, error) {
+	var res ApprovalsResponse
+	err := c.query(map[string]ApprovalsQuery{"approvals": msg}, &res)
+	return res, err
+}
+
+// AllOperators queries "all_operators" of the contract.
+// List all operators that can access all of the owner's tokens Return type: `OperatorsResponse`
+func (c *Cw721) AllOperators(msg AllOperatorsQuery) (OperatorsResponse, error) {
+	var res OperatorsResponse
+	err := c.query(map[string]AllOperatorsQuery{"all_operators": msg}, &res)
+	return res, err
+}
+
+// NumTokens queries "num_tokens" of the contract.
+// Total number of tokens issued
+func (c *Cw721) NumTokens() (NumTokensResponse, error) {
+	var res NumTokensResponse
+	err := c.query(map[string]struct{}{"num_tokens": {}}, &res)
+	return res, err
+}
+
+// ContractInfo queries "contract_info" of the contract.
+// With MetaData Extension. Returns top-level metadata about the contract: `ContractInfoResponse`
+func (c *Cw721) ContractInfo() (ContractInfoResponse, error) {
+	var res ContractInfoResponse
+	err := c.query(map[string]struct{}{"contract_info": {}}, &res)
+	return res, err
+}
+
+// NftInfo queries "nft_info" of the contract.
+// With MetaData Extension. Returns metadata about one particular token, based on *ERC721 Metadata JSON Schema* but directly from the contract: `NftInfoResponse`
+func (c *Cw721) NftInfo(msg NftInfoQuery) (NftInfoResponseForNullableMetadata, error) {
+	var res NftInfoResponseForNullableMetadata
+	err := c.query(map[string]NftInfoQuery{"nft_info": msg}, &res)
+	return res, err
+}
+
+// AllNftInfo queries "all_nft_info" of the contract.
+// With MetaData Extension. Returns the result of both `NftInfo` and `OwnerOf` as one query as an optimization for clients: `AllNftInfo`
+func (c *Cw721) AllNftInfo(msg AllNftInfoQuery) (AllNftInfoResponseForNullableMetadata, error) {
+	var res AllNftInfoResponseForNullableMetadata
+	err := c.query(map[string]AllNftInfoQuery{"all_nft_info": msg}, &res)
+	return res, err
+}
+
+// Tokens queries "tokens" of the contract.
+// With Enumerable extension. Returns all tokens owned by the given address, [] if unset. Return type: TokensResponse.
+func (c *Cw721) Tokens(msg TokensQuery) (TokensResponse, error) {
+	var res TokensResponse
+	err := c.query(map[string]TokensQuery{"tokens": msg}, &res)
+	return res, err
+}
+
+// AllTokens queries "all_tokens" of the contract.
+// With Enumerable extension. Requires pagination. Lists all token_ids controlled by the contract. Return type: TokensResponse.
+func (c *Cw721) AllTokens(msg AllTokensQuery) (TokensResponse, error) {
+	var res TokensResponse
+	err := c.query(map[string]AllTokensQuery{"all_tokens": msg}, &res)
+	return res, err
+}
+
+// Minter queries "minter" of the contract.
+// Return the minter
+func (c *Cw721) Minter() (MinterResponse, error) {
+	var res MinterResponse
+	err := c.query(map[string]struct{}{"minter": {}}, &res)
+	return res, err
+}
+
+type InstantiateMsg struct {
+	// The minter is the only one who can create new NFTs. This is designed for a base NFT that is controlled by an external program or contract. You will likely replace this with custom logic in custom NFTs
+	Minter string `json:"minter"`
+	// Name of the NFT contract
+	Name string `json:"name"`
+	// Symbol of the NFT contract
+	Symbol string `json:"symbol"`
+}
+
+type TransferNftMsg struct {
+	Recipient string `json:"recipient"`
+	TokenId   string `json:"token_id"`
+}
+
+// Binary is a wrapper around Vec<u8> to add base64 de/serialization with serde. It also adds some helper methods to help encode inline.
+//
+// This is only needed as serde-json-{core,wasm} has a horrible encoding for Vec<u8>. See also <https://github.com/CosmWasm/cosmwasm/blob/main/docs/MESSAGE_TYPES.md>.
+type Binary string
+
+type SendNftMsg struct {
+	Contract string `json:"contract"`
+	Msg      Binary `json:"msg"`
+	TokenId  string `json:"token_id"`
+}
+
+// A thin wrapper around u64 that is using strings for JSON encoding/decoding, such that the full u64 range can be used for clients that convert JSON numbers to floats, like JavaScript and jq.
+//
+// # Examples
+//
+// Use `from` to create instances of this and `u64` to get the value out:
+//
+// ``` # use cosmwasm_std::Uint64; let a = Uint64::from(42u64); assert_eq!(a.u64(), 42);
+//
+// let b = Uint64::from(70u64); assert_eq!(b.u64(), 70); ```
+type Uint64 string
+
+// A point in time in nanosecond precision.
+//
+// This type can represent times from 1970-01-01T00:00:00Z to 2554-07-21T23:34:33Z.
+//
+// ## Examples
+//
+// ``` # use cosmwasm_std::Timestamp; let ts = Timestamp::from_nanos(1_000_000_202); assert_eq!(ts.nanos(), 1_000_000_202); assert_eq!(ts.seconds(), 1); assert_eq!(ts.subsec_nanos(), 202);
+//
+// let ts = ts.plus_seconds(2); assert_eq!(ts.nanos(), 2_000_000_202); assert_eq!(ts.seconds(), 3); assert_eq!(ts.subsec_nanos(), 202); ```
+type Timestamp Uint64
+
+// Expiration represents a point in time when some event happens. It can compare with a BlockInfo and will return is_expired() == true once the condition is hit (and for every block in the future)
+type Expiration struct {
+	// AtHeight will expire when `env.block.height` >= height
+	AtHeight *uint64 `json:"at_height,omitempty"`
+	// AtTime will expire when `env.block.time` >= time
+	AtTime *Timestamp `json:"at_time,omitempty"`
+	// Never will never expire. Used to express the empty variant
+	Never *struct{} `json:"never,omitempty"`
+}
+
+type ApproveMsg struct {
+	Expires *Expiration `json:"expires,omitempty"`
+	Spender string      `json:"spender"`
+	TokenId string      `json:"token_id"`
+}
+
+type RevokeMsg struct {
+	Spender string `json:"spender"`
+	TokenId string `json:"token_id"`
+}
+
+type ApproveAllMsg struct {
+	Expires  *Expiration `json:"expires,omitempty"`
+	Operator string      `json:"operator"`
+}
+
+type RevokeAllMsg struct {
+	Operator string `json:"operator"`
+}
+
+type Trait struct {
+	DisplayType *string `json:"display_type,omitempty"`
+	TraitType   string  `json:"trait_type"`
+	Value       string  `json:"value"`
+}
+
+type Metadata struct {
+	AnimationUrl    *string `json:"animation_url,omitempty"`
+	Attributes      []Trait `json:"attributes,omitempty"`
+	BackgroundColor *string `json:"background_color,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	ExternalUrl     *string `json:"external_url,omitempty"`
+	Image           *string `json:"image,omitempty"`
+	ImageData       *string `json:"image_data,omitempty"`
+	Name            *string `json:"name,omitempty"`
+	YoutubeUrl      *string `json:"youtube_url,omitempty"`
+}
+
+type MintMsgForNullableMetadata struct {
+	// Any custom extension used by this contract
+	Extension *Metadata `json:"extension,omitempty"`
+	// The owner of the newly minter NFT
+	Owner string `json:"owner"`
+	// Unique ID of the NFT
+	TokenId string `json:"token_id"`
+	// Universal resource identifier for this NFT Should point to a JSON file that conforms to the ERC721 Metadata JSON Schema
+	TokenUri *string `json:"token_uri,omitempty"`
+}
+
+type BurnMsg struct {
+	TokenId string `json:"token_id"`
+}
+
+// An empty struct that serves as a placeholder in different places, such as contracts that don't set a custom message.
+//
+// It is designed to be expressable in correct JSON and JSON Schema but contains no meaningful data. Previously we used enums without cases, but those cannot represented as valid JSON Schema (https://github.com/CosmWasm/cosmwasm/issues/451)
+type Empty struct{}
+
+type ExtensionMsg struct {
+	Msg Empty `json:"msg"`
+}
+
+type OwnerOfQuery struct {
+	// unset or false will filter out expired approvals, you must set to true to see them
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+	TokenId        string `json:"token_id"`
+}
+
+type Approval struct {
+	// When the Approval expires (maybe Expiration::never)
+	Expires Expiration `json:"expires"`
+	// Account that can transfer/send the token
+	Spender string `json:"spender"`
+}
+
+type OwnerOfResponse struct {
+	// If set this address is approved to transfer/send the token as well
+	Approvals []Approval `json:"approvals"`
+	// Owner of the token
+	Owner string `json:"owner"`
+}
+
+type ApprovalQuery struct {
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+	Spender        string `json:"spender"`
+	TokenId        string `json:"token_id"`
+}
+
+type ApprovalResponse struct {
+	Approval Approval `json:"approval"`
+}
+
+type ApprovalsQuery struct {
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+	TokenId        string `json:"token_id"`
+}
+
+type ApprovalsResponse struct {
+	Approvals []Approval `json:"approvals"`
+}
+
+type AllOperatorsQuery struct {
+	// unset or false will filter out expired items, you must set to true to see them
+	IncludeExpired *bool   `json:"include_expired,omitempty"`
+	Limit          *uint32 `json:"limit,omitempty"`
+	Owner          string  `json:"owner"`
+	StartAfter     *string `json:"start_after,omitempty"`
+}
+
+type OperatorsResponse struct {
+	Operators []Approval `json:"operators"`
+}
+
+type NumTokensResponse struct {
+	Count uint64 `json:"count"`
+}
+
+type ContractInfoResponse struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type NftInfoQuery struct {
+	TokenId string `json:"token_id"`
+}
+
+type NftInfoResponseForNullableMetadata struct {
+	// You can add any custom metadata here when you extend cw721-base
+	Extension *Metadata `json:"extension,omitempty"`
+	// Universal resource identifier for this NFT Should point to a JSON file that conforms to the ERC721 Metadata JSON Schema
+	TokenUri *string `json:"token_uri,omitempty"`
+}
+
+type AllNftInfoQuery struct {
+	// unset or false will filter out expired approvals, you must set to true to see them
+	IncludeExpired *bool  `json:"include_expired,omitempty"`
+	TokenId        string `json:"token_id"`
+}
+
+type AllNftInfoResponseForNullableMetadata struct {
+	// Who can transfer the token
+	Access OwnerOfResponse `json:"access"`
+	// Data on the token itself,
+	Info NftInfoResponseForNullableMetadata `json:"info"`
+}
+
+type TokensQuery struct {
+	Limit      *uint32 `json:"limit,omitempty"`
+	Owner      string  `json:"owner"`
+	StartAfter *string `json:"start_after,omitempty"`
+}
+
+type TokensResponse struct {
+	// Contains all token_ids in lexicographical ordering If there are more than `limit`, use `start_from` in future queries to achieve pagination.
+	Tokens []string `json:"tokens"`
+}
+
+type AllTokensQuery struct {
+	Limit      *uint32 `json:"limit,omitempty"`
+	StartAfter *string `json:"start_after,omitempty"`
+}
+
+// Shows who can mint these tokens
+type MinterResponse struct {
+	Minter string `json:"minter"`
+}
@@ -0,0 +1,4 @@
+// Package cw721 is the binding of the test contract cw721_metadata_onchain.wasm which is generated by xplabind.
+package cw721
+
+//go:generate go run github.com/xpladev/xpla.go/cmd/xplabind wasm -schema ../../test_files/cw721_metadata_onchain_schema -pkg cw721 -type Cw721 -out cw721.go
@@ -0,0 +1,212 @@
+// Code generated by xplabind. DO NOT EDIT.
+
+package erc20
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/xpladev/xpla.go/core/evm"
+	"github.com/xpladev/xpla.go/provider"
+	"github.com/xpladev/xpla.go/types"
+	"github.com/xpladev/xpla.go/util"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+	_ = ethtypes.BloomLookup
+	_ = evm.CallSolidityContractOutputs
+	_ = util.UnpackEvmEventValues
+)
+
+// Erc20ABI is the ABI of the contract.
+const Erc20ABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"initialAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"i\",\"type\":\"uint256\"}],\"name\":\"TestLog\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"n\",\"type\":\"uint256\"}],\"name\":\"benchmarkLogs\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseApproval\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseApproval\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// Erc20Bin is the bytecode of the contract which is used to deploy.
+const Erc20Bin = "608060405234801561001057600080fd5b506040516114543803806114548339818101604052604081101561003357600080fd5b810190808051906020019092919080519060200190929190505050806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508060028190555050506113ab806100a96000396000f3fe608060405234801561001057600080fd5b506004361061009e5760003560e01c80636618846311610066578063661884631461022957806370a082311461028f578063a9059cbb146102e7578063d73dd6231461034d578063dd62ed3e146103b35761009e565b8063095ea7b3146100a357806318160ddd1461010957806323b872dd1461012757806340c10f19146101ad57806357807d7f146101fb575b600080fd5b6100ef600480360360408110156100b957600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff1690602001909291908035906020019092919050505061042b565b604051808215151515815260200191505060405180910390f35b61011161051d565b6040518082815260200191505060405180910390f35b6101936004803603606081101561013d57600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff169060200190929190803573ffffffffffffffffffffffffffffffffffffffff16906020019092919080359060200190929190505050610527565b604051808215151515815260200191505060405180910390f35b6101f9600480360360408110156101c357600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff169060200190929190803590602001909291905050506108dc565b005b6102276004803603602081101561021157600080fd5b810190808035906020019092919050505061098e565b005b6102756004803603604081101561023f57600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff16906020019092919080359060200190929190505050610a18565b604051808215151515815260200191505060405180910390f35b6102d1600480360360208110156102a557600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff169060200190929190505050610ca8565b6040518082815260200191505060405180910390f35b610333600480360360408110156102fd57600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff16906020019092919080359060200190929190505050610cf0565b604051808215151515815260200191505060405180910390f35b6103996004803603604081101561036357600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff16906020019092919080359060200190929190505050610f0c565b604051808215151515815260200191505060405180910390f35b610415600480360360408110156103c957600080fd5b81019080803573ffffffffffffffffffffffffffffffffffffffff169060200190929190803573ffffffffffffffffffffffffffffffffffffffff169060200190929190505050611108565b6040518082815260200191505060405180910390f35b600081600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925846040518082815260200191505060405180910390a36001905092915050565b6000600254905090565b60008060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205482111561057457600080fd5b600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020548211156105fd57600080fd5b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff16141561063757600080fd5b610688826000808773ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461118f90919063ffffffff16565b6000808673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000208190555061071b826000808673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461128390919063ffffffff16565b6000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055506107ec82600160008773ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461118f90919063ffffffff16565b600160008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508273ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040518082815260200191505060405180910390a3600190509392505050565b61092d816000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461128390919063ffffffff16565b6000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055506109848160025461128390919063ffffffff16565b6002819055505050565b60008090505b81811015610a14577fb2abdf6dca7f5665e93ea2262744f2159b5c45ff1a9dacadb090ceb00c2a302f3382604051808373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020018281526020019250505060405180910390a18080600101915050610994565b5050565b600080600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050808310610b28576000600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550610bbc565b610b3b838261118f90919063ffffffff16565b600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055505b8373ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008873ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020546040518082815260200191505060405180910390a3600191505092915050565b60008060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b60008060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054821115610d3d57600080fd5b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff161415610d7757600080fd5b610dc8826000803373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461118f90919063ffffffff16565b6000803373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550610e5b826000808673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461128390919063ffffffff16565b6000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040518082815260200191505060405180910390a36001905092915050565b6000610f9d82600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205461128390919063ffffffff16565b600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508273ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008773ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020546040518082815260200191505060405180910390a36001905092915050565b6000600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b6000828211156040518060400160405280601281526020017f4d4154485f5355425f554e444552464c4f57000000000000000000000000000081525090611271576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825283818151815260200191508051906020019080838360005b8381101561123657808201518184015260208101905061121b565b50505050905090810190601f1680156112635780820380516001836020036101000a031916815260200191505b509250505060405180910390fd5b50600082840390508091505092915050565b6000808284019050838110156040518060400160405280601181526020017f4d4154485f4144445f4f564552464c4f570000000000000000000000000000008152509061136b576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004018080602001828103825283818151815260200191508051906020019080838360005b83811015611330578082015181840152602081019050611315565b50505050905090810190601f16801561135d5780820380516001836020036101000a031916815260200191505b509250505060405180910390fd5b50809150509291505056fea265627a7a723158201835ad3fdb84f43ad0d79c6875a0f5521dc5110fc373c9f0597547aff6d9971764736f6c63430005110032"
+
+// Erc20 is the binding of the solidity contract.
+// Invoke methods return the xpla client which has the message, so the transaction is created by CreateAndSignTx.
+type Erc20 struct {
+	xplac           provider.XplaClient
+	contractAddress common.Address
+	abi             abi.ABI
+}
+
+// DeployErc20 makes the deploy message of the contract with arguments of the constructor.
+func DeployErc20(xplac provider.XplaClient, initialAccount common.Address, initialBalance *big.Int) provider.XplaClient {
+	return xplac.DeploySolidityContract(types.DeploySolContractMsg{
+		ABI:      Erc20ABI,
+		Bytecode: Erc20Bin,
+		Args:     []interface{}{initialAccount, initialBalance},
+	})
+}
+
+// NewErc20 binds the contract which is deployed at the contract address.
+func NewErc20(xplac provider.XplaClient, contractAddress common.Address) (*Erc20, error) {
+	parsed, err := abi.JSON(strings.NewReader(Erc20ABI))
+	if err != nil {
+		return nil, types.ErrWrap(types.ErrParse, err)
+	}
+	return &Erc20{xplac: xplac, contractAddress: contractAddress, abi: parsed}, nil
+}
+
+// ContractAddress returns the address of the bound contract.
+func (c *Erc20) ContractAddress() common.Address {
+	return c.contractAddress
+}
+
+func (c *Erc20) invoke(method string, args ...interface{}) provider.XplaClient {
+	return c.xplac.InvokeSolidityContract(types.InvokeSolContractMsg{
+		ContractAddress:      c.contractAddress.Hex(),
+		ContractFuncCallName: method,
+		Args:                 args,
+		ABI:                  Erc20ABI,
+		FromByteAddress:      common.BytesToAddress(c.xplac.GetFromAddress()).Hex(),
+	})
+}
+
+func (c *Erc20) call(method string, args ...interface{}) ([]interface{}, error) {
+	return evm.CallSolidityContractOutputs(c.xplac, types.CallSolContractMsg{
+		ContractAddress:      c.contractAddress.Hex(),
+		ContractFuncCallName: method,
+		Args:                 args,
+		ABI:                  Erc20ABI,
+		FromByteAddress:      common.BytesToAddress(c.xplac.GetFromAddress()).Hex(),
+	})
+}
+
+// Allowance calls "allowance(address,address)" of the contract.
+func (c *Erc20) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	var out *big.Int
+	outputs, err := c.call("allowance", owner, spender)
+	if err != nil {
+		return out, err
+	}
+	out = *abi.ConvertType(outputs[0], new(*big.Int)).(**big.Int)
+	return out, nil
+}
+
+// Approve invokes "approve(address,uint256)" of the contract.
+func (c *Erc20) Approve(spender common.Address, value *big.Int) provider.XplaClient {
+	return c.invoke("approve", spender, value)
+}
+
+// BalanceOf calls "balanceOf(address)" of the contract.
+func (c *Erc20) BalanceOf(owner common.Address) (*big.Int, error) {
+	var out *big.Int
+	outputs, err := c.call("balanceOf", owner)
+	if err != nil {
+		return out, err
+	}
+	out = *abi.ConvertType(outputs[0], new(*big.Int)).(**big.Int)
+	return out, nil
+}
+
+// BenchmarkLogs invokes "benchmarkLogs(uint256)" of the contract.
+func (c *Erc20) BenchmarkLogs(n *big.Int) provider.XplaClient {
+	return c.invoke("benchmarkLogs", n)
+}
+
+// DecreaseApproval invokes "decreaseApproval(address,uint256)" of the contract.
+func (c *Erc20) DecreaseApproval(spender common.Address, subtractedValue *big.Int) provider.XplaClient {
+	return c.invoke("decreaseApproval", spender, subtractedValue)
+}
+
+// IncreaseApproval invokes "increaseApproval(address,uint256)" of the contract.
+func (c *Erc20) IncreaseApproval(spender common.Address, addedValue *big.Int) provider.XplaClient {
+	return c.invoke("increaseApproval", spender, addedValue)
+}
+
+// Mint invokes "mint(address,uint256)" of the contract.
+func (c *Erc20) Mint(account common.Address, amount *big.Int) provider.XplaClient {
+	return c.invoke("mint", account, amount)
+}
+
+// TotalSupply calls "totalSupply()" of the contract.
+func (c *Erc20) TotalSupply() (*big.Int, error) {
+	var out *big.Int
+	outputs, err := c.call("totalSupply")
+	if err != nil {
+		return out, err
+	}
+	out = *abi.ConvertType(outputs[0], new(*big.Int)).(**big.Int)
+	return out, nil
+}
+
+// Transfer invokes "transfer(address,uint256)" of the contract.
+func (c *Erc20) Transfer(to common.Address, value *big.Int) provider.XplaClient {
+	return c.invoke("transfer", to, value)
+}
+
+// TransferFrom invokes "transferFrom(address,address,uint256)" of the contract.
+func (c *Erc20) TransferFrom(from common.Address, to common.Address, value *big.Int) provider.XplaClient {
+	return c.invoke("transferFrom", from, to, value)
+}
+
+// ParseApproval parses the log of the event "Approval".
+func (c *Erc20) ParseApproval(log ethtypes.Log) (*Erc20Approval, error) {
+	values, err := util.UnpackEvmEventValues(c.abi, "Approval", log)
+	if err != nil {
+		return nil, err
+	}
+	event := &Erc20Approval{Raw: log}
+	event.Owner = *abi.ConvertType(values[0], new(common.Address)).(*common.Address)
+	event.Spender = *abi.ConvertType(values[1], new(common.Address)).(*common.Address)
+	event.Value = *abi.ConvertType(values[2], new(*big.Int)).(**big.Int)
+	return event, nil
+}
+
+// ParseTestLog parses the log of the event "TestLog".
+func (c *Erc20) ParseTestLog(log ethtypes.Log) (*Erc20TestLog, error) {
+	values, err := util.UnpackEvmEventValues(c.abi, "TestLog", log)
+	if err != nil {
+		return nil, err
+	}
+	event := &Erc20TestLog{Raw: log}
+	event.Sender = *abi.ConvertType(values[0], new(common.Address)).(*common.Address)
+	event.I = *abi.ConvertType(values[1], new(*big.Int)).(**big.Int)
+	return event, nil
+}
+
+// ParseTransfer parses the log of the event "Transfer".
+func (c *Erc20) ParseTransfer(log ethtypes.Log) (*Erc20Transfer, error) {
+	values, err := util.UnpackEvmEventValues(c.abi, "Transfer", log)
+	if err != nil {
+		return nil, err
+	}
+	event := &Erc20Transfer{Raw: log}
+	event.From = *abi.ConvertType(values[0], new(common.Address)).(*common.Address)
+	event.To = *abi.ConvertType(values[1], new(common.Address)).(*common.Address)
+	event.Value = *abi.ConvertType(values[2], new(*big.Int)).(**big.Int)
+	return event, nil
+}
+
+// Erc20Approval is the event "Approval(address,address,uint256)" of the contract.
+type Erc20Approval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     ethtypes.Log
+}
+
+// Erc20TestLog is the event "TestLog(address,uint256)" of the contract.
+type Erc20TestLog struct {
+	Sender common.Address
+	I      *big.Int
+	Raw    ethtypes.Log
+}
+
+// Erc20Transfer is the event "Transfer(address,address,uint256)" of the contract.
+type Erc20Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   ethtypes.Log
+}
@@ -0,0 +1,4 @@
+// Package erc20 is the binding of the test contract erc20_abi.json which is generated by xplabind.
+package erc20
+
+//go:generate go run github.com/xpladev/xpla.go/cmd/xplabind evm -abi ../../test_files/erc20_abi.json -bin ../../test_files/erc20_bytecode.json -pkg erc20 -type Erc20 -out erc20.go
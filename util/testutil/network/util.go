@@ -208,7 +208,7 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 		},
 	}
 	bankGenState.Balances = append(bankGenState.Balances, genBalances...)
-	bankGenState.DenomMetadata = []banktypes.Metadata{
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, []banktypes.Metadata{
 		{
 			Description: "main token for test",
 			DenomUnits: []*banktypes.DenomUnit{
@@ -238,7 +238,7 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 			Base:    "node0token",
 			Display: "alt",
 		},
-	}
+	}...)
 	cfg.GenesisState[banktypes.ModuleName] = cfg.Codec.MustMarshalJSON(&bankGenState)
 
 	var stakingGenState stakingtypes.GenesisState
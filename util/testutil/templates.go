@@ -24,7 +24,7 @@ const (
 	StakingUnbondTxTemplates                = `{"body":{"messages":[{"@type":"/cosmos.staking.v1beta1.MsgUndelegate","delegator_address":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","validator_address":"xplavaloper1l8l7uju593qtu08uprtrly223dnpxlrvwmmmmg","amount":{"denom":"axpla","amount":"1000"}}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A9B1KwYOQUjFakc7Hhgbf1K/TldjpMWUvD5vWIMnHbF4"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["cMdHHr0Y40q39hlWJF9H0Tbw10h9nIJuI6thpHRhDX5TFcjUoZDul00RS+6P76iSxLa+wPdOatZ1EG0PaHv3/AE="]}`
 	StakingRedelegateTxTemplates            = `{"body":{"messages":[{"@type":"/cosmos.staking.v1beta1.MsgBeginRedelegate","delegator_address":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","validator_src_address":"xplavaloper1l8l7uju593qtu08uprtrly223dnpxlrvwmmmmg","validator_dst_address":"xplavaloper1l03kma4vv9qcvhgcxf2ga0rnv7dqcumahy92l2","amount":{"denom":"axpla","amount":"1000"}}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A9B1KwYOQUjFakc7Hhgbf1K/TldjpMWUvD5vWIMnHbF4"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["Tj80GLrhSqsylMTIARlncL2GXj/W/RRg4NsGAOpxGuZBjqajjqrZvheWa44l2DBBPVm7iM1AOhFq1aSIJVYnrQA="]}`
 	UpgradeSoftwareUpgradeTxTemplates       = `{"body":{"messages":[{"@type":"/cosmos.gov.v1beta1.MsgSubmitProposal","content":{"@type":"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal","title":"Upgrade Title","description":"Upgrade Description","plan":{"name":"Upgrade Name","time":"0001-01-01T00:00:00Z","height":"6000","info":"{\"upgrade_info\":\"INFO\"}","upgraded_client_state":null}},"initial_deposit":[{"denom":"axpla","amount":"1000"}],"proposer":"xpla1l03kma4vv9qcvhgcxf2ga0rnv7dqcumaxexssh"}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A5+rNG/0BpZEQGGZKq29JH4nvDnyHYmm1D+b5NzNC7bC"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["cobmhnGFWyVrcOLF+sVtZ+4ZZ6PrXcpCVdtLAFZ6vNse3d2szfgArPjATMvXKk7A15XBbuUn7TCizV6QMxvRkwE="]}`
-	UpgradeCancelSoftwareUpgradeTxTemplates = `{"body":{"messages":[{"@type":"/cosmos.gov.v1beta1.MsgSubmitProposal","content":{"@type":"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal","title":"1000","description":"Cancel software upgrade description"},"initial_deposit":[{"denom":"axpla","amount":"1000"}],"proposer":"xpla1l03kma4vv9qcvhgcxf2ga0rnv7dqcumaxexssh"}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A5+rNG/0BpZEQGGZKq29JH4nvDnyHYmm1D+b5NzNC7bC"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["Wk26NO9tYdxFLpVp37/bgvF3MbCuaohq/9P7NAtUdZwQdSi9weQzsVh/4kLIpjxQDOUGMIL00akEc6KO5JpzogE="]}`
+	UpgradeCancelSoftwareUpgradeTxTemplates = `{"body":{"messages":[{"@type":"/cosmos.gov.v1beta1.MsgSubmitProposal","content":{"@type":"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal","title":"Cancel software upgrade","description":"Cancel software upgrade description"},"initial_deposit":[{"denom":"axpla","amount":"1000"}],"proposer":"xpla1l03kma4vv9qcvhgcxf2ga0rnv7dqcumaxexssh"}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A5+rNG/0BpZEQGGZKq29JH4nvDnyHYmm1D+b5NzNC7bC"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["LDSCg87KjFD4Ys4ZNsR2eK/YtgiP3ez8CXShCDioVMgvlTAEqhJTv/IeYcM22gmEF0g139KtRcz40UPr3FrGDwE="]}`
 	WasmInstantiateContractTxTemplates      = `{"body":{"messages":[{"@type":"/cosmwasm.wasm.v1.MsgInstantiateContract","sender":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","admin":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","code_id":"1","label":"Contract instant","msg":{"owner":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54"},"funds":[{"denom":"axpla","amount":"10"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A9B1KwYOQUjFakc7Hhgbf1K/TldjpMWUvD5vWIMnHbF4"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["0XFKN9YOzlJUmNM2fixrbuh9f02hT4oZL7Vcb9WN+rB0YgoQkDKbhrCU2LBu96iD+ckS/dxZA2/sUOBsiNUGrwE="]}`
 	WasmExecuteContractTxTemplates          = `{"body":{"messages":[{"@type":"/cosmwasm.wasm.v1.MsgExecuteContract","sender":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","contract":"xpla14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s525s0h","msg":{"execute_method":{"execute_key":"execute_test","execute_value":"execute_val"}},"funds":[]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A9B1KwYOQUjFakc7Hhgbf1K/TldjpMWUvD5vWIMnHbF4"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["I+BvuHu6Op74A99WZKAWJKSAUd04TaBClGOvqh5jpG0dOVYHy79hUJqor6bBRy9VvZptV5YhWQRj0LMcz0IaNQE="]}`
 	WasmClearContractAdminTxTemplates       = `{"body":{"messages":[{"@type":"/cosmwasm.wasm.v1.MsgClearAdmin","sender":"xpla1l8l7uju593qtu08uprtrly223dnpxlrvlxcp54","contract":"xpla14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s525s0h"}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[{"public_key":{"@type":"/ethermint.crypto.v1.ethsecp256k1.PubKey","key":"A9B1KwYOQUjFakc7Hhgbf1K/TldjpMWUvD5vWIMnHbF4"},"mode_info":{"single":{"mode":"SIGN_MODE_DIRECT"}},"sequence":"0"}],"fee":{"amount":[{"denom":"axpla","amount":"1275000000000000000"}],"gas_limit":"250000","payer":"","granter":""}},"signatures":["oGpLH08YxSzAvqjezDosPE/0eiTYtNCwgJ4ihq+L1kA1Axu8DG68hwdQ2tnFWeOQce1qomVjhYy1MjhiiyUkUAA="]}`
@@ -7,6 +7,8 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/xpladev/xpla.go/types"
 )
 
@@ -35,21 +37,137 @@ func GrpcUrlParsing(normalUrl string) string {
 	}
 }
 
+// Add the default denom (axpla) to amounts which have no denom.
+// Amounts are separated by comma, e.g. "1000,10ibc/..." is converted to "1000axpla,10ibc/...",
+// and amounts which have any denom are not changed.
+// It is only used for fees and gas prices of options of the xpla client, and amounts of messages must have denoms.
 func DenomAdd(amount string) string {
-	if strings.Contains(amount, types.XplaDenom) {
+	if strings.TrimSpace(amount) == "" {
 		return amount
-	} else {
-		return amount + types.XplaDenom
 	}
+
+	amounts := strings.Split(amount, ",")
+	for i, a := range amounts {
+		a = strings.TrimSpace(a)
+		if isDenomOmitted(a) {
+			a = a + types.XplaDenom
+		}
+		amounts[i] = a
+	}
+	return strings.Join(amounts, ",")
 }
 
+// Remove the default denom (axpla) from the amount.
+// The amount which has other denoms is not changed.
 func DenomRemove(amount string) string {
-	if strings.Contains(amount, types.XplaDenom) {
-		returnAmount := strings.Split(amount, types.XplaDenom)
-		return returnAmount[0]
-	} else {
-		return amount
+	trimmed := strings.TrimSpace(amount)
+	if strings.HasSuffix(trimmed, types.XplaDenom) && isDenomOmitted(strings.TrimSuffix(trimmed, types.XplaDenom)) {
+		return strings.TrimSuffix(trimmed, types.XplaDenom)
+	}
+	return amount
+}
+
+// Parse coins which can have arbitrary denoms, e.g. "1000axpla,10ibc/...".
+// Every amount must have the denom.
+func ParseCoins(amount string) (sdk.Coins, error) {
+	if err := requireDenom(amount); err != nil {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(amount)
+}
+
+// Parse a coin which can have an arbitrary denom.
+// The amount must have the denom.
+func ParseCoin(amount string) (sdk.Coin, error) {
+	if err := requireDenom(amount); err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.ParseCoinNormalized(amount)
+}
+
+// Parse decimal coins which can have arbitrary denoms.
+// Every amount must have the denom.
+func ParseDecCoins(amount string) (sdk.DecCoins, error) {
+	if err := requireDenom(amount); err != nil {
+		return nil, err
+	}
+	return sdk.ParseDecCoins(amount)
+}
+
+// Parse the fee amount of the xpla client option, e.g. "1000axpla,10ibc/...".
+// As the default gas price, the default denom (axpla) is used for amounts which have no denom.
+func ParseFeeCoins(amount string) (sdk.Coins, error) {
+	return sdk.ParseCoinsNormalized(DenomAdd(amount))
+}
+
+// Parse gas prices of the xpla client option, e.g. "850000000000axpla,0.025ibc/...".
+// As the default gas price, the default denom (axpla) is used for amounts which have no denom.
+func ParseGasPrices(gasPrices string) (sdk.DecCoins, error) {
+	return sdk.ParseDecCoins(DenomAdd(gasPrices))
+}
+
+// Amounts which are separated by comma must have denoms.
+func requireDenom(amount string) error {
+	for _, a := range strings.Split(amount, ",") {
+		if isDenomOmitted(strings.TrimSpace(a)) {
+			return types.ErrWrap(types.ErrInvalidRequest, "denom of the amount", a, "is required")
+		}
+	}
+	return nil
+}
+
+// Calculate fee coins of the gas limit by the gas prices.
+// Each gas price is multiplied by the gas limit and rounded up, so the fee has all denoms of gas prices.
+func FeeCoinsByGasPrices(gasPrices string, gasLimit string) (sdk.Coins, error) {
+	decCoins, err := ParseGasPrices(gasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := FromStringToUint64(gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+
+	var fees sdk.Coins
+	for _, gasPrice := range decCoins {
+		fee := gasPrice.Amount.Mul(gasDec).Ceil().RoundInt()
+		fees = fees.Add(sdk.NewCoin(gasPrice.Denom, fee))
+	}
+	return fees, nil
+}
+
+// Get the gas price of the evm transaction.
+// The evm only uses the default denom (axpla), so the gas price must have the amount of axpla.
+func EvmGasPrice(gasPrices string) (*big.Int, error) {
+	decCoins, err := ParseGasPrices(gasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := decCoins.AmountOf(types.XplaDenom)
+	if !gasPrice.IsPositive() {
+		return nil, types.ErrWrap(types.ErrInvalidRequest, "gas price of", types.XplaDenom, "is required for evm transaction")
+	}
+	return gasPrice.Ceil().RoundInt().BigInt(), nil
+}
+
+// Compute the address of the evm contract which is deployed by the sender with the nonce.
+// The nonce of the deploy transaction is the account sequence of the sender, so the address is known before broadcasting.
+func EvmContractAddress(from common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(from, nonce)
+}
+
+// Check that the amount is only a number without the denom.
+func isDenomOmitted(amount string) bool {
+	if amount == "" {
+		return false
+	}
+	if _, err := sdk.NewDecFromStr(amount); err != nil {
+		return false
 	}
+	return true
 }
 
 func ConvertEvmChainId(chainId string) (*big.Int, error) {